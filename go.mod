@@ -6,3 +6,5 @@ require (
 	github.com/ciscoecosystem/mso-go-client v1.29.0
 	github.com/hashicorp/terraform-plugin-sdk v1.17.1
 )
+
+replace github.com/ciscoecosystem/mso-go-client => ./third_party/mso-go-client
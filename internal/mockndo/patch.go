@@ -0,0 +1,166 @@
+package mockndo
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// applyOperation applies a JSON patch operation to the object and returns the patched object.
+// List elements can be addressed by index, by name, by siteId-templateName and by the last segment of an object ref, like NDO does.
+func applyOperation(object interface{}, operation interface{}) (interface{}, error) {
+	operationMap, ok := operation.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Invalid patch operation %v", operation)
+	}
+	op, _ := operationMap["op"].(string)
+	path, _ := operationMap["path"].(string)
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.Replace(strings.Replace(segment, "~1", "/", -1), "~0", "~", -1)
+	}
+	if path == "" || path == "/" {
+		return nil, fmt.Errorf("Invalid patch path %s", path)
+	}
+
+	parent := object
+	for _, segment := range segments[:len(segments)-1] {
+		next, _, ok := getChild(parent, segment)
+		if !ok {
+			return nil, fmt.Errorf("Resource Not Found: %s", path)
+		}
+		parent = next
+	}
+
+	last := segments[len(segments)-1]
+	value := operationMap["value"]
+	switch parentValue := parent.(type) {
+	case map[string]interface{}:
+		_, exists := parentValue[last]
+		switch op {
+		case "add":
+			parentValue[last] = value
+		case "replace":
+			if !exists {
+				return nil, fmt.Errorf("Resource Not Found: %s", path)
+			}
+			parentValue[last] = value
+		case "remove":
+			if !exists {
+				return nil, fmt.Errorf("Resource Not Found: %s", path)
+			}
+			delete(parentValue, last)
+		default:
+			return nil, fmt.Errorf("Unsupported patch operation %s", op)
+		}
+	case []interface{}:
+		list := parentValue
+		if op == "add" && last == "-" {
+			list = append(list, value)
+		} else {
+			_, index, ok := getChild(list, last)
+			if op == "add" && !ok {
+				if index, err := strconv.Atoi(last); err == nil && index == len(list) {
+					list = append(list, value)
+					break
+				}
+			}
+			if !ok {
+				return nil, fmt.Errorf("Resource Not Found: %s", path)
+			}
+			switch op {
+			case "add":
+				list = append(list[:index], append([]interface{}{value}, list[index:]...)...)
+			case "replace":
+				list[index] = value
+			case "remove":
+				list = append(list[:index], list[index+1:]...)
+			default:
+				return nil, fmt.Errorf("Unsupported patch operation %s", op)
+			}
+		}
+		// The list can be reallocated, so it is set again in the parent of the list.
+		return setPath(object, segments[:len(segments)-1], list)
+	default:
+		return nil, fmt.Errorf("Resource Not Found: %s", path)
+	}
+	return object, nil
+}
+
+// setPath replaces the value at the path segments in the object and returns the object.
+func setPath(object interface{}, segments []string, value interface{}) (interface{}, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+	child, index, ok := getChild(object, segments[0])
+	if !ok {
+		return nil, fmt.Errorf("Resource Not Found: /%s", strings.Join(segments, "/"))
+	}
+	newChild, err := setPath(child, segments[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	switch objectValue := object.(type) {
+	case map[string]interface{}:
+		objectValue[segments[0]] = newChild
+	case []interface{}:
+		objectValue[index] = newChild
+	}
+	return object, nil
+}
+
+// getChild returns the child of a map by key or of a list by index or name, the index of a list element and whether the child exists.
+func getChild(object interface{}, segment string) (interface{}, int, bool) {
+	switch objectValue := object.(type) {
+	case map[string]interface{}:
+		child, ok := objectValue[segment]
+		return child, -1, ok
+	case []interface{}:
+		if index, err := strconv.Atoi(segment); err == nil {
+			if index < 0 || index >= len(objectValue) {
+				return nil, index, false
+			}
+			return objectValue[index], index, true
+		}
+		for index, element := range objectValue {
+			if elementMap, ok := element.(map[string]interface{}); ok && isElement(elementMap, segment) {
+				return element, index, true
+			}
+		}
+	}
+	return nil, -1, false
+}
+
+func isElement(element map[string]interface{}, segment string) bool {
+	if element["name"] == segment {
+		return true
+	}
+	if siteId, ok := element["siteId"].(string); ok && fmt.Sprintf("%s-%v", siteId, element["templateName"]) == segment {
+		return true
+	}
+	for key, value := range element {
+		if !strings.HasSuffix(key, "Ref") {
+			continue
+		}
+		switch ref := value.(type) {
+		case string:
+			if strings.HasSuffix(ref, "/"+segment) {
+				return true
+			}
+		case map[string]interface{}:
+			// Refs are sent as objects with the names of the referenced object and its parents.
+			if strings.HasSuffix(key, "Ref") && ref[strings.TrimSuffix(key, "Ref")+"Name"] == segment {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fixtureDir returns the testdata directory of this package.
+func fixtureDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "testdata")
+}
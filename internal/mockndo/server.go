@@ -0,0 +1,262 @@
+// Package mockndo provides an httptest based mock of the NDO API, so the resources of the provider can be tested without
+// a live orchestrator.
+//
+// The server stores objects by their API path. GET, PUT and DELETE requests operate on the stored objects, POST requests
+// to a collection store a new object with a generated id and PATCH requests apply JSON patch operations with the NDO path
+// semantics, where list elements can also be addressed by name. Every request is recorded and failures can be injected
+// per method and path to test error handling.
+package mockndo
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Request is a request that was received by the server.
+type Request struct {
+	Method string
+	// Path is the API path without the leading slash and the mso prefix of the Nexus Dashboard platform.
+	Path string
+	// URLPath is the path as it is sent by the client.
+	URLPath string
+	Header  http.Header
+	Body    interface{}
+}
+
+type failure struct {
+	method string
+	path   string
+	status int
+	body   interface{}
+}
+
+// Server is a mock NDO API server.
+type Server struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	version  string
+	objects  map[string]interface{}
+	requests []Request
+	failures []failure
+	nextId   int
+	gzip     bool
+}
+
+// NewServer starts a mock NDO API server which reports version as the NDO version.
+func NewServer(version string) *Server {
+	server := &Server{version: version}
+	server.Reset()
+	server.Server = httptest.NewServer(http.HandlerFunc(server.handle))
+	return server
+}
+
+// Reset removes all objects, recorded requests and injected failures.
+func (s *Server) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.objects = make(map[string]interface{})
+	s.requests = make([]Request, 0)
+	s.failures = make([]failure, 0)
+	s.nextId = 0
+	s.gzip = false
+}
+
+// SetGzip enables or disables the gzip compression of the responses to requests which accept it.
+func (s *Server) SetGzip(enabled bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.gzip = enabled
+}
+
+// SetObject stores the object at the API path, e.g. api/v1/schemas/{id}.
+func (s *Server) SetObject(path string, object interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.objects[normalizePath(path)] = object
+}
+
+// Object returns the object stored at the API path and whether it exists.
+func (s *Server) Object(path string) (interface{}, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	object, ok := s.objects[normalizePath(path)]
+	return object, ok
+}
+
+// LoadFixture stores the JSON object of a file in the testdata directory of this package at the API path.
+func (s *Server) LoadFixture(path, name string) error {
+	content, err := ioutil.ReadFile(filepath.Join(fixtureDir(), name))
+	if err != nil {
+		return err
+	}
+	var object interface{}
+	err = json.Unmarshal(content, &object)
+	if err != nil {
+		return fmt.Errorf("Unable to parse fixture %s: %s", name, err)
+	}
+	s.SetObject(path, object)
+	return nil
+}
+
+// Requests returns the requests which are received by the server, excluding the login and version requests.
+func (s *Server) Requests() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+// Fail makes the next request with the method and API path fail with the status and the JSON body.
+// Failures are used once in the order in which they are added.
+func (s *Server) Fail(method, path string, status int, body interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failures = append(s.failures, failure{method: method, path: normalizePath(path), status: status, body: body})
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	writer io.Writer
+}
+
+func (w gzipResponseWriter) Write(content []byte) (int, error) {
+	return w.writer.Write(content)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	path := normalizePath(r.URL.Path)
+	s.mutex.Lock()
+	compress := s.gzip && strings.Contains(r.Header.Get("Accept-Encoding"), "gzip")
+	s.mutex.Unlock()
+	if compress {
+		w.Header().Set("Content-Encoding", "gzip")
+		gzipWriter := gzip.NewWriter(w)
+		defer gzipWriter.Close()
+		w = gzipResponseWriter{ResponseWriter: w, writer: gzipWriter}
+	}
+	switch path {
+	case "api/v1/auth/login", "login":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"token": "mock-token"})
+		return
+	case "api/v1/platform/version":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"version": s.version})
+		return
+	}
+
+	var body interface{}
+	if r.Body != nil {
+		content, _ := ioutil.ReadAll(r.Body)
+		if len(content) > 0 {
+			if err := json.Unmarshal(content, &body); err != nil {
+				writeJSON(w, http.StatusBadRequest, errorBody(http.StatusBadRequest, err.Error()))
+				return
+			}
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path, URLPath: r.URL.Path, Header: r.Header.Clone(), Body: body})
+
+	for i, f := range s.failures {
+		if f.method == r.Method && f.path == path {
+			s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			writeJSON(w, f.status, f.body)
+			return
+		}
+	}
+
+	object, exists := s.objects[path]
+	switch r.Method {
+	case http.MethodGet:
+		if !exists {
+			writeJSON(w, http.StatusNotFound, errorBody(http.StatusNotFound, fmt.Sprintf("Object %s not found", path)))
+			return
+		}
+		writeJSON(w, http.StatusOK, object)
+	case http.MethodPost:
+		objectMap, ok := body.(map[string]interface{})
+		if !ok {
+			writeJSON(w, http.StatusBadRequest, errorBody(http.StatusBadRequest, "Expected an object"))
+			return
+		}
+		s.nextId++
+		id := fmt.Sprintf("%024x", s.nextId)
+		objectMap["id"] = id
+		// Templates of the templates API are identified by templateId instead of id.
+		if path == "api/v1/templates" {
+			objectMap["templateId"] = id
+		}
+		s.objects[fmt.Sprintf("%s/%s", path, id)] = objectMap
+		// Tasks, like deployments, are accepted and run asynchronously by NDO.
+		// The mock completes them immediately, unless the test set their status with SetObject.
+		if path == "api/v1/task" {
+			if _, ok := objectMap["operDetails"]; !ok {
+				objectMap["operDetails"] = map[string]interface{}{"taskStatus": "Complete"}
+			}
+			writeJSON(w, http.StatusAccepted, objectMap)
+			return
+		}
+		writeJSON(w, http.StatusCreated, objectMap)
+	case http.MethodPut:
+		if !exists {
+			writeJSON(w, http.StatusNotFound, errorBody(http.StatusNotFound, fmt.Sprintf("Object %s not found", path)))
+			return
+		}
+		s.objects[path] = body
+		writeJSON(w, http.StatusOK, body)
+	case http.MethodPatch:
+		if !exists {
+			writeJSON(w, http.StatusNotFound, errorBody(http.StatusNotFound, fmt.Sprintf("Object %s not found", path)))
+			return
+		}
+		operations, _ := body.([]interface{})
+		for _, operation := range operations {
+			var err error
+			object, err = applyOperation(object, operation)
+			if err != nil {
+				// NDO uses code 141 when the object of a patch path does not exist.
+				writeJSON(w, http.StatusBadRequest, map[string]interface{}{"code": 141, "message": err.Error()})
+				return
+			}
+		}
+		s.objects[path] = object
+		writeJSON(w, http.StatusOK, object)
+	case http.MethodDelete:
+		if !exists {
+			writeJSON(w, http.StatusNotFound, errorBody(http.StatusNotFound, fmt.Sprintf("Object %s not found", path)))
+			return
+		}
+		delete(s.objects, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSON(w, http.StatusMethodNotAllowed, errorBody(http.StatusMethodNotAllowed, r.Method+" is not supported"))
+	}
+}
+
+// normalizePath removes the leading slash and the mso prefix of the Nexus Dashboard platform from a path.
+func normalizePath(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	return strings.TrimPrefix(path, "mso/")
+}
+
+func errorBody(status int, message string) map[string]interface{} {
+	return map[string]interface{}{"code": status, "message": message}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,88 @@
+package mso
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// getAllowedSites returns the site ids of the allowed_sites provider setting, which the resources are allowed to address.
+func getAllowedSites(siteIds []interface{}) map[string]bool {
+	allowedSites := make(map[string]bool)
+	for _, siteId := range siteIds {
+		allowedSites[siteId.(string)] = true
+	}
+	return allowedSites
+}
+
+// checkAllowedSites returns an error when one of the site ids is not in allowedSites, all sites are allowed when it is empty.
+func checkAllowedSites(allowedSites map[string]bool, resourceName string, siteIds []string) error {
+	if len(allowedSites) == 0 {
+		return nil
+	}
+	for _, siteId := range siteIds {
+		if siteId != "" && !allowedSites[siteId] {
+			allowed := make([]string, 0, len(allowedSites))
+			for allowedSite := range allowedSites {
+				allowed = append(allowed, allowedSite)
+			}
+			sort.Strings(allowed)
+			return fmt.Errorf("%s addresses site %s, which is not in the allowed_sites of the provider: %s", resourceName, siteId, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// getSiteIds returns the site ids of a site_id attribute, which is a string or a list of strings.
+func getSiteIds(value interface{}) []string {
+	switch siteId := value.(type) {
+	case string:
+		return []string{siteId}
+	case []interface{}:
+		siteIds := make([]string, 0, len(siteId))
+		for _, id := range siteId {
+			if id, ok := id.(string); ok {
+				siteIds = append(siteIds, id)
+			}
+		}
+		return siteIds
+	}
+	return nil
+}
+
+// allowedSitesResources adds the allowed_sites check to the plan and the destroy of the resources with a site_id attribute.
+// The destroy is also checked because resources which are destroyed are not planned with a diff.
+func allowedSitesResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		if _, ok := resource.Schema["site_id"]; !ok {
+			continue
+		}
+		resourceName := name
+		customizeDiff := resource.CustomizeDiff
+		resource.CustomizeDiff = func(diff *schema.ResourceDiff, m interface{}) error {
+			if diff.NewValueKnown("site_id") {
+				err := checkAllowedSites(getProviderSettings(m).allowedSites, resourceName, getSiteIds(diff.Get("site_id")))
+				if err != nil {
+					return err
+				}
+			}
+			if customizeDiff != nil {
+				return customizeDiff(diff, m)
+			}
+			return nil
+		}
+		if resource.Delete != nil {
+			deleteFunc := resource.Delete
+			resource.Delete = func(d *schema.ResourceData, m interface{}) error {
+				err := checkAllowedSites(getProviderSettings(m).allowedSites, resourceName, getSiteIds(d.Get("site_id")))
+				if err != nil {
+					return err
+				}
+				return deleteFunc(d, m)
+			}
+		}
+	}
+	return resources
+}
@@ -0,0 +1,41 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestCheckAllowedSites(t *testing.T) {
+	if err := checkAllowedSites(getAllowedSites(nil), "mso_schema_site", []string{"site1"}); err != nil {
+		t.Errorf("Expected all sites to be allowed without allowed_sites, got %v", err)
+	}
+
+	allowedSites := getAllowedSites([]interface{}{"site1", "site2"})
+	if err := checkAllowedSites(allowedSites, "mso_schema_site", getSiteIds("site2")); err != nil {
+		t.Errorf("Expected site2 to be allowed, got %v", err)
+	}
+	if err := checkAllowedSites(allowedSites, "mso_schema_site", getSiteIds([]interface{}{"site1", "site3"})); err == nil {
+		t.Error("Expected site3 not to be allowed")
+	}
+}
+
+func TestAllowedSitesDelete(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	setProviderSettings(msoClient, &providerSettings{allowedSites: getAllowedSites([]interface{}{"5c7c95b25100008f01c1ee3d"})})
+
+	resource := Provider().(*schema.Provider).ResourcesMap["mso_schema_site"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"schema_id":     mockSchemaId,
+		"template_name": "Template1",
+		"site_id":       "5c7c95b25100008f01c1ee3c",
+	})
+	d.SetId("5c7c95b25100008f01c1ee3c")
+
+	if err := resource.Delete(d, msoClient); err == nil {
+		t.Error("Expected the delete of a site which is not allowed to fail")
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("Expected no requests, got %v", requests)
+	}
+}
@@ -0,0 +1,106 @@
+package mso
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+)
+
+// downloadBackup writes the archive of the backup with backupId to localPath and returns the sha256 of the archive.
+func downloadBackup(ctx context.Context, msoClient *client.Client, backupId, localPath string) (string, error) {
+	req, err := msoClient.MakeRestRequest("GET", fmt.Sprintf("api/v1/backups/%s/download", backupId), nil, true)
+	if err != nil {
+		return "", err
+	}
+	// The archive is not JSON, so it is downloaded with DoRaw which keeps the retries and re-authentication of the client.
+	resp, body, err := msoClient.DoRaw(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", contextDoneError(ctx, fmt.Sprintf("downloading backup %s", backupId))
+		}
+		return "", err
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("Download of backup %s failed with status %s: %s", backupId, resp.Status, string(body))
+	}
+
+	file, err := ioutil.TempFile(filepath.Dir(localPath), filepath.Base(localPath))
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	_, err = io.MultiWriter(file, hash).Write(body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), localPath)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+	log.Printf("[DEBUG] Downloaded backup %s to %s", backupId, localPath)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// uploadBackup uploads the archive at localPath and returns the response of the upload.
+func uploadBackup(ctx context.Context, msoClient *client.Client, localPath string) (*container.Container, error) {
+	content, err := ioutil.ReadFile(localPath)
+	if err != nil {
+		return nil, err
+	}
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filepath.Base(localPath))
+	if err != nil {
+		return nil, err
+	}
+	part.Write(content)
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// The multipart body is set before the request is authenticated, so a signature covers the archive.
+	req, err := msoClient.MakeRawRequest("POST", "api/v1/backups/upload", body.Bytes(), writer.FormDataContentType(), true)
+	if err != nil {
+		return nil, err
+	}
+	resp, respBody, err := msoClient.DoRaw(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, contextDoneError(ctx, fmt.Sprintf("uploading backup %s", localPath))
+		}
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("Upload of backup %s failed with status %s: %s", localPath, resp.Status, string(respBody))
+	}
+	log.Printf("[DEBUG] Uploaded backup %s", localPath)
+	return container.ParseJSON(respBody)
+}
+
+// fileSha256 returns the sha256 of the file at localPath.
+func fileSha256(localPath string) (string, error) {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,68 @@
+package mso
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+func TestUploadBackupSignature(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var verifyErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		signature, err := r.Cookie("APIC-Request-Signature")
+		if err != nil {
+			verifyErr = err
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		signatureBytes, _ := base64.StdEncoding.DecodeString(signature.Value)
+		hash := sha256.Sum256(append([]byte(r.Method+r.URL.RequestURI()), body...))
+		verifyErr = rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hash[:], signatureBytes)
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			t.Errorf("Expected a multipart upload, got content type %s", r.Header.Get("Content-Type"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": "backup1"}`))
+	}))
+	defer server.Close()
+
+	msoClient := client.NewClient(server.URL, "admin", client.Insecure(true))
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := msoClient.SetCertificateAuthentication(string(privateKey), "cert1"); err != nil {
+		t.Fatal(err)
+	}
+
+	localPath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	if err := ioutil.WriteFile(localPath, []byte("archive"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cont, err := uploadBackup(context.Background(), msoClient, localPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verifyErr != nil {
+		t.Errorf("Expected the signature to cover the uploaded archive: %s", verifyErr)
+	}
+	if id := cont.S("id").Data(); id != "backup1" {
+		t.Errorf("Expected the response of the upload, got id %v", id)
+	}
+}
@@ -0,0 +1,101 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+// contractFilterBatchDelay is the time a batch waits for contract filter operations of other resources on the same schema.
+const contractFilterBatchDelay = 500 * time.Millisecond
+
+// contractFilterBatchKey identifies a batch, operations are only batched when they are sent with the same client to the same schema.
+type contractFilterBatchKey struct {
+	msoClient *client.Client
+	schemaId  string
+}
+
+type contractFilterBatch struct {
+	operations []*models.PatchPayload
+	done       chan struct{}
+	// errs holds the error of every operation, by the index of the operation.
+	errs []error
+}
+
+var contractFilterBatches = struct {
+	sync.Mutex
+	batches map[contractFilterBatchKey]*contractFilterBatch
+}{batches: make(map[contractFilterBatchKey]*contractFilterBatch)}
+
+// patchContractFilter queues a filter relationship add, replace or remove and waits until it is sent, it returns the error of the operation.
+// Operations of all contract filter resources on the same schema that are queued within contractFilterBatchDelay are sent in a single PATCH request.
+// The filter relationships are addressed by their filter name, so the operations of a batch do not depend on each other's order.
+func patchContractFilter(msoClient *client.Client, schemaId string, operation *models.PatchPayload) error {
+	key := contractFilterBatchKey{msoClient, schemaId}
+	contractFilterBatches.Lock()
+	batch, ok := contractFilterBatches.batches[key]
+	if !ok {
+		batch = &contractFilterBatch{done: make(chan struct{})}
+		contractFilterBatches.batches[key] = batch
+		go func() {
+			err := sleepWithContext(getStopContext(msoClient), contractFilterBatchDelay, fmt.Sprintf("waiting for the contract filter operations of schema %s", schemaId))
+			contractFilterBatches.Lock()
+			delete(contractFilterBatches.batches, key)
+			contractFilterBatches.Unlock()
+			if err != nil {
+				batch.errs = make([]error, len(batch.operations))
+				for i := range batch.errs {
+					batch.errs[i] = err
+				}
+			} else {
+				batch.errs = sendContractFilterBatch(msoClient, schemaId, batch.operations)
+			}
+			close(batch.done)
+		}()
+	}
+	index := len(batch.operations)
+	batch.operations = append(batch.operations, operation)
+	contractFilterBatches.Unlock()
+
+	<-batch.done
+	return batch.errs[index]
+}
+
+// sendContractFilterBatch sends all operations in one PATCH request and returns the error of every operation.
+// When NDO rejects the PATCH request, the operations are sent one by one so only the operations which fail return an error.
+// A remove of a filter relationship which does not exist anymore is not an error.
+func sendContractFilterBatch(msoClient *client.Client, schemaId string, operations []*models.PatchPayload) []error {
+	log.Printf("[DEBUG] Sending %d batched contract filter operations for schema %s", len(operations), schemaId)
+	errs := make([]error, len(operations))
+	objList := make([]models.Model, 0, len(operations))
+	for _, operation := range operations {
+		objList = append(objList, operation)
+	}
+
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), objList...)
+	if err == nil || len(operations) == 1 {
+		if err != nil && operations[0].Ops == "remove" && isObjectNotFoundResponse(response) {
+			log.Printf("[DEBUG] Filter relationship %s is already removed", operations[0].Path)
+			err = nil
+		}
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+	log.Printf("[WARN] Batched contract filter operations for schema %s failed, sending them one by one: %s", schemaId, err)
+	for i := range operations {
+		errs[i] = sendContractFilterBatch(msoClient, schemaId, operations[i:i+1])[0]
+	}
+	return errs
+}
+
+// isObjectNotFoundResponse returns whether NDO rejected a PATCH request because the object of the path does not exist.
+func isObjectNotFoundResponse(response *container.Container) bool {
+	return response != nil && response.Exists("code") && response.S("code").String() == "141"
+}
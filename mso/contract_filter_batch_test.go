@@ -0,0 +1,122 @@
+package mso
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+// testContractFilterSchema adds Contract1 with a filter relationship to Filter1 to Template1 of the mock schema.
+func testContractFilterSchema() {
+	schemaObject, _ := mockServer.Object("api/v1/schemas/" + mockSchemaId)
+	template := schemaObject.(map[string]interface{})["templates"].([]interface{})[0].(map[string]interface{})
+	template["contracts"] = []interface{}{map[string]interface{}{
+		"name":        "Contract1",
+		"displayName": "Contract1",
+		"filterRelationships": []interface{}{map[string]interface{}{
+			"filterRef": "/schemas/" + mockSchemaId + "/templates/Template1/filters/Filter1",
+		}},
+	}}
+	mockServer.SetObject("api/v1/schemas/"+mockSchemaId, schemaObject)
+}
+
+// testPatchContractFilters queues the operations at the same time and returns the error of every operation.
+func testPatchContractFilters(operations ...*models.PatchPayload) []error {
+	errs := make([]error, len(operations))
+	var wg sync.WaitGroup
+	for i, operation := range operations {
+		wg.Add(1)
+		go func(i int, operation *models.PatchPayload) {
+			defer wg.Done()
+			errs[i] = patchContractFilter(mockClient, mockSchemaId, operation)
+		}(i, operation)
+	}
+	wg.Wait()
+	return errs
+}
+
+func testContractFilterOperation(op, filterName string) *models.PatchPayload {
+	if op == "remove" {
+		return models.GetRemovePatchPayload(createMSOTemplateContractFilterPath("Template1", "Contract1", "filterRelationships", filterName))
+	}
+	path := createMSOTemplateContractFilterPath("Template1", "Contract1", "filterRelationships", filterName)
+	if op == "add" {
+		path = createMSOTemplateContractFilterPath("Template1", "Contract1", "filterRelationships", "-")
+	}
+	return models.NewTemplateContractFilterRelationShip(op, path, "permit", "", "", getFilterRef(mockSchemaId, "Template1", filterName), nil)
+}
+
+func testContractFilterRelationships() []interface{} {
+	schemaObject, _ := mockServer.Object("api/v1/schemas/" + mockSchemaId)
+	template := schemaObject.(map[string]interface{})["templates"].([]interface{})[0].(map[string]interface{})
+	contract := template["contracts"].([]interface{})[0].(map[string]interface{})
+	relationships, _ := contract["filterRelationships"].([]interface{})
+	return relationships
+}
+
+func TestPatchContractFilterBatch(t *testing.T) {
+	server, _ := testMockNDO(t)
+	testContractFilterSchema()
+
+	errs := testPatchContractFilters(
+		testContractFilterOperation("add", "Filter2"),
+		testContractFilterOperation("add", "Filter3"),
+		testContractFilterOperation("remove", "Filter1"),
+	)
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Expected operation %d to succeed, got %v", i, err)
+		}
+	}
+
+	patches := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			patches++
+			if operations := request.Body.([]interface{}); len(operations) != 3 {
+				t.Errorf("Expected the 3 operations in one PATCH request, got %v", operations)
+			}
+		}
+	}
+	if patches != 1 {
+		t.Errorf("Expected one PATCH request, got %d", patches)
+	}
+	if relationships := testContractFilterRelationships(); len(relationships) != 2 {
+		t.Errorf("Expected the relationships to Filter2 and Filter3, got %v", relationships)
+	}
+}
+
+func TestPatchContractFilterBatchFallback(t *testing.T) {
+	server, _ := testMockNDO(t)
+	testContractFilterSchema()
+
+	errs := testPatchContractFilters(
+		testContractFilterOperation("add", "Filter2"),
+		testContractFilterOperation("remove", "Filter4"),
+		testContractFilterOperation("replace", "Filter5"),
+	)
+	if errs[0] != nil {
+		t.Errorf("Expected the add to succeed, got %v", errs[0])
+	}
+	if errs[1] != nil {
+		t.Errorf("Expected the remove of a missing filter relationship to succeed, got %v", errs[1])
+	}
+	if errs[2] == nil {
+		t.Error("Expected the replace of a missing filter relationship to fail")
+	}
+
+	patches := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			patches++
+		}
+	}
+	if patches != 4 {
+		t.Errorf("Expected the rejected batch to be sent again one operation at a time, got %d PATCH requests", patches)
+	}
+	if relationships := testContractFilterRelationships(); len(relationships) != 2 {
+		t.Errorf("Expected the relationships to Filter1 and Filter2, got %v", relationships)
+	}
+}
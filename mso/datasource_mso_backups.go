@@ -0,0 +1,142 @@
+package mso
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceMSOBackups() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOBackupsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"name_prefix": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"backups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"timestamp": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// getBackupRecordAttribute returns the first attribute of the backup record or its metadata which is set.
+func getBackupRecordAttribute(backupCont *container.Container, keys ...string) string {
+	for _, key := range keys {
+		for _, cont := range []*container.Container{backupCont.S("metadata"), backupCont} {
+			if value := convertInterfaceToString(cont.S(key).Data()); value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+func datasourceMSOBackupsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	cont, err := msoClient.GetViaURL("api/v1/backups/backupRecords")
+	if err != nil {
+		return err
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	backupVersion := d.Get("version").(string)
+	status := d.Get("status").(string)
+	backups := make([]map[string]interface{}, 0)
+	count, _ := cont.ArrayCount("backupRecords")
+	for i := 0; i < count; i++ {
+		backupCont, err := cont.ArrayElement(i, "backupRecords")
+		if err != nil {
+			return err
+		}
+		backup := map[string]interface{}{
+			"id":          convertInterfaceToString(backupCont.S("id").Data()),
+			"name":        getBackupRecordAttribute(backupCont, "name"),
+			"description": getBackupRecordAttribute(backupCont, "description"),
+			"timestamp":   getBackupRecordAttribute(backupCont, "backupCreatedAt", "createdAt"),
+			"version":     getBackupRecordAttribute(backupCont, "version"),
+			"location":    getBackupRecordAttribute(backupCont, "location", "remotePath", "remoteLocationId"),
+			"status":      convertInterfaceToString(backupCont.S("backupStatus", "status").Data()),
+		}
+		if backup["status"] == "" {
+			backup["status"] = getBackupRecordAttribute(backupCont, "status")
+		}
+		if !strings.HasPrefix(backup["name"].(string), namePrefix) ||
+			(backupVersion != "" && backup["version"] != backupVersion) ||
+			(status != "" && backup["status"] != status) {
+			continue
+		}
+		backups = append(backups, backup)
+	}
+
+	// The timestamps are in RFC 3339 format, so the newest backup is sorted first.
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i]["timestamp"].(string) > backups[j]["timestamp"].(string)
+	})
+	backupList := make([]interface{}, 0, len(backups))
+	for _, backup := range backups {
+		backupList = append(backupList, backup)
+	}
+
+	d.SetId("backups")
+	d.Set("backups", backupList)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
@@ -0,0 +1,211 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func datasourceMSOCurrentUser() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOCurrentUserRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"required_read_permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"required_write_permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"roleid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+			"read_permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"write_permissions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+// getMSORolePermissions returns the name and the read and write permissions of the MSO roles by role id.
+func getMSORolePermissions(msoClient *client.Client) (map[string]*container.Container, error) {
+	rolesCont, err := msoClient.GetViaURL("api/v1/roles")
+	if err != nil {
+		return nil, err
+	}
+	roles := make(map[string]*container.Container)
+	count, _ := rolesCont.ArrayCount("roles")
+	for i := 0; i < count; i++ {
+		roleCont, err := rolesCont.ArrayElement(i, "roles")
+		if err != nil {
+			return nil, err
+		}
+		roles[models.StripQuotes(roleCont.S("id").String())] = roleCont
+	}
+	return roles, nil
+}
+
+// getMissingPermissions returns the required permissions which are not in permissions.
+func getMissingPermissions(required []interface{}, permissions map[string]bool) []string {
+	missing := make([]string, 0)
+	for _, permission := range required {
+		if !permissions[permission.(string)] {
+			missing = append(missing, permission.(string))
+		}
+	}
+	return missing
+}
+
+// addPermissions adds the permissions of a role payload list to permissions.
+func addPermissions(permissions map[string]bool, rolePermissions interface{}) {
+	list, _ := rolePermissions.([]interface{})
+	for _, permission := range list {
+		if name, ok := permission.(string); ok {
+			permissions[name] = true
+		}
+	}
+}
+
+func sortedPermissions(permissions map[string]bool) []string {
+	list := make([]string, 0, len(permissions))
+	for permission := range permissions {
+		list = append(list, permission)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func datasourceMSOCurrentUserRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	platform := msoClient.GetPlatform()
+	var path string
+	if platform == "nd" {
+		path = "api/v2/users/me"
+	} else {
+		path = "api/v1/users/me"
+	}
+	userCont, err := msoClient.GetViaURL(path)
+	if err != nil {
+		return err
+	}
+
+	roles := make([]interface{}, 0)
+	readPermissions := make(map[string]bool)
+	writePermissions := make(map[string]bool)
+	var username string
+	if platform == "nd" {
+		d.SetId(models.StripQuotes(userCont.S("userID").String()))
+		username = models.StripQuotes(userCont.S("loginID").String())
+		// The roles of Nexus Dashboard users have no permission lists, the role names are used as the permissions instead.
+		if userCont.Exists("userRbac") {
+			for name := range userCont.S("userRbac").Data().(map[string]interface{}) {
+				userPriv := models.StripQuotes(userCont.S("userRbac").S(name).S("userPriv").String())
+				roles = append(roles, map[string]interface{}{
+					"roleid":      name,
+					"name":        name,
+					"access_type": userPriv,
+				})
+				readPermissions[name] = true
+				if userPriv == "writePriv" {
+					writePermissions[name] = true
+				}
+			}
+		}
+	} else {
+		d.SetId(models.StripQuotes(userCont.S("id").String()))
+		username = models.StripQuotes(userCont.S("username").String())
+		rolePermissions, err := getMSORolePermissions(msoClient)
+		if err != nil {
+			return err
+		}
+		count, _ := userCont.ArrayCount("roles")
+		for i := 0; i < count; i++ {
+			roleCont, err := userCont.ArrayElement(i, "roles")
+			if err != nil {
+				return fmt.Errorf("Unable to parse the roles list")
+			}
+			roleId := models.StripQuotes(roleCont.S("roleId").String())
+			accessType := models.StripQuotes(roleCont.S("accessType").String())
+			role := map[string]interface{}{
+				"roleid":      roleId,
+				"name":        "",
+				"access_type": accessType,
+			}
+			if permissionCont, ok := rolePermissions[roleId]; ok {
+				role["name"] = models.StripQuotes(permissionCont.S("name").String())
+				addPermissions(readPermissions, permissionCont.S("readPermissions").Data())
+				if accessType != "readOnly" {
+					addPermissions(writePermissions, permissionCont.S("writePermissions").Data())
+				}
+			}
+			roles = append(roles, role)
+		}
+	}
+
+	d.Set("username", username)
+	d.Set("domain", convertInterfaceToString(userCont.S("domain").Data()))
+	d.Set("roles", roles)
+	d.Set("read_permissions", sortedPermissions(readPermissions))
+	d.Set("write_permissions", sortedPermissions(writePermissions))
+
+	// A write permission also allows to read.
+	for permission := range writePermissions {
+		readPermissions[permission] = true
+	}
+	if missing := getMissingPermissions(d.Get("required_read_permissions").([]interface{}), readPermissions); len(missing) > 0 {
+		return fmt.Errorf("The user %s is missing the required read permissions: %s", username, strings.Join(missing, ", "))
+	}
+	if missing := getMissingPermissions(d.Get("required_write_permissions").([]interface{}), writePermissions); len(missing) > 0 {
+		return fmt.Errorf("The user %s is missing the required write permissions: %s", username, strings.Join(missing, ", "))
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
@@ -0,0 +1,181 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// dhcpPolicyTypes are the policy types of the legacy DHCP policies API and the template objects API by DHCP policy kind.
+var dhcpPolicyTypes = map[string][2]string{
+	"relay":  {"relay", "dhcpRelay"},
+	"option": {"option", "dhcpOption"},
+}
+
+func dataSourceMSODHCPRelayPolicies() *schema.Resource {
+	return &schema.Resource{
+
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceMSODHCPPoliciesRead(d, m, "relay")
+		},
+
+		SchemaVersion: version,
+
+		Schema: dhcpPoliciesSchema(),
+	}
+}
+
+func dataSourceMSODHCPOptionPolicies() *schema.Resource {
+	return &schema.Resource{
+
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceMSODHCPPoliciesRead(d, m, "option")
+		},
+
+		SchemaVersion: version,
+
+		Schema: dhcpPoliciesSchema(),
+	}
+}
+
+func dhcpPoliciesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tenant_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"policies": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"tenant_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"template_id": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"template_name": &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"names": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// getDHCPPolicies returns the DHCP policies of a kind, which are tenant policy template objects from NDO 4.0 onwards and global policies before.
+// The policies of the common tenant are included when filtering on a tenant, because they can be used by the BDs of every tenant.
+func getDHCPPolicies(msoClient *client.Client, kind, tenantId string) ([]*container.Container, error) {
+	versionInt, err := msoClient.CompareVersion("4.0.0.0")
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*container.Container, 0)
+	if versionInt == -1 {
+		cont, err := msoClient.GetViaURL(addQueryFilters("api/v1/templates/objects", map[string]string{"type": dhcpPolicyTypes[kind][1], "tenant-id": tenantId, "include-common": "true"}))
+		if err != nil {
+			return nil, err
+		}
+		count, _ := cont.ArrayCount()
+		for i := 0; i < count; i++ {
+			policyCont, err := cont.ArrayElement(i)
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, policyCont)
+		}
+		return policies, nil
+	}
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/policies/dhcp/%s", dhcpPolicyTypes[kind][0]))
+	if err != nil {
+		return nil, err
+	}
+	// The option policies are returned in the DhcpRelayPolicies list by some versions.
+	listKey := "DhcpRelayPolicies"
+	if kind == "option" && cont.Exists("DhcpOptionPolicies") {
+		listKey = "DhcpOptionPolicies"
+	}
+	count, _ := cont.ArrayCount(listKey)
+	for i := 0; i < count; i++ {
+		policyCont, err := cont.ArrayElement(i, listKey)
+		if err != nil {
+			return nil, err
+		}
+		if tenantId == "" || convertInterfaceToString(policyCont.S("tenantId").Data()) == tenantId {
+			policies = append(policies, policyCont)
+		}
+	}
+	return policies, nil
+}
+
+func dataSourceMSODHCPPoliciesRead(d *schema.ResourceData, m interface{}, kind string) error {
+	log.Printf("[DEBUG] Beginning Read DHCP %s Policies", kind)
+	msoClient := m.(*client.Client)
+
+	tenantId := d.Get("tenant_id").(string)
+	policies, err := getDHCPPolicies(msoClient, kind, tenantId)
+	if err != nil {
+		return err
+	}
+
+	policyList := make([]interface{}, 0, len(policies))
+	names := make([]interface{}, 0, len(policies))
+	for _, policyCont := range policies {
+		id := convertInterfaceToString(policyCont.S("uuid").Data())
+		if id == "" {
+			id = convertInterfaceToString(policyCont.S("id").Data())
+		}
+		description := convertInterfaceToString(policyCont.S("description").Data())
+		if description == "" {
+			description = convertInterfaceToString(policyCont.S("desc").Data())
+		}
+		name := convertInterfaceToString(policyCont.S("name").Data())
+		policyList = append(policyList, map[string]interface{}{
+			"id":            id,
+			"name":          name,
+			"description":   description,
+			"tenant_id":     convertInterfaceToString(policyCont.S("tenantId").Data()),
+			"template_id":   convertInterfaceToString(policyCont.S("templateId").Data()),
+			"template_name": convertInterfaceToString(policyCont.S("templateName").Data()),
+		})
+		names = append(names, name)
+	}
+	d.Set("policies", policyList)
+	d.Set("names", names)
+
+	if tenantId != "" {
+		d.SetId(fmt.Sprintf("%s/dhcp/%s", tenantId, kind))
+	} else {
+		d.SetId(fmt.Sprintf("dhcp/%s", kind))
+	}
+	log.Printf("[DEBUG] %s: Read DHCP %s Policies finished successfully", d.Id(), kind)
+	return nil
+}
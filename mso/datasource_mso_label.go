@@ -23,6 +23,7 @@ func datasourceMSOLabel() *schema.Resource {
 			},
 			"type": &schema.Schema{
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 		}),
@@ -35,7 +36,8 @@ func datasourceMSOLabelRead(d *schema.ResourceData, m interface{}) error {
 	msoClient := m.(*client.Client)
 
 	name := d.Get("label").(string)
-	con, err := msoClient.GetViaURL("api/v1/labels")
+	labelType := d.Get("type").(string)
+	con, err := msoClient.GetViaURL(addQueryFilters("api/v1/labels", map[string]string{"displayName": name, "type": labelType}))
 	if err != nil {
 		return err
 	}
@@ -44,14 +46,15 @@ func datasourceMSOLabelRead(d *schema.ResourceData, m interface{}) error {
 	var cnt int
 	for _, info := range data {
 		val := info.(map[string]interface{})
-		if val["displayName"].(string) == name {
+		// The type is optional to find labels with the same name but a different type.
+		if val["displayName"].(string) == name && (labelType == "" || val["type"] == labelType) {
 			flag = true
 			break
 		}
 		cnt = cnt + 1
 	}
 	if flag != true {
-		return fmt.Errorf("Label of specified name not found")
+		return fmt.Errorf("Label of specified name %s not found", name)
 	}
 
 	dataCon := con.S("labels").Index(cnt)
@@ -1,6 +1,7 @@
 package mso
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -21,10 +22,23 @@ func datasourceMSORest() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"search": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
 			"content": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"search_results": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		}),
 	}
 }
@@ -38,8 +52,24 @@ func datasourceMSORestRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
+	searchResults := make(map[string]interface{})
+	for _, search := range d.Get("search").([]interface{}) {
+		searchPath := search.(string)
+		if !content.ExistsP(searchPath) {
+			return fmt.Errorf("Search path %s not found in the response of %s", searchPath, path)
+		}
+		// Strings are returned without quotes, all other values as JSON.
+		result := content.Path(searchPath)
+		if value, ok := result.Data().(string); ok {
+			searchResults[searchPath] = value
+		} else {
+			searchResults[searchPath] = result.String()
+		}
+	}
+
 	d.SetId(path)
 	d.Set("content", content.String())
+	d.Set("search_results", searchResults)
 
 	log.Printf("[DEBUG] %s: Read finished successfully", path)
 	return nil
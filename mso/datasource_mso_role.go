@@ -50,7 +50,7 @@ func datasourceMSORoleRead(d *schema.ResourceData, m interface{}) error {
 
 	msoClient := m.(*client.Client)
 	name := d.Get("name").(string)
-	con, err := msoClient.GetViaURL("api/v1/roles")
+	con, err := msoClient.GetViaURL(addQueryFilters("api/v1/roles", map[string]string{"name": name}))
 	if err != nil {
 		return err
 	}
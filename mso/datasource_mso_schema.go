@@ -76,26 +76,28 @@ func datasourceMSOSchemaRead(d *schema.ResourceData, m interface{}) error {
 	msoClient := m.(*client.Client)
 
 	name := d.Get("name").(string)
-	con, err := msoClient.GetViaURL("api/v1/schemas")
+	// Use the lightweight schema identity list to find the schema ID and only fetch the matching schema.
+	con, err := msoClient.GetViaURL(addQueryFilters("api/v1/schemas/list-identity", map[string]string{"displayName": name}))
 	if err != nil {
 		return err
 	}
 	data := con.S("schemas").Data().([]interface{})
-	var flag bool
-	var count int
+	var schemaId string
 	for _, info := range data {
 		val := info.(map[string]interface{})
 		if val["displayName"].(string) == name {
-			flag = true
+			schemaId = val["id"].(string)
 			break
 		}
-		count = count + 1
 	}
-	if flag != true {
+	if schemaId == "" {
 		return fmt.Errorf("Schema of specified name not found")
 	}
 
-	dataCon := con.S("schemas").Index(count)
+	dataCon, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
 	d.SetId(models.StripQuotes(dataCon.S("id").String()))
 	d.Set("name", models.StripQuotes(dataCon.S("displayName").String()))
 	d.Set("description", models.StripQuotes(dataCon.S("description").String()))
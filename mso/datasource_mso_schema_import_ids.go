@@ -0,0 +1,334 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMSOSchemaImportIds() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSchemaImportIdsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"resource_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resources": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"import_blocks": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+type importableResource struct {
+	resourceType string
+	name         string
+	importId     string
+}
+
+var invalidResourceNameRegex = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+
+// importResourceName converts the names of an object and its parents to a valid Terraform resource name.
+func importResourceName(names ...string) string {
+	name := invalidResourceNameRegex.ReplaceAllString(strings.Join(names, "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "_" + name
+	}
+	return name
+}
+
+// refName returns the name of the object a schema reference like /schemas/{id}/templates/{name}/anps/{name} points to.
+func refName(cont *container.Container, ref string) string {
+	tokens := strings.Split(models.StripQuotes(cont.S(ref).String()), "/")
+	return tokens[len(tokens)-1]
+}
+
+// getSchemaImportableResources returns the resources of a schema with the ids their importers expect.
+func getSchemaImportableResources(schemaId string, schemaCont *container.Container, siteNames map[string]string) []importableResource {
+	resources := []importableResource{
+		{"mso_schema", importResourceName(models.StripQuotes(schemaCont.S("displayName").String())), schemaId},
+	}
+
+	templateCount, _ := schemaCont.ArrayCount("templates")
+	for i := 0; i < templateCount; i++ {
+		templateCont, err := schemaCont.ArrayElement(i, "templates")
+		if err != nil {
+			continue
+		}
+		template := models.StripQuotes(templateCont.S("name").String())
+		templateId := fmt.Sprintf("%s/template/%s", schemaId, template)
+		resources = append(resources, importableResource{"mso_schema_template", importResourceName(template), templateId})
+
+		anpCount, _ := templateCont.ArrayCount("anps")
+		for j := 0; j < anpCount; j++ {
+			anpCont, err := templateCont.ArrayElement(j, "anps")
+			if err != nil {
+				continue
+			}
+			anp := models.StripQuotes(anpCont.S("name").String())
+			resources = append(resources, importableResource{"mso_schema_template_anp", importResourceName(template, anp), fmt.Sprintf("%s/anp/%s", templateId, anp)})
+			epgCount, _ := anpCont.ArrayCount("epgs")
+			for k := 0; k < epgCount; k++ {
+				epgCont, err := anpCont.ArrayElement(k, "epgs")
+				if err != nil {
+					continue
+				}
+				epg := models.StripQuotes(epgCont.S("name").String())
+				resources = append(resources, importableResource{"mso_schema_template_anp_epg", importResourceName(template, anp, epg), fmt.Sprintf("%s/anp/%s/epg/%s", templateId, anp, epg)})
+			}
+		}
+
+		for _, object := range []struct{ key, resourceType, idFormat string }{
+			{"bds", "mso_schema_template_bd", "%s/bd/%s"},
+			{"vrfs", "mso_schema_template_vrf", "%s/vrf/%s"},
+			{"externalEpgs", "mso_schema_template_external_epg", "%s/externalEPG/%s"},
+			{"intersiteL3outs", "mso_schema_template_l3out", "%s/l3out/%s"},
+			{"serviceGraphs", "mso_schema_template_service_graph", "%s/serviceGraph/%s"},
+		} {
+			objectCount, _ := templateCont.ArrayCount(object.key)
+			for j := 0; j < objectCount; j++ {
+				objectCont, err := templateCont.ArrayElement(j, object.key)
+				if err != nil {
+					continue
+				}
+				name := models.StripQuotes(objectCont.S("name").String())
+				resources = append(resources, importableResource{object.resourceType, importResourceName(template, name), fmt.Sprintf(object.idFormat, templateId, name)})
+			}
+		}
+
+		contractCount, _ := templateCont.ArrayCount("contracts")
+		for j := 0; j < contractCount; j++ {
+			contractCont, err := templateCont.ArrayElement(j, "contracts")
+			if err != nil {
+				continue
+			}
+			contract := models.StripQuotes(contractCont.S("name").String())
+			resources = append(resources, importableResource{"mso_schema_template_contract", importResourceName(template, contract), fmt.Sprintf("%s/templates/%s/contracts/%s", schemaId, template, contract)})
+		}
+
+		filterCount, _ := templateCont.ArrayCount("filters")
+		for j := 0; j < filterCount; j++ {
+			filterCont, err := templateCont.ArrayElement(j, "filters")
+			if err != nil {
+				continue
+			}
+			filter := models.StripQuotes(filterCont.S("name").String())
+			entryCount, _ := filterCont.ArrayCount("entries")
+			for k := 0; k < entryCount; k++ {
+				entryCont, err := filterCont.ArrayElement(k, "entries")
+				if err != nil {
+					continue
+				}
+				entry := models.StripQuotes(entryCont.S("name").String())
+				resources = append(resources, importableResource{"mso_schema_template_filter_entry", importResourceName(template, filter, entry), fmt.Sprintf("%s/filter/%s/entry/%s", templateId, filter, entry)})
+			}
+		}
+	}
+
+	importedSites := make(map[string]bool)
+	siteCount, _ := schemaCont.ArrayCount("sites")
+	for i := 0; i < siteCount; i++ {
+		siteCont, err := schemaCont.ArrayElement(i, "sites")
+		if err != nil {
+			continue
+		}
+		siteId := models.StripQuotes(siteCont.S("siteId").String())
+		template := models.StripQuotes(siteCont.S("templateName").String())
+		siteName, ok := siteNames[siteId]
+		if !ok {
+			siteName = siteId
+		}
+		// The mso_schema_site importer looks up the site by name and only supports one template per site.
+		if ok && !importedSites[siteId] {
+			importedSites[siteId] = true
+			resources = append(resources, importableResource{"mso_schema_site", importResourceName(siteName), fmt.Sprintf("%s/site/%s", schemaId, siteName)})
+		}
+		siteIdPrefix := fmt.Sprintf("%s/site/%s", schemaId, siteId)
+
+		anpCount, _ := siteCont.ArrayCount("anps")
+		for j := 0; j < anpCount; j++ {
+			anpCont, err := siteCont.ArrayElement(j, "anps")
+			if err != nil {
+				continue
+			}
+			anp := refName(anpCont, "anpRef")
+			resources = append(resources, importableResource{"mso_schema_site_anp", importResourceName(siteName, template, anp), fmt.Sprintf("%s/anp/%s", siteIdPrefix, anp)})
+			epgCount, _ := anpCont.ArrayCount("epgs")
+			for k := 0; k < epgCount; k++ {
+				epgCont, err := anpCont.ArrayElement(k, "epgs")
+				if err != nil {
+					continue
+				}
+				epg := refName(epgCont, "epgRef")
+				epgId := fmt.Sprintf("%s/template/%s/anp/%s/epg/%s", siteIdPrefix, template, anp, epg)
+				resources = append(resources, importableResource{"mso_schema_site_anp_epg", importResourceName(siteName, template, anp, epg), epgId})
+
+				// All static ports of the EPG are imported at once with the bulk resource, or one by one with the static port resource.
+				portCount, _ := epgCont.ArrayCount("staticPorts")
+				if portCount > 0 {
+					resources = append(resources, importableResource{"mso_schema_site_anp_epg_bulk_staticport", importResourceName(siteName, template, anp, epg), epgId})
+				}
+				for l := 0; l < portCount; l++ {
+					portCont, err := epgCont.ArrayElement(l, "staticPorts")
+					if err != nil {
+						continue
+					}
+					pathType := models.StripQuotes(portCont.S("type").String())
+					path := parseStaticPortPath(models.StripQuotes(portCont.S("path").String()))
+					resources = append(resources, importableResource{
+						"mso_schema_site_anp_epg_static_port",
+						importResourceName(siteName, template, anp, epg, path["leafValue"], path["pathValue"]),
+						fmt.Sprintf("%s/staticPortPod/%s/staticPortLeaf/%s/pathType/%s/fex/%s/path/%s", epgId, path["podValue"], path["leafValue"], pathType, path["fexValue"], path["pathValue"]),
+					})
+				}
+			}
+		}
+
+		bdCount, _ := siteCont.ArrayCount("bds")
+		for j := 0; j < bdCount; j++ {
+			bdCont, err := siteCont.ArrayElement(j, "bds")
+			if err != nil {
+				continue
+			}
+			bd := refName(bdCont, "bdRef")
+			resources = append(resources, importableResource{"mso_schema_site_bd", importResourceName(siteName, template, bd), fmt.Sprintf("%s/%s/%s/%s", schemaId, siteId, template, bd)})
+		}
+
+		for _, object := range []struct{ key, ref, resourceType, idFormat string }{
+			{"vrfs", "vrfRef", "mso_schema_site_vrf", "%s/vrf/%s"},
+			{"externalEpgs", "externalEpgRef", "mso_schema_site_external_epg", "%s/externalEPG/%s"},
+		} {
+			objectCount, _ := siteCont.ArrayCount(object.key)
+			for j := 0; j < objectCount; j++ {
+				objectCont, err := siteCont.ArrayElement(j, object.key)
+				if err != nil {
+					continue
+				}
+				name := refName(objectCont, object.ref)
+				resources = append(resources, importableResource{object.resourceType, importResourceName(siteName, template, name), fmt.Sprintf(object.idFormat, siteIdPrefix, name)})
+			}
+		}
+
+		externalEpgCount, _ := siteCont.ArrayCount("externalEpgs")
+		for j := 0; j < externalEpgCount; j++ {
+			externalEpgCont, err := siteCont.ArrayElement(j, "externalEpgs")
+			if err != nil {
+				continue
+			}
+			externalEpg := refName(externalEpgCont, "externalEpgRef")
+			subnetCount, _ := externalEpgCont.ArrayCount("subnets")
+			for k := 0; k < subnetCount; k++ {
+				subnetCont, err := externalEpgCont.ArrayElement(k, "subnets")
+				if err != nil {
+					continue
+				}
+				ip := models.StripQuotes(subnetCont.S("ip").String())
+				resources = append(resources, importableResource{"mso_schema_site_external_epg_subnet", importResourceName(siteName, template, externalEpg, ip), fmt.Sprintf("%s/template/%s/externalEPG/%s/ip/%s", siteIdPrefix, template, externalEpg, ip)})
+			}
+		}
+	}
+	return resources
+}
+
+func dataSourceMSOSchemaImportIdsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Schema Import Ids")
+	msoClient := m.(*client.Client)
+
+	schemaId := d.Get("schema_id").(string)
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+
+	siteNames := make(map[string]string)
+	sitesCont, err := msoClient.GetViaURL("api/v1/sites")
+	if err != nil {
+		return err
+	}
+	if sites, ok := sitesCont.S("sites").Data().([]interface{}); ok {
+		for _, site := range sites {
+			if siteMap, ok := site.(map[string]interface{}); ok {
+				siteNames[convertInterfaceToString(siteMap["id"])] = convertInterfaceToString(siteMap["name"])
+			}
+		}
+	}
+
+	resourceTypes := make(map[string]bool)
+	for _, resourceType := range d.Get("resource_types").(*schema.Set).List() {
+		resourceTypes[resourceType.(string)] = true
+	}
+
+	resourceList := make([]interface{}, 0, 1)
+	usedAddresses := make(map[string]bool)
+	var importBlocks strings.Builder
+	for _, resource := range getSchemaImportableResources(schemaId, schemaCont, siteNames) {
+		if len(resourceTypes) > 0 && !resourceTypes[resource.resourceType] {
+			continue
+		}
+		name := resource.name
+		for i := 2; usedAddresses[resource.resourceType+"."+name]; i++ {
+			name = fmt.Sprintf("%s_%d", resource.name, i)
+		}
+		address := resource.resourceType + "." + name
+		usedAddresses[address] = true
+
+		resourceList = append(resourceList, map[string]interface{}{
+			"resource_type": resource.resourceType,
+			"name":          name,
+			"address":       address,
+			"import_id":     resource.importId,
+		})
+		fmt.Fprintf(&importBlocks, "import {\n  to = %s\n  id = %q\n}\n\n", address, resource.importId)
+	}
+	d.Set("resources", resourceList)
+	d.Set("import_blocks", importBlocks.String())
+
+	d.SetId(fmt.Sprintf("%s/import_ids", schemaId))
+	log.Printf("[DEBUG] %s: Read Schema Import Ids finished successfully", d.Id())
+	return nil
+}
@@ -96,7 +96,7 @@ func dataSourceMSOSchemaSiteContractServiceGraphRead(d *schema.ResourceData, m i
 	siteID := d.Get("site_id").(string)
 
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
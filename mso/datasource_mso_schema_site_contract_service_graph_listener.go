@@ -255,7 +255,7 @@ func dataSourceMSOSchemaSiteContractServiceGraphListenerRead(d *schema.ResourceD
 	contractName := d.Get("contract_name").(string)
 
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
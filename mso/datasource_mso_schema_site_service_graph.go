@@ -3,8 +3,11 @@ package mso
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -62,6 +65,26 @@ func datasourceMSOSchemaSiteServiceGraph() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_tenant": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -69,6 +92,52 @@ func datasourceMSOSchemaSiteServiceGraph() *schema.Resource {
 	}
 }
 
+// serviceDeviceDnRegex matches the tenant and the name of a service device in its dn, for example uni/tn-tenant1/lDevVip-device1.
+var serviceDeviceDnRegex = regexp.MustCompile(`^uni/tn-([^/]+)/[A-Za-z]+-(.+)$`)
+
+// addServiceNodeDetails adds the name, index and type of the template service node and the name and tenant of the device to the site service nodes.
+// The site service nodes reference their template node by name, and are in the order of the template nodes when the reference is missing.
+func addServiceNodeDetails(msoClient *client.Client, serviceNodeList []interface{}, graphCont, templateGraphCont *container.Container) error {
+	siteNodes, _ := graphCont.S("serviceNodes").Data().([]interface{})
+	templateNodes, _ := templateGraphCont.S("serviceNodes").Data().([]interface{})
+	for i, serviceNode := range serviceNodeList {
+		serviceNodeMap := serviceNode.(map[string]interface{})
+		siteNode, _ := siteNodes[i].(map[string]interface{})
+
+		var nodeName string
+		switch ref := siteNode["serviceNodeRef"].(type) {
+		case string:
+			nodeName = ref[strings.LastIndex(ref, "/")+1:]
+		case map[string]interface{}:
+			nodeName = convertInterfaceToString(ref["serviceNodeName"])
+		}
+
+		var templateNode map[string]interface{}
+		for j, node := range templateNodes {
+			nodeMap, _ := node.(map[string]interface{})
+			if (nodeName != "" && nodeMap["name"] == nodeName) || (nodeName == "" && i == j) {
+				templateNode = nodeMap
+				break
+			}
+		}
+		if templateNode != nil {
+			nodeType, err := getNodeNameFromId(msoClient, convertInterfaceToString(templateNode["serviceNodeTypeId"]))
+			if err != nil {
+				return err
+			}
+			serviceNodeMap["name"] = convertInterfaceToString(templateNode["name"])
+			serviceNodeMap["index"] = convertInterfaceToInt(templateNode["index"])
+			serviceNodeMap["type"] = nodeType
+		}
+
+		if match := serviceDeviceDnRegex.FindStringSubmatch(convertInterfaceToString(serviceNodeMap["device_dn"])); match != nil {
+			serviceNodeMap["device_tenant"] = match[1]
+			serviceNodeMap["device_name"] = match[2]
+		}
+	}
+	return nil
+}
+
 func dataSourceMSOSchemaSiteServiceGraphRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Beginning datasource Read")
 	msoClient := m.(*client.Client)
@@ -78,7 +147,7 @@ func dataSourceMSOSchemaSiteServiceGraphRead(d *schema.ResourceData, m interface
 	siteId := d.Get("site_id").(string)
 	graphName := d.Get("service_graph_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -90,6 +159,17 @@ func dataSourceMSOSchemaSiteServiceGraphRead(d *schema.ResourceData, m interface
 	}
 
 	serviceNodeList, err := setServiceNodeList(graphCont)
+	if err != nil {
+		return err
+	}
+	templateGraphCont, _, err := getTemplateServiceGraphCont(cont, templateName, graphName)
+	if err != nil {
+		return err
+	}
+	err = addServiceNodeDetails(msoClient, serviceNodeList, graphCont, templateGraphCont)
+	if err != nil {
+		return err
+	}
 	d.Set("service_node", serviceNodeList)
 
 	d.Set("schema_id", schemaId)
@@ -1,7 +1,6 @@
 package mso
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -77,7 +76,7 @@ func dataSourceMSOSchemaSiteVrfRouteLeakRead(d *schema.ResourceData, m interface
 	templateName := d.Get("template_name").(string)
 	vrfName := d.Get("vrf_name").(string)
 
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
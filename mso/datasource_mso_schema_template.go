@@ -54,7 +54,7 @@ func datasourceMSOSchemaTemplateRead(d *schema.ResourceData, m interface{}) erro
 	msoClient := m.(*client.Client)
 	name := d.Get("name").(string)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
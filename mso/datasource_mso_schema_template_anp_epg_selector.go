@@ -72,7 +72,7 @@ func datasourceMSOSchemaTemplateAnpEpgSelectorRead(d *schema.ResourceData, m int
 	anpName := d.Get("anp_name").(string)
 	epgName := d.Get("epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
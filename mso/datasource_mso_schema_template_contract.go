@@ -1,7 +1,6 @@
 package mso
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -39,6 +38,10 @@ func dataSourceMSOTemplateContract() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"reverse_filter_ports": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"scope": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -85,6 +88,10 @@ func dataSourceMSOTemplateContract() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -127,7 +134,7 @@ func dataSourceMSOTemplateContractRead(d *schema.ResourceData, m interface{}) er
 	schemaId := d.Get("schema_id").(string)
 	templateName := d.Get("template_name").(string)
 	contractName := d.Get("contract_name").(string)
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
 	}
@@ -1,7 +1,6 @@
 package mso
 
 import (
-	"fmt"
 	"log"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -71,6 +70,10 @@ func dataSourceMSOTemplateContractFilter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		}),
 	}
 }
@@ -93,7 +96,7 @@ func dataSourceMSOTemplateContractFilterRead(d *schema.ResourceData, m interface
 		filterTemplateName = tempVar.(string)
 	}
 
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
 	}
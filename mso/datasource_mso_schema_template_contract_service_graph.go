@@ -84,7 +84,7 @@ func dataSourceMSOSchemaTemplateContractServiceGraphRead(d *schema.ResourceData,
 	contractName := d.Get("contract_name").(string)
 
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
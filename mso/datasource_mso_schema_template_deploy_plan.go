@@ -0,0 +1,161 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceMSOSchemaTemplateDeployPlan() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOSchemaTemplateDeployPlanRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"sites": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"site_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"objects": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dn": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"has_changes": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// deployPlanActions maps the actions of the deployment plan to the actions of the data source.
+var deployPlanActions = map[string]string{
+	"add":    "create",
+	"create": "create",
+	"update": "modify",
+	"modify": "modify",
+	"delete": "delete",
+	"remove": "delete",
+}
+
+// getDeployPlanObjects returns the objects of the deployment plan of a site.
+// Unknown actions are returned in lower case, so newer versions do not hide their changes.
+func getDeployPlanObjects(siteMap map[string]interface{}) []interface{} {
+	objects := make([]interface{}, 0)
+	planObjects, _ := siteMap["objects"].([]interface{})
+	for _, planObject := range planObjects {
+		objectMap, ok := planObject.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		action := strings.ToLower(convertInterfaceToString(objectMap["action"]))
+		if mappedAction, ok := deployPlanActions[action]; ok {
+			action = mappedAction
+		}
+		objects = append(objects, map[string]interface{}{
+			"action": action,
+			"type":   convertInterfaceToString(objectMap["type"]),
+			"name":   convertInterfaceToString(objectMap["name"]),
+			"dn":     convertInterfaceToString(objectMap["dn"]),
+		})
+	}
+	return objects
+}
+
+func datasourceMSOSchemaTemplateDeployPlanRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+	siteId := d.Get("site_id").(string)
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/deploy/plan/schema/%s/template/%s", schemaId, templateName))
+	if err != nil {
+		return err
+	}
+
+	sites := make([]map[string]interface{}, 0)
+	planSites, _ := cont.S("sites").Data().([]interface{})
+	for _, planSite := range planSites {
+		siteMap, ok := planSite.(map[string]interface{})
+		if !ok || (siteId != "" && siteMap["siteId"] != siteId) {
+			continue
+		}
+		sites = append(sites, map[string]interface{}{
+			"site_id":   convertInterfaceToString(siteMap["siteId"]),
+			"site_name": convertInterfaceToString(siteMap["siteName"]),
+			"objects":   getDeployPlanObjects(siteMap),
+		})
+	}
+	sort.SliceStable(sites, func(i, j int) bool {
+		return sites[i]["site_id"].(string) < sites[j]["site_id"].(string)
+	})
+
+	hasChanges := false
+	plan := make([]interface{}, 0, len(sites))
+	for _, site := range sites {
+		hasChanges = hasChanges || len(site["objects"].([]interface{})) > 0
+		plan = append(plan, site)
+	}
+
+	d.SetId(fmt.Sprintf("%s/templates/%s/deployPlan", schemaId, templateName))
+	d.Set("sites", plan)
+	d.Set("has_changes", hasChanges)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
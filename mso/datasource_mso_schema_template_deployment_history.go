@@ -0,0 +1,185 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceMSOSchemaTemplateDeploymentHistory() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOSchemaTemplateDeploymentHistoryRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"limit": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"deployments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"undeploy": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sites": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"site_id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"site_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"last_successful_task_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_successful_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// getDeploymentTaskSites returns the sites of a deploy task with their status, sorted by site id.
+func getDeploymentTaskSites(operDetails map[string]interface{}) []interface{} {
+	sites := make([]interface{}, 0)
+	siteStatus, _ := operDetails["siteStatus"].(map[string]interface{})
+	siteIds := make([]string, 0, len(siteStatus))
+	for siteId := range siteStatus {
+		siteIds = append(siteIds, siteId)
+	}
+	sort.Strings(siteIds)
+	for _, siteId := range siteIds {
+		siteMap, _ := siteStatus[siteId].(map[string]interface{})
+		statusMap, _ := siteMap["status"].(map[string]interface{})
+		sites = append(sites, map[string]interface{}{
+			"site_id":   siteId,
+			"site_name": convertInterfaceToString(siteMap["siteName"]),
+			"status":    convertInterfaceToString(statusMap["status"]),
+		})
+	}
+	return sites
+}
+
+func datasourceMSOSchemaTemplateDeploymentHistoryRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+	cont, err := msoClient.GetViaURL(addQueryFilters("api/v1/task", map[string]string{"schemaId": schemaId, "templateName": templateName}))
+	if err != nil {
+		return err
+	}
+
+	deployments := make([]map[string]interface{}, 0)
+	tasks, _ := cont.S("tasks").Data().([]interface{})
+	for _, task := range tasks {
+		taskMap, ok := task.(map[string]interface{})
+		// The filters are not supported by all versions, so the tasks of other templates are skipped here.
+		if !ok || taskMap["schemaId"] != schemaId || taskMap["templateName"] != templateName {
+			continue
+		}
+		operDetails, _ := taskMap["operDetails"].(map[string]interface{})
+		undeploy, _ := taskMap["undeploy"].([]interface{})
+		user := convertInterfaceToString(taskMap["user"])
+		if user == "" {
+			user = convertInterfaceToString(taskMap["createdBy"])
+		}
+		deployments = append(deployments, map[string]interface{}{
+			"task_id":    convertInterfaceToString(taskMap["id"]),
+			"user":       user,
+			"status":     convertInterfaceToString(operDetails["taskStatus"]),
+			"start_time": convertInterfaceToString(operDetails["startTime"]),
+			"end_time":   convertInterfaceToString(operDetails["endTime"]),
+			"undeploy":   len(undeploy) > 0,
+			"sites":      getDeploymentTaskSites(operDetails),
+		})
+	}
+	// The timestamps are in RFC 3339 format, so the most recent deployment sorts first.
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i]["start_time"].(string) > deployments[j]["start_time"].(string)
+	})
+
+	lastSuccessfulTaskId, lastSuccessfulTime := "", ""
+	for _, deployment := range deployments {
+		if deployment["status"] == "Complete" && deployment["undeploy"] == false {
+			lastSuccessfulTaskId = deployment["task_id"].(string)
+			lastSuccessfulTime = deployment["end_time"].(string)
+			break
+		}
+	}
+	if limit, ok := d.GetOk("limit"); ok && limit.(int) < len(deployments) {
+		deployments = deployments[:limit.(int)]
+	}
+
+	history := make([]interface{}, 0, len(deployments))
+	for _, deployment := range deployments {
+		history = append(history, deployment)
+	}
+
+	d.SetId(fmt.Sprintf("%s/templates/%s/deploymentHistory", schemaId, templateName))
+	d.Set("deployments", history)
+	d.Set("last_successful_task_id", lastSuccessfulTaskId)
+	d.Set("last_successful_time", lastSuccessfulTime)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
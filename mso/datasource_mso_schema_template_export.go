@@ -0,0 +1,115 @@
+package mso
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// schemaExportExcludedKeys are the keys of a schema which are generated by NDO and not part of an export.
+var schemaExportExcludedKeys = []string{"id", "_updateVersion"}
+
+func datasourceMSOSchemaTemplateExport() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOSchemaTemplateExportRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"json": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+func datasourceMSOSchemaTemplateExportRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+
+	export, err := exportSchemaTemplate(cont.Data(), templateName)
+	if err != nil {
+		return err
+	}
+	exportJson, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	d.Set("json", string(exportJson))
+
+	if templateName != "" {
+		d.SetId(fmt.Sprintf("%s/templates/%s/export", schemaId, templateName))
+	} else {
+		d.SetId(fmt.Sprintf("%s/export", schemaId))
+	}
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+// exportSchemaTemplate returns the schema in the NDO export format without the keys generated by NDO.
+// When templateName is provided only the template and its site associations are included.
+func exportSchemaTemplate(schemaData interface{}, templateName string) (map[string]interface{}, error) {
+	schemaMap, ok := schemaData.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Unable to parse the schema")
+	}
+
+	export := make(map[string]interface{})
+	for key, value := range schemaMap {
+		if !valueInSliceofStrings(key, schemaExportExcludedKeys) {
+			export[key] = value
+		}
+	}
+	if templateName == "" {
+		return export, nil
+	}
+
+	templates := make([]interface{}, 0, 1)
+	if schemaTemplates, ok := schemaMap["templates"].([]interface{}); ok {
+		for _, template := range schemaTemplates {
+			if templateMap, ok := template.(map[string]interface{}); ok && templateMap["name"] == templateName {
+				templates = append(templates, template)
+			}
+		}
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("Template %s is not found in Schema.", templateName)
+	}
+	export["templates"] = templates
+
+	sites := make([]interface{}, 0, 1)
+	if schemaSites, ok := schemaMap["sites"].([]interface{}); ok {
+		for _, site := range schemaSites {
+			if siteMap, ok := site.(map[string]interface{}); ok && siteMap["templateName"] == templateName {
+				sites = append(sites, site)
+			}
+		}
+	}
+	export["sites"] = sites
+
+	return export, nil
+}
@@ -66,7 +66,7 @@ func dataSourceMSOTemplateExternalEpgContractRead(d *schema.ResourceData, m inte
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
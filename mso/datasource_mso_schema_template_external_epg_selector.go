@@ -66,7 +66,7 @@ func datasourceSchemaTemplateExternalEPGSelectorRead(d *schema.ResourceData, m i
 	externalEpgName := d.Get("external_epg_name").(string)
 	name := d.Get("name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -102,7 +102,7 @@ func dataSourceMSOSchemaTemplateFilterEntryRead(d *schema.ResourceData, m interf
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -64,7 +64,7 @@ func dataSourceMSOTemplateL3outRead(d *schema.ResourceData, m interface{}) error
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
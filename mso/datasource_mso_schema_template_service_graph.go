@@ -49,6 +49,18 @@ func dataSourceMSOSchemaTemplateServiceGraph() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"type_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -66,7 +78,7 @@ func dataSourceMSOSchemaTemplateServiceGraphRead(d *schema.ResourceData, m inter
 	msoClient := m.(*client.Client)
 
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -90,12 +102,16 @@ func dataSourceMSOSchemaTemplateServiceGraphRead(d *schema.ResourceData, m inter
 	} else {
 		serviceNodeList := make([]interface{}, 0, 1)
 		for _, val := range sgCont.S("serviceNodes").Data().([]interface{}) {
-			nodeType, err := getNodeNameFromId(msoClient, models.StripQuotes(val.(map[string]interface{})["serviceNodeTypeId"].(string)))
+			nodeTypeId := models.StripQuotes(val.(map[string]interface{})["serviceNodeTypeId"].(string))
+			nodeType, err := getNodeNameFromId(msoClient, nodeTypeId)
 			if err != nil {
 				return err
 			}
 			serviceNodeMap := map[string]interface{}{
-				"type": nodeType,
+				"type":    nodeType,
+				"type_id": nodeTypeId,
+				"name":    convertInterfaceToString(val.(map[string]interface{})["name"]),
+				"index":   convertInterfaceToInt(val.(map[string]interface{})["index"]),
 			}
 
 			serviceNodeList = append(serviceNodeList, serviceNodeMap)
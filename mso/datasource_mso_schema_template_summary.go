@@ -0,0 +1,124 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// templateSummaryObjectTypes maps the attribute prefixes of the summary to the object lists of a template.
+var templateSummaryObjectTypes = map[string]string{
+	"anp":           "anps",
+	"bd":            "bds",
+	"vrf":           "vrfs",
+	"contract":      "contracts",
+	"service_graph": "serviceGraphs",
+}
+
+func datasourceMSOSchemaTemplateSummary() *schema.Resource {
+	summarySchema := map[string]*schema.Schema{
+		"schema_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"template_name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"epg_names": &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"counts": &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeInt},
+		},
+	}
+	for objectType := range templateSummaryObjectTypes {
+		summarySchema[fmt.Sprintf("%s_names", objectType)] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Resource{
+
+		Read: datasourceMSOSchemaTemplateSummaryRead,
+
+		SchemaVersion: version,
+
+		Schema: summarySchema,
+	}
+}
+
+// getTemplateObjectNames returns the sorted names of the objects in the list of the template.
+func getTemplateObjectNames(template map[string]interface{}, list string) []string {
+	names := make([]string, 0)
+	objects, _ := template[list].([]interface{})
+	for _, object := range objects {
+		if objectMap, ok := object.(map[string]interface{}); ok {
+			names = append(names, convertInterfaceToString(objectMap["name"]))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func datasourceMSOSchemaTemplateSummaryRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+
+	var template map[string]interface{}
+	templates, _ := cont.S("templates").Data().([]interface{})
+	for _, templateData := range templates {
+		if templateMap, ok := templateData.(map[string]interface{}); ok && templateMap["name"] == templateName {
+			template = templateMap
+			break
+		}
+	}
+	if template == nil {
+		return fmt.Errorf("Template %s not found in schema %s", templateName, schemaId)
+	}
+
+	counts := make(map[string]interface{})
+	for objectType, list := range templateSummaryObjectTypes {
+		names := getTemplateObjectNames(template, list)
+		d.Set(fmt.Sprintf("%s_names", objectType), names)
+		counts[list] = len(names)
+	}
+
+	// EPGs are named by their ANP, because EPG names are only unique within an ANP.
+	epgNames := make([]string, 0)
+	anps, _ := template["anps"].([]interface{})
+	for _, anp := range anps {
+		if anpMap, ok := anp.(map[string]interface{}); ok {
+			for _, epgName := range getTemplateObjectNames(anpMap, "epgs") {
+				epgNames = append(epgNames, fmt.Sprintf("%s/%s", convertInterfaceToString(anpMap["name"]), epgName))
+			}
+		}
+	}
+	sort.Strings(epgNames)
+	d.Set("epg_names", epgNames)
+	counts["epgs"] = len(epgNames)
+	d.Set("counts", counts)
+
+	d.SetId(fmt.Sprintf("%s/templates/%s/summary", schemaId, templateName))
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
@@ -61,13 +61,21 @@ func datasourceMSOSchemaTemplateVrf() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"policy_control_enforcement_preference": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_control_enforcement_direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		}),
 	}
 }
 func datasourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) error {
 	schemaId := d.Get("schema_id").(string)
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -125,6 +133,12 @@ func datasourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) e
 						siteAwarePolicyEnforcementMode, _ := strconv.ParseBool(models.StripQuotes(vrfCont.S("siteAwarePolicyEnforcementMode").String()))
 						d.Set("site_aware_policy_enforcement", siteAwarePolicyEnforcementMode)
 					}
+					if vrfCont.Exists("pcEnfPref") {
+						d.Set("policy_control_enforcement_preference", models.StripQuotes(vrfCont.S("pcEnfPref").String()))
+					}
+					if vrfCont.Exists("pcEnfDir") {
+						d.Set("policy_control_enforcement_direction", models.StripQuotes(vrfCont.S("pcEnfDir").String()))
+					}
 					found = true
 					break
 				}
@@ -71,7 +71,7 @@ func dataSourceMSOTemplateVRFContractRead(d *schema.ResourceData, m interface{})
 	schemaId := d.Get("schema_id").(string)
 	relationshipType := d.Get("relationship_type").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
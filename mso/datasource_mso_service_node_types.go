@@ -0,0 +1,80 @@
+package mso
+
+import (
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceMSOServiceNodeTypes() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOServiceNodeTypesRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"service_node_types": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOServiceNodeTypesRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Service Node Types")
+
+	msoClient := m.(*client.Client)
+
+	cont, err := msoClient.GetViaURL("api/v1/schemas/service-node-types")
+	if err != nil {
+		return err
+	}
+
+	nodesCount, _ := cont.ArrayCount("serviceNodeTypes")
+	nodeTypes := make([]interface{}, 0, nodesCount)
+	ids := make(map[string]interface{})
+	for i := 0; i < nodesCount; i++ {
+		nodeCont, err := cont.ArrayElement(i, "serviceNodeTypes")
+		if err != nil {
+			return err
+		}
+		nodeType := map[string]interface{}{
+			"id":           models.StripQuotes(nodeCont.S("id").String()),
+			"name":         models.StripQuotes(nodeCont.S("name").String()),
+			"display_name": models.StripQuotes(nodeCont.S("displayName").String()),
+		}
+		nodeTypes = append(nodeTypes, nodeType)
+		ids[nodeType["name"].(string)] = nodeType["id"]
+	}
+	d.Set("service_node_types", nodeTypes)
+	d.Set("ids", ids)
+
+	d.SetId("service-node-types")
+	log.Printf("[DEBUG] %s: Read Service Node Types finished successfully", d.Id())
+	return nil
+}
@@ -3,6 +3,7 @@ package mso
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/models"
@@ -93,6 +94,10 @@ func datasourceMSOSite() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			"controller_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cloud_providers": &schema.Schema{
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -114,7 +119,7 @@ func datasourceMSOSiteRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		path = "api/v1/sites"
 	}
-	con, err := msoClient.GetViaURL(path)
+	con, err := msoClient.GetViaURL(addQueryFilters(path, map[string]string{"name": name}))
 	if err != nil {
 		return err
 	}
@@ -245,8 +250,26 @@ func datasourceMSOSiteRead(d *schema.ResourceData, m interface{}) error {
 		if dataCon.Exists("cloudProviders") {
 			d.Set("cloud_providers", dataCon.S("cloudProviders").Data().([]interface{}))
 		}
+
+		if dataCon.Exists("platform") {
+			d.Set("type", models.StripQuotes(dataCon.S("platform").String()))
+		}
 	}
 
+	d.Set("controller_url", getSiteControllerUrl(d.Get("urls").([]interface{})))
+
 	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
 	return nil
 }
+
+// getSiteControllerUrl returns the first controller URL of a site with a https scheme when the URL has no scheme.
+func getSiteControllerUrl(urls []interface{}) string {
+	if len(urls) == 0 {
+		return ""
+	}
+	controllerUrl := urls[0].(string)
+	if !strings.Contains(controllerUrl, "://") {
+		controllerUrl = "https://" + controllerUrl
+	}
+	return controllerUrl
+}
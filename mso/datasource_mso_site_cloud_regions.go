@@ -0,0 +1,117 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMSOSiteCloudRegions() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSiteCloudRegionsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"region": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zones": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOSiteCloudRegionsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Site Cloud Regions")
+	msoClient := m.(*client.Client)
+
+	siteId := d.Get("site_id").(string)
+	regionName := d.Get("region").(string)
+
+	regions, err := getSiteCloudRegions(msoClient, siteId)
+	if err != nil {
+		return err
+	}
+
+	regionList := make([]interface{}, 0, 1)
+	for _, region := range regions {
+		regionMap, ok := region.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := convertInterfaceToString(regionMap["name"])
+		if regionName != "" && name != regionName {
+			continue
+		}
+		regionList = append(regionList, map[string]interface{}{
+			"name":  name,
+			"zones": getCloudRegionZones(regionMap),
+		})
+	}
+	if regionName != "" && len(regionList) == 0 {
+		return fmt.Errorf("Region %s is not available on site %s", regionName, siteId)
+	}
+	d.Set("regions", regionList)
+
+	d.SetId(fmt.Sprintf("%s/regions", siteId))
+	log.Printf("[DEBUG] %s: Read Site Cloud Regions finished successfully", d.Id())
+	return nil
+}
+
+// getSiteCloudRegions returns the regions that are managed by the cloud controller of a site.
+func getSiteCloudRegions(msoClient *client.Client, siteId string) ([]interface{}, error) {
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/cloud/regions", siteId))
+	if err != nil {
+		return nil, err
+	}
+
+	regions, ok := cont.S("regions").Data().([]interface{})
+	if !ok {
+		return make([]interface{}, 0), nil
+	}
+	return regions, nil
+}
+
+// getCloudRegionZones returns the names of the availability zones of a region, which are returned as names or as objects with a name.
+func getCloudRegionZones(region map[string]interface{}) []interface{} {
+	zoneList := make([]interface{}, 0, 1)
+	zones, ok := region["zones"].([]interface{})
+	if !ok {
+		zones, _ = region["availabilityZones"].([]interface{})
+	}
+	for _, zone := range zones {
+		if zoneMap, ok := zone.(map[string]interface{}); ok {
+			zoneList = append(zoneList, convertInterfaceToString(zoneMap["name"]))
+		} else {
+			zoneList = append(zoneList, convertInterfaceToString(zone))
+		}
+	}
+	return zoneList
+}
@@ -0,0 +1,144 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var domainDnRegexes = []struct {
+	domainType string
+	regex      *regexp.Regexp
+}{
+	{"vmmDomain", regexp.MustCompile("^uni/vmmp-([^/]+)/dom-(.+)$")},
+	{"l3ExtDomain", regexp.MustCompile("^uni/l3dom-(.+)$")},
+	{"l2ExtDomain", regexp.MustCompile("^uni/l2dom-(.+)$")},
+	{"physicalDomain", regexp.MustCompile("^uni/phys-(.+)$")},
+	{"fibreChannelDomain", regexp.MustCompile("^uni/fc-(.+)$")},
+}
+
+func dataSourceMSOSiteDomains() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSiteDomainsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"domain_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"vmmDomain",
+					"l3ExtDomain",
+					"l2ExtDomain",
+					"physicalDomain",
+					"fibreChannelDomain",
+				}, false),
+			},
+			"domains": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vmm_domain_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOSiteDomainsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Site Domains")
+	msoClient := m.(*client.Client)
+
+	siteId := d.Get("site_id").(string)
+	domainType := d.Get("domain_type").(string)
+
+	domains, err := getSiteDomains(msoClient, siteId)
+	if err != nil {
+		return err
+	}
+
+	domainList := make([]interface{}, 0, 1)
+	for _, domain := range domains {
+		domainMap, ok := domain.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dn := convertInterfaceToString(domainMap["dn"])
+		apiDomainType, vmmDomainType, name := parseDomainDn(dn)
+		if domainType != "" && apiDomainType != domainType {
+			continue
+		}
+		if apiName := convertInterfaceToString(domainMap["name"]); apiName != "" {
+			name = apiName
+		}
+
+		domainList = append(domainList, map[string]interface{}{
+			"dn":              dn,
+			"name":            name,
+			"domain_type":     apiDomainType,
+			"vmm_domain_type": vmmDomainType,
+		})
+	}
+	d.Set("domains", domainList)
+
+	d.SetId(fmt.Sprintf("%s/domains", siteId))
+	log.Printf("[DEBUG] %s: Read Site Domains finished successfully", d.Id())
+	return nil
+}
+
+// getSiteDomains returns the domains that are configured on the APIC of a site.
+func getSiteDomains(msoClient *client.Client, siteId string) ([]interface{}, error) {
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/aci/domains", siteId))
+	if err != nil {
+		return nil, err
+	}
+
+	domains, ok := cont.S("domains").Data().([]interface{})
+	if !ok {
+		return make([]interface{}, 0), nil
+	}
+	return domains, nil
+}
+
+// parseDomainDn returns the domain type, vmm domain type and name of a domain dn.
+func parseDomainDn(dn string) (string, string, string) {
+	for _, domainDnRegex := range domainDnRegexes {
+		match := domainDnRegex.regex.FindStringSubmatch(dn)
+		if match == nil {
+			continue
+		}
+		if domainDnRegex.domainType == "vmmDomain" {
+			return domainDnRegex.domainType, match[1], match[2]
+		}
+		return domainDnRegex.domainType, "", match[1]
+	}
+	return "", "", ""
+}
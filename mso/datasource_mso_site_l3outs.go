@@ -0,0 +1,103 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMSOSiteL3outs() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSiteL3outsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tenant_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"vrf_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"l3outs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrf_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOSiteL3outsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Site L3Outs")
+	msoClient := m.(*client.Client)
+
+	siteId := d.Get("site_id").(string)
+	tenantName := d.Get("tenant_name").(string)
+	vrfName := d.Get("vrf_name").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/aci/tenants/%s/l3outs", siteId, tenantName))
+	if err != nil {
+		return err
+	}
+
+	l3outList := make([]interface{}, 0, 1)
+	if l3outs, ok := cont.S("l3outs").Data().([]interface{}); ok {
+		reVrf := regexp.MustCompile("uni/tn-(.*?)/ctx-(.*)")
+		for _, l3out := range l3outs {
+			l3outMap, ok := l3out.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			apiVrf := convertInterfaceToString(l3outMap["vrfName"])
+			if apiVrf == "" {
+				if match := reVrf.FindStringSubmatch(convertInterfaceToString(l3outMap["vrfDn"])); match != nil {
+					apiVrf = match[2]
+				}
+			}
+			if vrfName != "" && apiVrf != vrfName {
+				continue
+			}
+
+			l3outList = append(l3outList, map[string]interface{}{
+				"dn":       convertInterfaceToString(l3outMap["dn"]),
+				"name":     convertInterfaceToString(l3outMap["name"]),
+				"vrf_name": apiVrf,
+			})
+		}
+	}
+	d.Set("l3outs", l3outList)
+
+	d.SetId(fmt.Sprintf("%s/tenants/%s/l3outs", siteId, tenantName))
+	log.Printf("[DEBUG] %s: Read Site L3Outs finished successfully", d.Id())
+	return nil
+}
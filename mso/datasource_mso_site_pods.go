@@ -0,0 +1,177 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMSOSitePods() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSitePodsRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"pod": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"leaf": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"pods": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"leaves": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"interfaces": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"paths": &schema.Schema{
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOSitePodsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Site Pods")
+	msoClient := m.(*client.Client)
+
+	siteId := d.Get("site_id").(string)
+	podName := d.Get("pod").(string)
+	leafId := d.Get("leaf").(string)
+
+	pods, err := getSitePods(msoClient, siteId)
+	if err != nil {
+		return err
+	}
+
+	podList := make([]interface{}, 0, 1)
+	for _, pod := range pods {
+		podMap, ok := pod.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		apiPod := convertInterfaceToString(podMap["name"])
+		if podName != "" && apiPod != podName {
+			continue
+		}
+
+		leafList := make([]interface{}, 0, 1)
+		nodes, _ := podMap["nodes"].([]interface{})
+		for _, node := range nodes {
+			nodeMap, ok := node.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if role := convertInterfaceToString(nodeMap["role"]); role != "" && role != "leaf" {
+				continue
+			}
+			apiLeaf := convertInterfaceToString(nodeMap["id"])
+			if leafId != "" && apiLeaf != leafId {
+				continue
+			}
+
+			interfaces, err := getSiteLeafInterfaces(msoClient, siteId, apiPod, apiLeaf)
+			if err != nil {
+				return err
+			}
+			paths := make([]interface{}, 0, len(interfaces))
+			for _, interfaceName := range interfaces {
+				paths = append(paths, fmt.Sprintf("topology/%s/paths-%s/pathep-[%s]", apiPod, apiLeaf, interfaceName))
+			}
+
+			leafList = append(leafList, map[string]interface{}{
+				"id":         apiLeaf,
+				"name":       convertInterfaceToString(nodeMap["name"]),
+				"interfaces": interfaces,
+				"paths":      paths,
+			})
+		}
+
+		podList = append(podList, map[string]interface{}{
+			"name":   apiPod,
+			"leaves": leafList,
+		})
+	}
+	d.Set("pods", podList)
+
+	d.SetId(fmt.Sprintf("%s/pods", siteId))
+	log.Printf("[DEBUG] %s: Read Site Pods finished successfully", d.Id())
+	return nil
+}
+
+// getSitePods returns the pods of a site including the nodes in each pod.
+func getSitePods(msoClient *client.Client, siteId string) ([]interface{}, error) {
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/aci/pods", siteId))
+	if err != nil {
+		return nil, err
+	}
+
+	pods, ok := cont.S("pods").Data().([]interface{})
+	if !ok {
+		return make([]interface{}, 0), nil
+	}
+	return pods, nil
+}
+
+// getSiteLeafInterfaces returns the names of the physical interfaces of a leaf node.
+func getSiteLeafInterfaces(msoClient *client.Client, siteId, pod, leaf string) ([]interface{}, error) {
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/aci/pods/%s/nodes/%s/interfaces", siteId, pod, leaf))
+	if err != nil {
+		return nil, err
+	}
+
+	interfaceList := make([]interface{}, 0, 1)
+	if interfaces, ok := cont.S("interfaces").Data().([]interface{}); ok {
+		for _, leafInterface := range interfaces {
+			if interfaceMap, ok := leafInterface.(map[string]interface{}); ok {
+				interfaceList = append(interfaceList, convertInterfaceToString(interfaceMap["name"]))
+			} else {
+				interfaceList = append(interfaceList, convertInterfaceToString(leafInterface))
+			}
+		}
+	}
+	return interfaceList, nil
+}
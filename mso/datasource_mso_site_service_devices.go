@@ -0,0 +1,122 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceMSOSiteServiceDevices() *schema.Resource {
+	return &schema.Resource{
+
+		Read: dataSourceMSOSiteServiceDevicesRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tenant_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"device_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"devices": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interfaces": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceMSOSiteServiceDevicesRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Beginning Read Site Service Devices")
+	msoClient := m.(*client.Client)
+
+	siteId := d.Get("site_id").(string)
+	tenantName := d.Get("tenant_name").(string)
+	deviceType := d.Get("device_type").(string)
+
+	devices, err := getSiteServiceDevices(msoClient, siteId, tenantName)
+	if err != nil {
+		return err
+	}
+
+	deviceList := make([]interface{}, 0, 1)
+	for _, device := range devices {
+		deviceMap := device.(map[string]interface{})
+		apiDeviceType := convertInterfaceToString(deviceMap["deviceType"])
+		if deviceType != "" && apiDeviceType != deviceType {
+			continue
+		}
+
+		interfaceList := make([]interface{}, 0, 1)
+		if interfaces, ok := deviceMap["interfaces"].([]interface{}); ok {
+			for _, deviceInterface := range interfaces {
+				if interfaceMap, ok := deviceInterface.(map[string]interface{}); ok {
+					interfaceList = append(interfaceList, convertInterfaceToString(interfaceMap["name"]))
+				} else {
+					interfaceList = append(interfaceList, convertInterfaceToString(deviceInterface))
+				}
+			}
+		}
+
+		deviceList = append(deviceList, map[string]interface{}{
+			"dn":         convertInterfaceToString(deviceMap["dn"]),
+			"name":       convertInterfaceToString(deviceMap["name"]),
+			"type":       apiDeviceType,
+			"interfaces": interfaceList,
+		})
+	}
+	d.Set("devices", deviceList)
+
+	d.SetId(fmt.Sprintf("%s/tenants/%s/devices", siteId, tenantName))
+	log.Printf("[DEBUG] %s: Read Site Service Devices finished successfully", d.Id())
+	return nil
+}
+
+// getSiteServiceDevices returns the L4-L7 devices of a tenant that are available in a site.
+func getSiteServiceDevices(msoClient *client.Client, siteId, tenantName string) ([]interface{}, error) {
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/sites/%s/aci/tenants/%s/devices", siteId, tenantName))
+	if err != nil {
+		return nil, err
+	}
+
+	devices, ok := cont.S("devices").Data().([]interface{})
+	if !ok {
+		return make([]interface{}, 0), nil
+	}
+	return devices, nil
+}
@@ -0,0 +1,132 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func datasourceMSOTemplatePolicies() *schema.Resource {
+	return &schema.Resource{
+
+		Read: datasourceMSOTemplatePoliciesRead,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"template_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"template_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policies": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uuid": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// templatePolicyTypes are the keys of the template content of the policy template types.
+var templatePolicyTypes = []string{tenantPolicyTemplate, fabricPolicyTemplate, fabricResourceTemplate, monitoringTemplate, serviceDeviceTemplate}
+
+// getTemplatePolicies returns the policies of the template content, which are the named objects of its lists, sorted by type and name.
+func getTemplatePolicies(content map[string]interface{}, policyType string) []interface{} {
+	policies := make([]interface{}, 0)
+	for objectKey, objects := range content {
+		if policyType != "" && objectKey != policyType {
+			continue
+		}
+		objectList, ok := objects.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, object := range objectList {
+			objectMap, ok := object.(map[string]interface{})
+			if !ok || objectMap["name"] == nil {
+				continue
+			}
+			policies = append(policies, map[string]interface{}{
+				"name":        convertInterfaceToString(objectMap["name"]),
+				"type":        objectKey,
+				"uuid":        convertInterfaceToString(objectMap["uuid"]),
+				"description": convertInterfaceToString(objectMap["description"]),
+			})
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		a, b := policies[i].(map[string]interface{}), policies[j].(map[string]interface{})
+		if a["type"] != b["type"] {
+			return a["type"].(string) < b["type"].(string)
+		}
+		return a["name"].(string) < b["name"].(string)
+	})
+	return policies
+}
+
+func datasourceMSOTemplatePoliciesRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return err
+	}
+
+	var content map[string]interface{}
+	for _, templateType := range templatePolicyTypes {
+		if templateContent, ok := cont.S(templateType, "template").Data().(map[string]interface{}); ok {
+			content = templateContent
+			break
+		}
+	}
+	if content == nil {
+		return fmt.Errorf("Template %s is not a tenant policy, fabric policy, fabric resource, monitoring or service device template", templateId)
+	}
+
+	d.SetId(templateId)
+	d.Set("template_name", convertInterfaceToString(cont.S("displayName").Data()))
+	d.Set("template_type", convertInterfaceToString(cont.S("templateType").Data()))
+	d.Set("policies", getTemplatePolicies(content, d.Get("type").(string)))
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
@@ -142,7 +142,7 @@ func datasourceMSOTenantRead(d *schema.ResourceData, m interface{}) error {
 
 	msoClient := m.(*client.Client)
 	name := d.Get("name").(string)
-	con, err := msoClient.GetViaURL("api/v1/tenants")
+	con, err := msoClient.GetViaURL(addQueryFilters("api/v1/tenants", map[string]string{"name": name}))
 	if err != nil {
 		return err
 	}
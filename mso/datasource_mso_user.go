@@ -98,19 +98,22 @@ func datasourceMSOUserRead(d *schema.ResourceData, m interface{}) error {
 	} else {
 		path = "api/v1/users"
 	}
-	con, err := msoClient.GetViaURL(path)
+	var usernameKey string
+	if platform == "nd" {
+		usernameKey = "loginID"
+	} else {
+		usernameKey = "username"
+	}
+	con, err := msoClient.GetViaURL(addQueryFilters(path, map[string]string{usernameKey: username}))
 	if err != nil {
 		return err
 	}
 
 	var data []interface{}
-	var usernameKey string
 	if platform == "nd" {
 		data = con.Data().([]interface{})
-		usernameKey = "loginID"
 	} else {
 		data = con.S("users").Data().([]interface{})
-		usernameKey = "username"
 	}
 
 	var flag bool
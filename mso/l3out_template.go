@@ -0,0 +1,257 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// l3outTemplateObject describes the objects of an L3Out template resource, either an L3Out or an object in a list of an L3Out.
+type l3outTemplateObject struct {
+	// label names the object in errors and logs.
+	label string
+	// listKey is the key of the list of the L3Out which holds the objects, it is empty for the L3Outs themselves.
+	listKey string
+	// idSegment is the segment of the ID of the resource before the key of the object.
+	idSegment string
+	// matchKey is the key in the API and the attribute of the resource which identify the object in its list.
+	matchKey, matchAttribute string
+	// payload returns the settings of the object which are managed by the resource, a nil value removes the setting.
+	payload func(d *schema.ResourceData) map[string]interface{}
+	set     func(d *schema.ResourceData, objectCont *container.Container)
+}
+
+// getTemplateListObject returns the object of the list at path of which key has value, and its index in the list.
+// The index is -1 when the object is not found, exists reports whether the list exists.
+func getTemplateListObject(cont *container.Container, key, value string, path ...string) (objectCont *container.Container, index int, exists bool) {
+	count, err := cont.ArrayCount(path...)
+	if err != nil {
+		return nil, -1, false
+	}
+	for i := 0; i < count; i++ {
+		objectCont, err := cont.ArrayElement(i, path...)
+		// Keys like node IDs are compared as text, as they may be numbers in the API.
+		if err == nil && fmt.Sprint(objectCont.S(key).Data()) == value {
+			return objectCont, i, true
+		}
+	}
+	return nil, -1, true
+}
+
+// patchTemplateList adds, replaces or removes an object in the list at listPath of a template.
+// An object is added with the list itself when the list does not exist yet.
+func patchTemplateList(msoClient *client.Client, templateId, listPath string, listExists bool, op string, index int, value interface{}) error {
+	path := fmt.Sprintf("%s/%d", listPath, index)
+	if op == "add" {
+		if listExists {
+			path = fmt.Sprintf("%s/-", listPath)
+		} else {
+			path = listPath
+			value = []interface{}{value}
+		}
+	}
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	err := addPatchPayloadToContainer(payloadCon, op, path, value)
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/templates/%s", templateId), payloadCon)
+}
+
+// id returns the ID of the resource of the object, {template_id}/l3out/{l3out_name} for L3Outs and {template_id}/l3out/{l3out_name}/{idSegment}/{key} for the objects in an L3Out.
+func (o l3outTemplateObject) id(d *schema.ResourceData) string {
+	if o.listKey == "" {
+		return fmt.Sprintf("%s/l3out/%s", d.Get("template_id").(string), d.Get("name").(string))
+	}
+	return fmt.Sprintf("%s/l3out/%s/%s/%s", d.Get("template_id").(string), d.Get("l3out_name").(string), o.idSegment, d.Get(o.matchAttribute).(string))
+}
+
+// find returns the object of the resource in the L3Out template, its index and the path and existence of its list.
+// A missing L3Out of an object in an L3Out is returned as a missing object.
+func (o l3outTemplateObject) find(d *schema.ResourceData, cont *container.Container) (*container.Container, int, string, bool) {
+	if o.listKey == "" {
+		objectCont, index, exists := getTemplateListObject(cont, "name", d.Get("name").(string), l3outTemplate, "l3outs")
+		return objectCont, index, fmt.Sprintf("/%s/l3outs", l3outTemplate), exists
+	}
+	l3outCont, l3outIndex, _ := getTemplateListObject(cont, "name", d.Get("l3out_name").(string), l3outTemplate, "l3outs")
+	if l3outIndex == -1 {
+		return nil, -1, "", false
+	}
+	objectCont, index, exists := getTemplateListObject(l3outCont, o.matchKey, d.Get(o.matchAttribute).(string), o.listKey)
+	return objectCont, index, fmt.Sprintf("/%s/l3outs/%d/%s", l3outTemplate, l3outIndex, o.listKey), exists
+}
+
+func (o l3outTemplateObject) importState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	get_attribute := strings.Split(d.Id(), "/")
+	if o.listKey == "" {
+		if len(get_attribute) != 3 || get_attribute[1] != "l3out" {
+			return nil, fmt.Errorf("Invalid import ID %s, expected {template_id}/l3out/{name}", d.Id())
+		}
+		d.Set("name", get_attribute[2])
+	} else {
+		if len(get_attribute) != 5 || get_attribute[1] != "l3out" || get_attribute[3] != o.idSegment {
+			return nil, fmt.Errorf("Invalid import ID %s, expected {template_id}/l3out/{l3out_name}/%s/{%s}", d.Id(), o.idSegment, o.matchAttribute)
+		}
+		d.Set("l3out_name", get_attribute[2])
+		d.Set(o.matchAttribute, get_attribute[4])
+	}
+	d.Set("template_id", get_attribute[0])
+
+	err := o.read(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("%s not found in template %s", o.label, get_attribute[0])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func (o l3outTemplateObject) create(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Create", o.label)
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return err
+	}
+	objectCont, _, listPath, listExists := o.find(d, cont)
+	if listPath == "" {
+		return fmt.Errorf("L3Out %s not found in template %s", d.Get("l3out_name").(string), templateId)
+	}
+	if objectCont != nil {
+		return fmt.Errorf("%s %s already exists in template %s", o.label, o.id(d), templateId)
+	}
+
+	object := make(map[string]interface{})
+	for key, value := range o.payload(d) {
+		if value != nil {
+			object[key] = value
+		}
+	}
+	err = patchTemplateList(msoClient, templateId, listPath, listExists, "add", -1, object)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(o.id(d))
+	log.Printf("[DEBUG] %s: Create finished successfully", d.Id())
+	return o.read(d, m)
+}
+
+func (o l3outTemplateObject) read(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	objectCont, _, _, _ := o.find(d, cont)
+	if objectCont == nil {
+		log.Printf("[WARN] %s not found, removing from state: %s", o.label, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(o.id(d))
+	o.set(d, objectCont)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func (o l3outTemplateObject) update(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return err
+	}
+	objectCont, index, listPath, listExists := o.find(d, cont)
+	if objectCont == nil {
+		return fmt.Errorf("%s %s not found in template %s", o.label, d.Id(), templateId)
+	}
+
+	// The objects keep their own settings which are not managed by the resource, like the nodes of an L3Out.
+	// Settings of the payload without a value are removed.
+	object, _ := objectCont.Data().(map[string]interface{})
+	for key, value := range o.payload(d) {
+		if value == nil {
+			delete(object, key)
+		} else {
+			object[key] = value
+		}
+	}
+	err = patchTemplateList(msoClient, templateId, listPath, listExists, "replace", index, object)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return o.read(d, m)
+}
+
+func (o l3outTemplateObject) delete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	_, index, listPath, listExists := o.find(d, cont)
+	if index != -1 {
+		err = patchTemplateList(msoClient, templateId, listPath, listExists, "remove", index, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resource returns the resource of the L3Out template object with the schema.
+func (o l3outTemplateObject) resource(objectSchema map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{
+		Create: o.create,
+		Read:   o.read,
+		Update: o.update,
+		Delete: o.delete,
+
+		Importer: &schema.ResourceImporter{
+			State: o.importState,
+		},
+
+		SchemaVersion: version,
+
+		Schema: objectSchema,
+	}
+}
+
+// setTemplateObjectString sets the attribute to the string value of the key of the object.
+func setTemplateObjectString(d *schema.ResourceData, objectCont *container.Container, attribute, key string) {
+	d.Set(attribute, models.StripQuotes(convertInterfaceToString(objectCont.S(key).Data())))
+}
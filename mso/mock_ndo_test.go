@@ -0,0 +1,875 @@
+package mso
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-mso/internal/mockndo"
+)
+
+const mockSchemaId = "5c4d5bb72700000401f80948"
+
+var (
+	mockServer     *mockndo.Server
+	mockClient     *client.Client
+	mockServerOnce sync.Once
+)
+
+// testMockNDO returns the client of the mock NDO server with the schema fixture loaded.
+// The server is shared by all tests, because the client of the mso-go-client package is a singleton.
+func testMockNDO(t *testing.T) (*mockndo.Server, *client.Client) {
+	mockServerOnce.Do(func() {
+		mockServer = mockndo.NewServer("4.2.3e")
+		mockClient = client.NewClient(mockServer.URL, "admin", client.Password("password"), client.Insecure(true))
+	})
+	mockServer.Reset()
+	settingsByClient.Delete(mockClient)
+	if err := mockServer.LoadFixture("api/v1/schemas/"+mockSchemaId, "schema.json"); err != nil {
+		t.Fatal(err)
+	}
+	return mockServer, mockClient
+}
+
+func TestMockNDOSchemaTemplateVrfCreate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateVrf().Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF2",
+		"display_name": "VRF 2",
+	})
+
+	err := resourceMSOSchemaTemplateVrfCreate(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "VRF2" {
+		t.Errorf("Expected id VRF2, got %s", d.Id())
+	}
+
+	var patch *mockndo.Request
+	requests := server.Requests()
+	for i := range requests {
+		if requests[i].Method == http.MethodPatch {
+			patch = &requests[i]
+		}
+	}
+	if patch == nil {
+		t.Fatal("Expected a PATCH request")
+	}
+	if patch.Path != "api/v1/schemas/"+mockSchemaId {
+		t.Errorf("Expected the PATCH request to the schema, got %s", patch.Path)
+	}
+	operations, _ := patch.Body.([]interface{})
+	if len(operations) != 1 {
+		t.Fatalf("Expected 1 operation, got %v", patch.Body)
+	}
+	operation := operations[0].(map[string]interface{})
+	if operation["op"] != "add" || operation["path"] != "/templates/Template1/vrfs/-" {
+		t.Errorf("Unexpected operation %v %v", operation["op"], operation["path"])
+	}
+	value := operation["value"].(map[string]interface{})
+	if value["name"] != "VRF2" || value["displayName"] != "VRF 2" {
+		t.Errorf("Unexpected operation value %v", value)
+	}
+	if d.Get("display_name") != "VRF 2" {
+		t.Errorf("Expected display_name to be read back, got %v", d.Get("display_name"))
+	}
+}
+
+func TestMockNDOSchemaTemplateVrfReadNotFound(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateVrf().Schema, map[string]interface{}{
+		"schema_id":    "000000000000000000000000",
+		"template":     "Template1",
+		"name":         "VRF1",
+		"display_name": "VRF1",
+	})
+	d.SetId("VRF1")
+
+	err := resourceMSOSchemaTemplateVrfRead(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the VRF to be removed from state when the schema does not exist, got id %s", d.Id())
+	}
+}
+
+func TestMockNDOPatchFailure(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.Fail(http.MethodPatch, "api/v1/schemas/"+mockSchemaId, http.StatusBadRequest, map[string]interface{}{"code": 400, "message": "Bad Request"})
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateVrf().Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF2",
+		"display_name": "VRF2",
+	})
+
+	if err := resourceMSOSchemaTemplateVrfCreate(d, msoClient); err == nil {
+		t.Fatal("Expected an error when the PATCH request fails")
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected no id after a failed create, got %s", d.Id())
+	}
+}
+
+func TestMockNDOWriteVerificationRetry(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	defer func(delay time.Duration) { writeVerification.delay = delay }(writeVerification.delay)
+	setProviderSettings(msoClient, &providerSettings{verifyWrites: true})
+	writeVerification.delay = time.Millisecond
+
+	// The first read after the write fails, so the verification must retry.
+	server.Fail(http.MethodGet, "api/v1/schemas/"+mockSchemaId, http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal Server Error"})
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateVrf().Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF2",
+		"display_name": "VRF2",
+	})
+	if err := resourceMSOSchemaTemplateVrfCreate(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+
+	methods := make([]string, 0)
+	for _, request := range server.Requests() {
+		methods = append(methods, request.Method)
+	}
+	expected := []string{http.MethodPatch, http.MethodGet, http.MethodGet, http.MethodGet}
+	if !reflect.DeepEqual(methods, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, methods)
+	}
+}
+
+func TestMockNDOCreatedObjectRetry(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	defer func(delay time.Duration) { writeVerification.delay = delay }(writeVerification.delay)
+	writeVerification.delay = time.Millisecond
+	path := "api/v1/schemas/" + mockSchemaId
+	notFound := map[string]interface{}{"code": 404, "message": "Schema not found"}
+
+	// The schema is not found twice directly after the create, the third read finds it.
+	server.Fail(http.MethodGet, path, http.StatusNotFound, notFound)
+	server.Fail(http.MethodGet, path, http.StatusNotFound, notFound)
+	if err := waitForCreatedObject(msoClient, path, nil); err != nil {
+		t.Fatal(err)
+	}
+	if requests := server.Requests(); len(requests) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(requests))
+	}
+
+	// Other errors are not retried.
+	server.Reset()
+	server.Fail(http.MethodGet, path, http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal Server Error"})
+	if err := waitForCreatedObject(msoClient, path, nil); err == nil {
+		t.Error("Expected the error of the read to be returned")
+	}
+	if requests := server.Requests(); len(requests) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(requests))
+	}
+
+	// The retries are bounded when the object is never found.
+	server.Reset()
+	err := waitForCreatedObject(msoClient, "api/v1/schemas/unknown", nil)
+	if err == nil {
+		t.Error("Expected an error for an object which is never found")
+	}
+	if requests := server.Requests(); len(requests) != writeVerification.attempts {
+		t.Errorf("Expected %d requests, got %d", writeVerification.attempts, len(requests))
+	}
+}
+
+func TestMockNDOGzipResponse(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetGzip(true)
+
+	cont, err := msoClient.GetViaURL("api/v1/schemas/" + mockSchemaId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if displayName := cont.S("displayName").Data(); displayName != "Schema1" {
+		t.Errorf("Expected the decompressed schema, got displayName %v", displayName)
+	}
+	requests := server.Requests()
+	if len(requests) != 1 || !strings.Contains(requests[0].Header.Get("Accept-Encoding"), "gzip") {
+		t.Errorf("Expected the request to accept gzip responses, got %v", requests)
+	}
+}
+
+func TestMockNDOSchemaCache(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	setProviderSettings(msoClient, &providerSettings{schemaCache: true})
+
+	schemaGets := func() int {
+		count := 0
+		for _, request := range server.Requests() {
+			if request.Method == http.MethodGet && request.Path == "api/v1/schemas/"+mockSchemaId {
+				count++
+			}
+		}
+		return count
+	}
+
+	for i := 0; i < 3; i++ {
+		cont, err := getSchemaCont(msoClient, mockSchemaId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Changes to the returned container must not change the cached schema.
+		cont.Set("changed", "displayName")
+	}
+	if count := schemaGets(); count != 1 {
+		t.Errorf("Expected 1 GET request of the schema, got %d", count)
+	}
+	cont, err := getSchemaCont(msoClient, mockSchemaId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cont.S("displayName").Data() == "changed" {
+		t.Error("Expected the cached schema to be unchanged")
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateVrf().Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF2",
+		"display_name": "VRF 2",
+	})
+	err = resourceMSOSchemaTemplateVrfCreate(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("display_name") != "VRF 2" {
+		t.Errorf("Expected the read after the PATCH to return the new VRF, got display name %v", d.Get("display_name"))
+	}
+	if count := schemaGets(); count != 2 {
+		t.Errorf("Expected 2 GET requests of the schema after the PATCH, got %d", count)
+	}
+}
+
+func TestMockNDOTokenAuthentication(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	// The mso-go-client v1.29.0 returns the shared client for every configuration, restore its token for the other tests.
+	authToken := msoClient.AuthToken
+	defer func() { msoClient.AuthToken = authToken }()
+
+	tokenClient, err := Config{Token: "ci-token", URL: server.URL}.getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tokenClient.GetViaURL("api/v1/schemas/" + mockSchemaId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := server.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(requests))
+	}
+	if authorization := requests[0].Header.Get("Authorization"); authorization != "Bearer ci-token" {
+		t.Errorf("Expected the configured token, got authorization %s", authorization)
+	}
+}
+
+func TestMockNDORetryUnavailable(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	if err := configureRetryPolicy(msoClient, 1, 0, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	defer configureRetryPolicy(msoClient, defaultMaxRetries, defaultBackoffMinDelay, defaultBackoffMaxDelay, defaultBackoffDelayFactor)
+
+	server.Fail(http.MethodGet, "api/v1/schemas/"+mockSchemaId, http.StatusServiceUnavailable, map[string]interface{}{"code": 503, "message": "Service Unavailable"})
+	_, err := msoClient.GetViaURL("api/v1/schemas/" + mockSchemaId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := len(server.Requests()); count != 2 {
+		t.Errorf("Expected the request to be retried once, got %d requests", count)
+	}
+
+	server.Fail(http.MethodGet, "api/v1/schemas/"+mockSchemaId, http.StatusServiceUnavailable, map[string]interface{}{"code": 503, "message": "Service Unavailable"})
+	server.Fail(http.MethodGet, "api/v1/schemas/"+mockSchemaId, http.StatusServiceUnavailable, map[string]interface{}{"code": 503, "message": "Service Unavailable"})
+	_, err = msoClient.GetViaURL("api/v1/schemas/" + mockSchemaId)
+	if err == nil || !strings.Contains(err.Error(), "gave up after 1 retries") {
+		t.Errorf("Expected the request to fail after 1 retry, got %v", err)
+	}
+}
+
+func TestMockNDORetryCancelled(t *testing.T) {
+	server, _ := testMockNDO(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	msoClient := client.NewClient(server.URL, "admin", client.Password("password"), client.Insecure(true), client.StopContext(ctx))
+	if err := configureRetryPolicy(msoClient, 1, 60, 60, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Fail(http.MethodGet, "api/v1/schemas/"+mockSchemaId, http.StatusServiceUnavailable, map[string]interface{}{"code": 503, "message": "Service Unavailable"})
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := msoClient.GetViaURL("api/v1/schemas/" + mockSchemaId)
+	if err == nil || !strings.Contains(err.Error(), "cancelled while waiting to retry") {
+		t.Errorf("Expected the request to be cancelled while waiting to retry, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected the request to end when the stop context is cancelled, took %s", elapsed)
+	}
+}
+
+func TestMockNDORetryConflict(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	if err := configureRetryPolicy(msoClient, 1, 0, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	defer configureRetryPolicy(msoClient, defaultMaxRetries, defaultBackoffMinDelay, defaultBackoffMaxDelay, defaultBackoffDelayFactor)
+
+	server.Fail(http.MethodPost, "api/v1/tenants", http.StatusConflict, map[string]interface{}{"code": 409, "message": "Tenant Tenant1 already exists"})
+	payload, _ := container.Consume(map[string]interface{}{"name": "Tenant1"})
+	_, _, err := doRequestWithContext(context.Background(), msoClient, "POST", "api/v1/tenants", payload)
+	if err == nil {
+		t.Error("Expected the create of an existing tenant to fail")
+	}
+	if count := len(server.Requests()); count != 1 {
+		t.Errorf("Expected the create not to be retried, got %d requests", count)
+	}
+
+	server.Fail(http.MethodPost, "api/v1/tenants", http.StatusConflict, map[string]interface{}{"code": 409, "message": "Tenant is being modified by another request"})
+	_, _, err = doRequestWithContext(context.Background(), msoClient, "POST", "api/v1/tenants", payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := len(server.Requests()); count != 3 {
+		t.Errorf("Expected the locked create to be retried once, got %d requests", count)
+	}
+}
+
+func TestMockNDOTenantPoliciesTemplate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(tenantPolicyTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":      "TenantPolicies1",
+		"tenant_id": "0000ffff0000000000000010",
+		"sites":     []interface{}{"5c7c95b25100008f01c1ee3c"},
+	})
+	err := templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, ok := server.Object("api/v1/templates/" + d.Id())
+	if !ok {
+		t.Fatalf("Expected template %s to be created", d.Id())
+	}
+	if templateType := template.(map[string]interface{})["templateType"]; templateType != "tenantPolicy" {
+		t.Errorf("Expected template type tenantPolicy, got %v", templateType)
+	}
+	if d.Get("tenant_id") != "0000ffff0000000000000010" || d.Get("sites").(*schema.Set).Len() != 1 {
+		t.Errorf("Unexpected template state tenant_id %v sites %v", d.Get("tenant_id"), d.Get("sites"))
+	}
+
+	policy := schema.TestResourceDataRaw(t, resourceMSOTenantPoliciesIgmpInterfacePolicy().Schema, map[string]interface{}{
+		"template_id":    d.Id(),
+		"name":           "igmp1",
+		"version":        "v3",
+		"query_interval": 60,
+	})
+	err = tenantPoliciesIgmpInterfacePolicy.create(policy, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policy.Id() != d.Id()+"/igmpInterfacePolicy/igmp1" {
+		t.Errorf("Unexpected policy id %s", policy.Id())
+	}
+	if policy.Get("version") != "v3" || policy.Get("query_interval") != 60 || policy.Get("group_timeout") != 260 {
+		t.Errorf("Unexpected policy state version %v query_interval %v group_timeout %v", policy.Get("version"), policy.Get("query_interval"), policy.Get("group_timeout"))
+	}
+
+	templateId := d.Id()
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.Object("api/v1/templates/" + templateId); ok {
+		t.Error("Expected the template to be deleted")
+	}
+}
+
+func TestMockNDOTemplatePolicyConcurrentDelete(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	policies := make([]interface{}, 0, 3)
+	for _, name := range []string{"igmp1", "igmp2", "igmp3"} {
+		policies = append(policies, map[string]interface{}{"name": name, "uuid": name + "-uuid"})
+	}
+	server.SetObject("api/v1/templates/template1", map[string]interface{}{
+		"templateType": "tenantPolicy",
+		tenantPolicyTemplate: map[string]interface{}{
+			"template": map[string]interface{}{"igmpInterfacePolicies": policies},
+		},
+	})
+
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, name := range []string{"igmp1", "igmp2"} {
+		policy := schema.TestResourceDataRaw(t, resourceMSOTenantPoliciesIgmpInterfacePolicy().Schema, map[string]interface{}{
+			"template_id": "template1",
+			"name":        name,
+		})
+		policy.SetId("template1/igmpInterfacePolicy/" + name)
+		wg.Add(1)
+		go func(i int, policy *schema.ResourceData) {
+			defer wg.Done()
+			errs[i] = tenantPoliciesIgmpInterfacePolicy.delete(policy, msoClient)
+		}(i, policy)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Expected delete %d to succeed, got %v", i, err)
+		}
+	}
+
+	template, _ := server.Object("api/v1/templates/template1")
+	remaining := template.(map[string]interface{})[tenantPolicyTemplate].(map[string]interface{})["template"].(map[string]interface{})["igmpInterfacePolicies"].([]interface{})
+	if len(remaining) != 1 || remaining[0].(map[string]interface{})["name"] != "igmp3" {
+		t.Errorf("Expected only igmp3 to remain, got %v", remaining)
+	}
+}
+
+func TestMockNDOL3outTemplate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(l3outTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":      "L3outTemplate1",
+		"tenant_id": "0000ffff0000000000000010",
+		"site_id":   "5c7c95b25100008f01c1ee3c",
+	})
+	err := templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("tenant_id") != "0000ffff0000000000000010" || d.Get("site_id") != "5c7c95b25100008f01c1ee3c" {
+		t.Errorf("Unexpected template state tenant_id %v site_id %v", d.Get("tenant_id"), d.Get("site_id"))
+	}
+	templateId := d.Id()
+
+	l3out := schema.TestResourceDataRaw(t, resourceMSOL3outTemplateL3out().Schema, map[string]interface{}{
+		"template_id":      templateId,
+		"name":             "l3out1",
+		"vrf_uuid":         "vrf-uuid",
+		"routing_protocol": "ospf",
+		"ospf": []interface{}{map[string]interface{}{
+			"area_id": "0.0.0.1",
+		}},
+	})
+	err = l3outTemplateL3out.create(l3out, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l3out.Id() != templateId+"/l3out/l3out1" {
+		t.Errorf("Unexpected L3Out id %s", l3out.Id())
+	}
+
+	node := schema.TestResourceDataRaw(t, resourceMSOL3outTemplateNode().Schema, map[string]interface{}{
+		"template_id": templateId,
+		"l3out_name":  "l3out1",
+		"node_id":     "101",
+		"router_id":   "1.1.1.1",
+		"bgp_peers": []interface{}{map[string]interface{}{
+			"peer_address": "10.0.0.2",
+			"peer_asn":     65001,
+		}},
+	})
+	err = l3outTemplateNode.create(node, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Id() != templateId+"/l3out/l3out1/node/101" || len(node.Get("bgp_peers").([]interface{})) != 1 {
+		t.Errorf("Unexpected node id %s bgp_peers %v", node.Id(), node.Get("bgp_peers"))
+	}
+
+	// An update of the L3Out keeps its nodes and removes the OSPF area which is no longer configured.
+	l3out.Set("routing_protocol", "bgp")
+	l3out.Set("ospf", []interface{}{})
+	err = l3outTemplateL3out.update(l3out, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, _ := server.Object("api/v1/templates/" + templateId)
+	l3outs := template.(map[string]interface{})[l3outTemplate].(map[string]interface{})["l3outs"].([]interface{})
+	l3outObject := l3outs[0].(map[string]interface{})
+	if _, ok := l3outObject["ospfAreaConfig"]; ok || len(l3outObject["nodes"].([]interface{})) != 1 || l3outObject["routingProtocol"] != "bgp" {
+		t.Errorf("Unexpected L3Out after update %v", l3outObject)
+	}
+
+	err = l3outTemplateNode.delete(node, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l3outTemplateNode.read(node, msoClient)
+	if err != nil || node.Id() != "" {
+		t.Errorf("Expected the node to be deleted, got id %s error %v", node.Id(), err)
+	}
+
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockNDOFabricPoliciesTemplate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(fabricPolicyTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name": "FabricPolicies1",
+	})
+	err := templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	templateId := d.Id()
+
+	pool := schema.TestResourceDataRaw(t, resourceMSOFabricPoliciesVlanPool().Schema, map[string]interface{}{
+		"template_id": templateId,
+		"name":        "pool1",
+		"vlan_ranges": []interface{}{map[string]interface{}{
+			"from": 100,
+			"to":   199,
+		}},
+	})
+	err = fabricPoliciesVlanPool.create(pool, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool.Id() != templateId+"/vlanPool/pool1" || pool.Get("vlan_ranges.0.to") != 199 || pool.Get("vlan_ranges.0.allocation_mode") != "inherit" {
+		t.Errorf("Unexpected VLAN pool id %s vlan_ranges %v", pool.Id(), pool.Get("vlan_ranges"))
+	}
+
+	domain := schema.TestResourceDataRaw(t, resourceMSOFabricPoliciesPhysicalDomain().Schema, map[string]interface{}{
+		"template_id":    templateId,
+		"name":           "domain1",
+		"vlan_pool_uuid": "pool-uuid",
+	})
+	err = fabricPoliciesPhysicalDomain.create(domain, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.Get("vlan_pool_uuid") != "pool-uuid" {
+		t.Errorf("Unexpected domain vlan_pool_uuid %v", domain.Get("vlan_pool_uuid"))
+	}
+
+	// A template holds a single PTP policy, which is patched as an object instead of a list element.
+	ptp := schema.TestResourceDataRaw(t, resourceMSOFabricPoliciesPtpPolicy().Schema, map[string]interface{}{
+		"template_id":   templateId,
+		"name":          "ptp1",
+		"global_domain": 24,
+	})
+	err = fabricPoliciesPtpPolicy.create(ptp, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = fabricPoliciesPtpPolicy.create(ptp, msoClient)
+	if err == nil {
+		t.Error("Expected an error when the template already has a PTP policy")
+	}
+	ptp.Set("global_priority1", 128)
+	err = fabricPoliciesPtpPolicy.update(ptp, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ptp.Get("global_domain") != 24 || ptp.Get("global_priority1") != 128 {
+		t.Errorf("Unexpected PTP policy global_domain %v global_priority1 %v", ptp.Get("global_domain"), ptp.Get("global_priority1"))
+	}
+	err = fabricPoliciesPtpPolicy.delete(ptp, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, _ := server.Object("api/v1/templates/" + templateId)
+	content := template.(map[string]interface{})[fabricPolicyTemplate].(map[string]interface{})["template"].(map[string]interface{})
+	if _, ok := content["ptpPolicy"]; ok || len(content["vlanPools"].([]interface{})) != 1 {
+		t.Errorf("Unexpected template content %v", content)
+	}
+
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockNDOFabricResourcePoliciesTemplate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(fabricResourceTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":  "FabricResources1",
+		"sites": []interface{}{"5c7c95b25100008f01c1ee3c"},
+	})
+	err := templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	templateId := d.Id()
+	template, _ := server.Object("api/v1/templates/" + templateId)
+	if templateType := template.(map[string]interface{})["templateType"]; templateType != "fabricResource" {
+		t.Errorf("Expected template type fabricResource, got %v", templateType)
+	}
+
+	vpc := schema.TestResourceDataRaw(t, resourceMSOFabricResourcePoliciesVpc().Schema, map[string]interface{}{
+		"template_id":                 templateId,
+		"name":                        "vpc1",
+		"interface_policy_group_uuid": "policy-group-uuid",
+		"node1_id":                    "101",
+		"node1_interfaces":            []interface{}{"1/2", "1/1"},
+		"node2_id":                    "102",
+		"node2_interfaces":            []interface{}{"1/1"},
+	})
+	err = fabricResourcePoliciesVpc.create(vpc, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpc.Id() != templateId+"/virtualPortChannel/vpc1" || vpc.Get("node1_interfaces").(*schema.Set).Len() != 2 || vpc.Get("node2_id") != "102" {
+		t.Errorf("Unexpected VPC id %s node1_interfaces %v node2_id %v", vpc.Id(), vpc.Get("node1_interfaces"), vpc.Get("node2_id"))
+	}
+	err = fabricResourcePoliciesVpc.delete(vpc, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockNDOMonitoringPoliciesTemplate(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(monitoringTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":            "Monitoring1",
+		"monitoring_type": "tenant",
+		"sites":           []interface{}{"5c7c95b25100008f01c1ee3c"},
+	})
+	err := templateResource.Create(d, msoClient)
+	if err == nil {
+		t.Fatal("Expected an error when a tenant monitoring template has no tenant_id")
+	}
+	d.Set("tenant_id", "0000ffff0000000000000010")
+	err = templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("monitoring_type") != "tenant" || d.Get("tenant_id") != "0000ffff0000000000000010" {
+		t.Errorf("Unexpected template state monitoring_type %v tenant_id %v", d.Get("monitoring_type"), d.Get("tenant_id"))
+	}
+	templateId := d.Id()
+
+	span := schema.TestResourceDataRaw(t, resourceMSOMonitoringPoliciesSpanSession().Schema, map[string]interface{}{
+		"template_id": templateId,
+		"name":        "span1",
+		"sources": []interface{}{map[string]interface{}{
+			"name":     "source1",
+			"epg_uuid": "epg-uuid",
+		}},
+		"destination": []interface{}{map[string]interface{}{
+			"epg_uuid":         "destination-epg-uuid",
+			"ip":               "10.0.0.100",
+			"source_ip_prefix": "10.0.0.0/24",
+		}},
+	})
+	err = monitoringPoliciesSpanSession.create(span, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if span.Get("sources.0.direction") != "both" || span.Get("sources.0.epg_uuid") != "epg-uuid" || span.Get("destination.0.ip") != "10.0.0.100" || span.Get("destination.0.span_version") != "v2" {
+		t.Errorf("Unexpected SPAN session sources %v destination %v", span.Get("sources"), span.Get("destination"))
+	}
+
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockNDOServiceDeviceCluster(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/tenants/0000ffff0000000000000010", map[string]interface{}{
+		"id":   "0000ffff0000000000000010",
+		"name": "Tenant1",
+	})
+	templateResource := resourceMSOTemplate(serviceDeviceTemplate)
+	d := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":      "ServiceDevices1",
+		"tenant_id": "0000ffff0000000000000010",
+	})
+	err := templateResource.Create(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := schema.TestResourceDataRaw(t, resourceMSOServiceDeviceCluster().Schema, map[string]interface{}{
+		"template_id": d.Id(),
+		"name":        "firewall1",
+		"device_type": "firewall",
+		"interfaces": []interface{}{map[string]interface{}{
+			"name":     "inside",
+			"bd_uuid":  "bd-uuid",
+			"redirect": true,
+		}},
+	})
+	err = serviceDeviceCluster.create(cluster, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Get("device_dn") != "uni/tn-Tenant1/lDevVip-firewall1" {
+		t.Errorf("Expected device_dn uni/tn-Tenant1/lDevVip-firewall1, got %v", cluster.Get("device_dn"))
+	}
+	if cluster.Get("connectivity_mode") != "one_arm" || cluster.Get("interfaces.0.redirect") != true {
+		t.Errorf("Unexpected cluster connectivity_mode %v interfaces %v", cluster.Get("connectivity_mode"), cluster.Get("interfaces"))
+	}
+
+	err = resourceMSOTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMockNDOTemplateDeploy(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	templateResource := resourceMSOTemplate(tenantPolicyTemplate)
+	template := schema.TestResourceDataRaw(t, templateResource.Schema, map[string]interface{}{
+		"name":      "TenantPolicies1",
+		"tenant_id": "0000ffff0000000000000010",
+	})
+	err := templateResource.Create(template, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceMSOTemplateDeploy().Schema, map[string]interface{}{
+		"template_id": template.Id(),
+	})
+	err = resourceMSOTemplateDeployExecute(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != template.Id() || d.Get("task_id") == "" {
+		t.Errorf("Unexpected id %s and task_id %v", d.Id(), d.Get("task_id"))
+	}
+	var deployed bool
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "api/v1/task" {
+			body := request.Body.(map[string]interface{})
+			deployed = body["templateId"] == template.Id() && body["isRedeploy"] == false
+		}
+	}
+	if !deployed {
+		t.Error("Expected a deploy task of the template")
+	}
+
+	content, err := getDeployTemplateContent(msoClient, template.Id(), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentHash, _ := getTemplateContentHash(content)
+	if contentHash != d.Get("content_hash") {
+		t.Errorf("Expected content_hash %s of the unchanged template, got %v", contentHash, d.Get("content_hash"))
+	}
+	object, _ := server.Object("api/v1/templates/" + template.Id())
+	object.(map[string]interface{})["description"] = "changed"
+	content, err = getDeployTemplateContent(msoClient, template.Id(), "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentHash, _ = getTemplateContentHash(content); contentHash == d.Get("content_hash") {
+		t.Error("Expected another content_hash for the changed template")
+	}
+
+	templateId := template.Id()
+	err = resourceMSOTemplateDelete(template, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err = getDeployTemplateContent(msoClient, templateId, "", "")
+	if err != nil || content != nil {
+		t.Errorf("Expected no content and no error for a removed template, got %v and %v", content, err)
+	}
+}
+
+func TestMockNDOSystemDnsPlatformPath(t *testing.T) {
+	server, _ := testMockNDO(t)
+	ndClient := client.NewClient(server.URL, "admin", client.Password("password"), client.Insecure(true), client.Platform("nd"))
+	server.SetObject(ndClusterConfigUrl, map[string]interface{}{"spec": map[string]interface{}{"nameServers": []interface{}{"10.0.0.1"}}})
+
+	d := schema.TestResourceDataRaw(t, resourceMSOSystemDns().Schema, map[string]interface{}{
+		"name_servers": []interface{}{"10.0.0.2"},
+	})
+	if err := resourceMSOSystemDnsCreate(d, ndClient); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := server.Requests()
+	if len(requests) == 0 {
+		t.Fatal("Expected requests to the cluster configuration")
+	}
+	for _, request := range requests {
+		if request.URLPath != "/"+ndClusterConfigUrl {
+			t.Errorf("Expected %s %s to be sent without the mso prefix", request.Method, request.URLPath)
+		}
+	}
+	config, _ := server.Object(ndClusterConfigUrl)
+	nameServers := config.(map[string]interface{})["spec"].(map[string]interface{})["nameServers"]
+	if !reflect.DeepEqual(nameServers, []interface{}{"10.0.0.2"}) {
+		t.Errorf("Expected the name servers to be updated, got %v", nameServers)
+	}
+}
+
+func TestMockNDOSchemaTemplateContractUpdate(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	schemaObject, _ := server.Object("api/v1/schemas/" + mockSchemaId)
+	template := schemaObject.(map[string]interface{})["templates"].([]interface{})[0].(map[string]interface{})
+	// Contracts which are created without a priority and target DSCP do not have these keys.
+	template["contracts"] = []interface{}{map[string]interface{}{
+		"name":                "Contract1",
+		"displayName":         "Contract1",
+		"scope":               "context",
+		"filterType":          "bothWay",
+		"filterRelationships": []interface{}{},
+	}}
+	server.SetObject("api/v1/schemas/"+mockSchemaId, schemaObject)
+
+	resource := resourceMSOTemplateContract()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"schema_id":     mockSchemaId,
+		"template_name": "Template1",
+		"contract_name": "Contract1",
+		"display_name":  "Contract 1",
+		"scope":         "context",
+		"filter_type":   "bothWay",
+	})
+	d.SetId("Contract1")
+	if err := resource.Update(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+
+	patches := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			patches++
+			if operations := request.Body.([]interface{}); len(operations) != 1 {
+				t.Errorf("Expected the contract to be replaced with one op, got %v", operations)
+			}
+		}
+	}
+	if patches != 1 {
+		t.Errorf("Expected one PATCH request, got %d", patches)
+	}
+	schemaObject, _ = server.Object("api/v1/schemas/" + mockSchemaId)
+	contract := schemaObject.(map[string]interface{})["templates"].([]interface{})[0].(map[string]interface{})["contracts"].([]interface{})[0].(map[string]interface{})
+	if contract["displayName"] != "Contract 1" {
+		t.Errorf("Expected the contract to be updated, got %v", contract)
+	}
+}
@@ -0,0 +1,62 @@
+package mso
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+)
+
+const ndClusterConfigUrl = "nexus/infra/api/platform/v1/clusterconfig"
+
+// ndClusterConfigMutex serializes the updates of the cluster configuration, which replace the whole configuration.
+var ndClusterConfigMutex sync.Mutex
+
+// getNDClusterConfig returns the cluster configuration of the Nexus Dashboard on which NDO is running.
+func getNDClusterConfig(msoClient *client.Client) (*container.Container, error) {
+	if msoClient.GetPlatform() != "nd" {
+		return nil, fmt.Errorf("The cluster configuration can only be managed when the platform is 'nd'")
+	}
+	return doNDPlatformRequest(msoClient, "GET", ndClusterConfigUrl, nil)
+}
+
+// doNDPlatformRequest sends a request to the API of the Nexus Dashboard, of which the paths are not prefixed with mso like the paths of NDO.
+func doNDPlatformRequest(msoClient *client.Client, method, path string, payloadCon *container.Container) (*container.Container, error) {
+	req, err := msoClient.MakePlatformRequest(method, path, payloadCon, true)
+	if err != nil {
+		return nil, err
+	}
+	cont, _, err := msoClient.Do(req.WithContext(getStopContext(msoClient)))
+	if err != nil {
+		return cont, err
+	}
+	if cont == nil {
+		if method == "GET" {
+			return nil, errors.New("Empty response body")
+		}
+		return nil, nil
+	}
+	return cont, client.CheckForErrors(cont, method)
+}
+
+// updateNDClusterConfig applies update to the spec of the current cluster configuration and sends the result.
+func updateNDClusterConfig(msoClient *client.Client, update func(specCont *container.Container) error) error {
+	ndClusterConfigMutex.Lock()
+	defer ndClusterConfigMutex.Unlock()
+
+	configCont, err := getNDClusterConfig(msoClient)
+	if err != nil {
+		return err
+	}
+	if !configCont.Exists("spec") {
+		configCont.Object("spec")
+	}
+	err = update(configCont.S("spec"))
+	if err != nil {
+		return err
+	}
+	_, err = doNDPlatformRequest(msoClient, "PUT", ndClusterConfigUrl, configCont)
+	return err
+}
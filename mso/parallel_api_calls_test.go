@@ -0,0 +1,77 @@
+package mso
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+// testParallelApiCallsClient returns a client with a token for the server, which responds after the delay.
+func testParallelApiCallsClient(t *testing.T, ctx context.Context, delay time.Duration, running, maxRunning *int32) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(running, 1)
+		for {
+			max := atomic.LoadInt32(maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(delay)
+		atomic.AddInt32(running, -1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	msoClient := client.NewClient(server.URL, "admin", client.Insecure(true), client.StopContext(ctx))
+	msoClient.AuthToken = &client.Auth{Token: "token"}
+	msoClient.AuthToken.CalculateExpiry(tokenValidity)
+	return msoClient
+}
+
+func TestMaxParallelApiCalls(t *testing.T) {
+	var running, maxRunning int32
+	msoClient := testParallelApiCallsClient(t, context.Background(), 20*time.Millisecond, &running, &maxRunning)
+	if err := msoClient.SetMaxParallelRequests(2); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := msoClient.GetViaURL("api/v1/schemas"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxRunning != 2 {
+		t.Errorf("Expected 2 parallel API calls, got %d", maxRunning)
+	}
+}
+
+func TestMaxParallelApiCallsCancelled(t *testing.T) {
+	var running, maxRunning int32
+	ctx, cancel := context.WithCancel(context.Background())
+	msoClient := testParallelApiCallsClient(t, ctx, time.Second, &running, &maxRunning)
+	if err := msoClient.SetMaxParallelRequests(1); err != nil {
+		t.Fatal(err)
+	}
+
+	go msoClient.GetViaURL("api/v1/schemas")
+	time.Sleep(100 * time.Millisecond)
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err := msoClient.GetViaURL("api/v1/tenants")
+	if err == nil || !strings.Contains(err.Error(), "cancelled while waiting for a free request slot") {
+		t.Errorf("Expected the request to be cancelled while waiting for a slot, got %v", err)
+	}
+}
@@ -1,7 +1,13 @@
 package mso
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"net/url"
+	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -10,20 +16,40 @@ import (
 )
 
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"username": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MSO_USERNAME", nil),
 				Description: "Username for the MSO Account",
 			},
 			"password": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MSO_PASSWORD", nil),
 				Description: "Password for the MSO Account",
 			},
+			"token": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_TOKEN", nil),
+				Description: "Session token which is used instead of logging in with the username and password",
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_PRIVATE_KEY", nil),
+				Description: "PEM encoded private key or path to the private key of the user certificate for signature based authentication",
+			},
+			"cert_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CERT_NAME", nil),
+				Description: "Name of the certificate of the user for signature based authentication",
+			},
 			"url": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -36,6 +62,30 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("MSO_INSECURE", true),
 				Description: "Allow insecure HTTPS client",
 			},
+			"ca_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CA_CERT_FILE", nil),
+				Description: "Path to the PEM encoded CA certificates which are used to verify the certificate of MSO",
+			},
+			"ca_cert_pem": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CA_CERT_PEM", nil),
+				Description: "PEM encoded CA certificates which are used to verify the certificate of MSO",
+			},
+			"client_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CLIENT_CERT_FILE", nil),
+				Description: "Path to the PEM encoded client certificate for mutual TLS",
+			},
+			"client_key_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CLIENT_KEY_FILE", nil),
+				Description: "Path to the PEM encoded private key of the client certificate for mutual TLS",
+			},
 			"domain": &schema.Schema{
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -58,65 +108,187 @@ func Provider() terraform.ResourceProvider {
 					"nd",
 				}, false),
 			},
+			"max_retries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSO_MAX_RETRIES", defaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of retries of a request when MSO reports a concurrent modification or is unavailable",
+			},
+			"backoff_min_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSO_BACKOFF_MIN_DELAY", defaultBackoffMinDelay),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Delay in seconds before the first retry of a request",
+			},
+			"backoff_max_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSO_BACKOFF_MAX_DELAY", defaultBackoffMaxDelay),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum delay in seconds between the retries of a request",
+			},
+			"backoff_delay_factor": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSO_BACKOFF_DELAY_FACTOR", defaultBackoffDelayFactor),
+				ValidateFunc: validation.FloatAtLeast(1),
+				Description:  "Factor the delay between the retries of a request is multiplied with on every retry",
+			},
+			"schema_cache": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_SCHEMA_CACHE", true),
+				Description: "Share the retrieved schemas between the resources until the provider changes them",
+			},
+			"verify_writes": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_VERIFY_WRITES", false),
+				Description: "Verify that the changes of PATCH requests are visible before the next operation starts",
+			},
+			"cluster": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_CLUSTER", nil),
+				Description: "Name of the member cluster of a Nexus Dashboard federation to send the requests to",
+			},
+			"read_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_READ_ONLY", false),
+				Description: "Reject the create, update and delete operations of all resources, so only read requests are sent",
+			},
+			"validate_references": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("MSO_VALIDATE_REFERENCES", false),
+				Description: "Validate during plan that the objects referenced in other schemas exist",
+			},
+			"max_parallel_api_calls": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("MSO_MAX_PARALLEL_API_CALLS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of API calls sent to MSO at the same time, not limited when 0",
+			},
+			"allowed_sites": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the sites the resources are allowed to address, all sites are allowed when not provided",
+			},
 		},
 
-		ResourcesMap: map[string]*schema.Resource{
-			"mso_schema":                                      resourceMSOSchema(),
-			"mso_schema_site":                                 resourceMSOSchemaSite(),
-			"mso_site":                                        resourceMSOSite(),
-			"mso_remote_location":                             resourceMSORemoteLocation(),
-			"mso_user":                                        resourceMSOUser(),
-			"mso_label":                                       resourceMSOLabel(),
-			"mso_schema_template":                             resourceMSOSchemaTemplate(),
-			"mso_tenant":                                      resourceMSOTenant(),
-			"mso_schema_template_bd":                          resourceMSOTemplateBD(),
-			"mso_schema_template_vrf":                         resourceMSOSchemaTemplateVrf(),
-			"mso_schema_template_bd_subnet":                   resourceMSOTemplateBDSubnet(),
-			"mso_schema_template_anp":                         resourceMSOSchemaTemplateAnp(),
-			"mso_schema_template_anp_epg":                     resourceMSOSchemaTemplateAnpEpg(),
-			"mso_schema_template_anp_epg_contract":            resourceMSOTemplateAnpEpgContract(),
-			"mso_schema_template_contract":                    resourceMSOTemplateContract(),
-			"mso_schema_template_anp_epg_subnet":              resourceMSOSchemaTemplateAnpEpgSubnet(),
-			"mso_schema_template_l3out":                       resourceMSOTemplateL3out(),
-			"mso_schema_template_external_epg":                resourceMSOTemplateExtenalepg(),
-			"mso_schema_template_contract_filter":             resourceMSOTemplateContractFilter(),
-			"mso_schema_template_external_epg_contract":       resourceMSOTemplateExternalEpgContract(),
-			"mso_schema_template_filter_entry":                resourceMSOSchemaTemplateFilterEntry(),
-			"mso_schema_template_external_epg_subnet":         resourceMSOTemplateExtenalepgSubnet(),
-			"mso_schema_site_anp_epg_static_leaf":             resourceMSOSchemaSiteAnpEpgStaticleaf(),
-			"mso_schema_site_anp_epg_static_port":             resourceMSOSchemaSiteAnpEpgStaticPort(),
-			"mso_schema_site_anp_epg_bulk_staticport":         resourceMSOSchemaSiteAnpEpgBulkStaticPort(),
-			"mso_schema_site_bd":                              resourceMSOSchemaSiteBd(),
-			"mso_schema_site_anp_epg_subnet":                  resourceMSOSchemaSiteAnpEpgSubnet(),
-			"mso_schema_site_anp_epg_domain":                  resourceMSOSchemaSiteAnpEpgDomain(),
-			"mso_schema_site_bd_l3out":                        resourceMSOSchemaSiteBdL3out(),
-			"mso_schema_site_vrf":                             resourceMSOSchemaSiteVrf(),
-			"mso_schema_site_vrf_route_leak":                  resourceMSOSchemaSiteVrfRouteLeak(),
-			"mso_schema_site_vrf_region":                      resourceMSOSchemaSiteVrfRegion(),
-			"mso_schema_site_bd_subnet":                       resourceMSOSchemaSiteBdSubnet(),
-			"mso_rest":                                        resourceMSORest(),
-			"mso_schema_template_deploy":                      resourceMSOSchemaTemplateDeploy(),
-			"mso_schema_template_deploy_ndo":                  resourceNDOSchemaTemplateDeploy(),
-			"mso_schema_site_vrf_region_cidr_subnet":          resourceMSOSchemaSiteVrfRegionCidrSubnet(),
-			"mso_schema_site_vrf_region_cidr":                 resourceMSOSchemaSiteVrfRegionCidr(),
-			"mso_schema_site_anp":                             resourceMSOSchemaSiteAnp(),
-			"mso_schema_site_anp_epg":                         resourceMSOSchemaSiteAnpEpg(),
-			"mso_schema_template_anp_epg_selector":            resourceMSOSchemaTemplateAnpEpgSelector(),
-			"mso_schema_site_external_epg":                    resourceMSOSchemaSiteExternalEpg(),
-			"mso_schema_template_external_epg_selector":       resourceSchemaTemplateExternalEPGSelector(),
-			"mso_schema_template_anp_epg_useg_attr":           resourceMSOSchemaTemplateAnpEpgUsegAttr(),
-			"mso_schema_site_anp_epg_selector":                resourceMSOSchemaSiteAnpEpgSelector(),
-			"mso_schema_template_vrf_contract":                resourceMSOTemplateVRFContract(),
-			"mso_schema_site_external_epg_selector":           resourceMSOSchemaSiteExternalEpgSelector(),
-			"mso_schema_template_service_graph":               resourceMSOSchemaTemplateServiceGraphs(),
-			"mso_schema_site_service_graph_node":              resourceMSOSchemaSiteServiceGraphNode(),
-			"mso_schema_site_service_graph":                   resourceMSOSchemaSiteServiceGraph(),
-			"mso_service_node_type":                           resourceMSOServiceNodeType(),
-			"mso_schema_template_contract_service_graph":      resourceMSOSchemaTemplateContractServiceGraph(),
-			"mso_system_config":                               resourceMSOSystemConfig(),
-			"mso_schema_site_contract_service_graph":          resourceMSOSchemaSiteContractServiceGraph(),
-			"mso_schema_site_contract_service_graph_listener": resourceMSOSchemaSiteContractServiceGraphListener(),
-		},
+		ResourcesMap: validateReferencesResources(allowedSitesResources(readOnlyResources(map[string]*schema.Resource{
+			"mso_schema":                                         resourceMSOSchema(),
+			"mso_schema_site":                                    resourceMSOSchemaSite(),
+			"mso_site":                                           resourceMSOSite(),
+			"mso_remote_location":                                resourceMSORemoteLocation(),
+			"mso_user":                                           resourceMSOUser(),
+			"mso_label":                                          resourceMSOLabel(),
+			"mso_schema_template":                                resourceMSOSchemaTemplate(),
+			"mso_tenant":                                         resourceMSOTenant(),
+			"mso_schema_template_bd":                             resourceMSOTemplateBD(),
+			"mso_schema_template_vrf":                            resourceMSOSchemaTemplateVrf(),
+			"mso_schema_template_bd_subnet":                      resourceMSOTemplateBDSubnet(),
+			"mso_schema_template_anp":                            resourceMSOSchemaTemplateAnp(),
+			"mso_schema_template_anp_epg":                        resourceMSOSchemaTemplateAnpEpg(),
+			"mso_schema_template_anp_epg_contract":               resourceMSOTemplateAnpEpgContract(),
+			"mso_schema_template_contract":                       resourceMSOTemplateContract(),
+			"mso_schema_template_anp_epg_subnet":                 resourceMSOSchemaTemplateAnpEpgSubnet(),
+			"mso_schema_template_l3out":                          resourceMSOTemplateL3out(),
+			"mso_schema_template_external_epg":                   resourceMSOTemplateExtenalepg(),
+			"mso_schema_template_contract_filter":                resourceMSOTemplateContractFilter(),
+			"mso_schema_template_external_epg_contract":          resourceMSOTemplateExternalEpgContract(),
+			"mso_schema_template_filter_entry":                   resourceMSOSchemaTemplateFilterEntry(),
+			"mso_schema_template_external_epg_subnet":            resourceMSOTemplateExtenalepgSubnet(),
+			"mso_schema_site_anp_epg_static_leaf":                resourceMSOSchemaSiteAnpEpgStaticleaf(),
+			"mso_schema_site_anp_epg_static_port":                resourceMSOSchemaSiteAnpEpgStaticPort(),
+			"mso_schema_site_anp_epg_bulk_staticport":            resourceMSOSchemaSiteAnpEpgBulkStaticPort(),
+			"mso_schema_site_bd":                                 resourceMSOSchemaSiteBd(),
+			"mso_schema_site_anp_epg_subnet":                     resourceMSOSchemaSiteAnpEpgSubnet(),
+			"mso_schema_site_anp_epg_domain":                     resourceMSOSchemaSiteAnpEpgDomain(),
+			"mso_schema_site_bd_l3out":                           resourceMSOSchemaSiteBdL3out(),
+			"mso_schema_site_vrf":                                resourceMSOSchemaSiteVrf(),
+			"mso_schema_site_vrf_route_leak":                     resourceMSOSchemaSiteVrfRouteLeak(),
+			"mso_schema_site_vrf_region":                         resourceMSOSchemaSiteVrfRegion(),
+			"mso_schema_site_bd_subnet":                          resourceMSOSchemaSiteBdSubnet(),
+			"mso_rest":                                           resourceMSORest(),
+			"mso_schema_template_deploy":                         resourceMSOSchemaTemplateDeploy(),
+			"mso_schema_template_deploy_ndo":                     resourceNDOSchemaTemplateDeploy(),
+			"mso_template_deploy":                                resourceMSOTemplateDeploy(),
+			"mso_schema_site_vrf_region_cidr_subnet":             resourceMSOSchemaSiteVrfRegionCidrSubnet(),
+			"mso_schema_site_vrf_region_cidr":                    resourceMSOSchemaSiteVrfRegionCidr(),
+			"mso_schema_site_anp":                                resourceMSOSchemaSiteAnp(),
+			"mso_schema_site_anp_epg":                            resourceMSOSchemaSiteAnpEpg(),
+			"mso_schema_template_anp_epg_selector":               resourceMSOSchemaTemplateAnpEpgSelector(),
+			"mso_schema_site_external_epg":                       resourceMSOSchemaSiteExternalEpg(),
+			"mso_schema_template_external_epg_selector":          resourceSchemaTemplateExternalEPGSelector(),
+			"mso_schema_template_anp_epg_useg_attr":              resourceMSOSchemaTemplateAnpEpgUsegAttr(),
+			"mso_schema_site_anp_epg_selector":                   resourceMSOSchemaSiteAnpEpgSelector(),
+			"mso_schema_template_vrf_contract":                   resourceMSOTemplateVRFContract(),
+			"mso_schema_site_external_epg_selector":              resourceMSOSchemaSiteExternalEpgSelector(),
+			"mso_schema_template_service_graph":                  resourceMSOSchemaTemplateServiceGraphs(),
+			"mso_schema_site_service_graph_node":                 resourceMSOSchemaSiteServiceGraphNode(),
+			"mso_schema_site_service_graph":                      resourceMSOSchemaSiteServiceGraph(),
+			"mso_service_node_type":                              resourceMSOServiceNodeType(),
+			"mso_schema_template_contract_service_graph":         resourceMSOSchemaTemplateContractServiceGraph(),
+			"mso_system_config":                                  resourceMSOSystemConfig(),
+			"mso_schema_site_contract_service_graph":             resourceMSOSchemaSiteContractServiceGraph(),
+			"mso_schema_site_contract_service_graph_listener":    resourceMSOSchemaSiteContractServiceGraphListener(),
+			"mso_schema_import":                                  resourceMSOSchemaJsonImport(),
+			"mso_backup_file":                                    resourceMSOBackupFile(),
+			"mso_tenant_policies_ipsla_track_list":               resourceMSOTenantPoliciesIpslaTrackList(),
+			"mso_tenant_policies_l3out_interface_routing_policy": resourceMSOTenantPoliciesL3outInterfaceRoutingPolicy(),
+			"mso_tenant_policies_template":                       resourceMSOTemplate(tenantPolicyTemplate),
+			"mso_tenant_policies_igmp_interface_policy":          resourceMSOTenantPoliciesIgmpInterfacePolicy(),
+			"mso_tenant_policies_multicast_route_map_policy":     resourceMSOTenantPoliciesMulticastRouteMapPolicy(),
+			"mso_l3out_template":                                 resourceMSOTemplate(l3outTemplate),
+			"mso_l3out_template_l3out":                           resourceMSOL3outTemplateL3out(),
+			"mso_l3out_template_node":                            resourceMSOL3outTemplateNode(),
+			"mso_l3out_template_node_group":                      resourceMSOL3outTemplateNodeGroup(),
+			"mso_l3out_template_interface_group":                 resourceMSOL3outTemplateInterfaceGroup(),
+			"mso_fabric_policies_template":                       resourceMSOTemplate(fabricPolicyTemplate),
+			"mso_fabric_policies_sr_mpls_qos_policy":             resourceMSOFabricPoliciesSrMplsQosPolicy(),
+			"mso_fabric_policies_vlan_pool":                      resourceMSOFabricPoliciesVlanPool(),
+			"mso_fabric_policies_physical_domain":                resourceMSOFabricPoliciesPhysicalDomain(),
+			"mso_fabric_policies_l3_domain":                      resourceMSOFabricPoliciesL3Domain(),
+			"mso_fabric_policies_interface_policy_group":         resourceMSOFabricPoliciesInterfacePolicyGroup(),
+			"mso_fabric_policies_ntp_policy":                     resourceMSOFabricPoliciesNtpPolicy(),
+			"mso_fabric_policies_ptp_policy":                     resourceMSOFabricPoliciesPtpPolicy(),
+			"mso_fabric_policies_dns_policy":                     resourceMSOFabricPoliciesDnsPolicy(),
+			"mso_fabric_policies_snmp_policy":                    resourceMSOFabricPoliciesSnmpPolicy(),
+			"mso_fabric_policies_syslog_policy":                  resourceMSOFabricPoliciesSyslogPolicy(),
+			"mso_fabric_resource_policies_template":              resourceMSOTemplate(fabricResourceTemplate),
+			"mso_fabric_resource_policies_physical_interface":    resourceMSOFabricResourcePoliciesPhysicalInterface(),
+			"mso_fabric_resource_policies_port_channel":          resourceMSOFabricResourcePoliciesPortChannel(),
+			"mso_fabric_resource_policies_vpc":                   resourceMSOFabricResourcePoliciesVpc(),
+			"mso_fabric_resource_policies_node_profile":          resourceMSOFabricResourcePoliciesNodeProfile(),
+			"mso_monitoring_policies_template":                   resourceMSOTemplate(monitoringTemplate),
+			"mso_monitoring_policies_span_session":               resourceMSOMonitoringPoliciesSpanSession(),
+			"mso_schema_site_vrf_sr_mpls_l3out":                  resourceMSOSchemaSiteVrfSrMplsL3out(),
+			"mso_service_device_template":                        resourceMSOTemplate(serviceDeviceTemplate),
+			"mso_service_device_cloud_device":                    resourceMSOServiceDeviceCloudDevice(),
+			"mso_service_device_cluster":                         resourceMSOServiceDeviceCluster(),
+			"mso_tenant_user":                                    resourceMSOTenantUser(),
+			"mso_tenant_site":                                    resourceMSOTenantSite(),
+			"mso_schema_site_external_epg_subnet":                resourceMSOSchemaSiteExternalEpgSubnet(),
+			"mso_dhcp_option_policy":                             resourceMSODHCPOptionPolicy(),
+			"mso_dhcp_relay_policy":                              resourceMSODHCPRelayPolicy(),
+			"mso_system_syslog":                                  resourceMSOSystemSyslog(),
+			"mso_system_ntp":                                     resourceMSOSystemNtp(),
+			"mso_system_dns":                                     resourceMSOSystemDns(),
+			"mso_system_authentication":                          resourceMSOSystemAuthentication(),
+			"mso_system_password_policy":                         resourceMSOSystemPasswordPolicy(),
+			"mso_schema_patch":                                   resourceMSOSchemaPatch(),
+			"mso_site_aws_hub_network":                           resourceMSOSiteAwsHubNetwork(),
+			"mso_site_azure_hub_network":                         resourceMSOSiteAzureHubNetwork(),
+			"mso_site_sr_mpls_l3out":                             resourceMSOSiteSrMplsL3out(),
+			"mso_schema_template_reconcile":                      resourceMSOSchemaTemplateReconcile(),
+			"mso_schema_template_approval":                       resourceMSOSchemaTemplateApproval(),
+		}))),
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"mso_schema":                                      datasourceMSOSchema(),
@@ -166,69 +338,172 @@ func Provider() terraform.ResourceProvider {
 			"mso_schema_site_external_epg_selector":           datasourceMSOSchemaSiteExternalEpgSelector(),
 			"mso_schema_template_service_graph":               dataSourceMSOSchemaTemplateServiceGraph(),
 			"mso_service_node_type":                           dataSourceMSOServiceNodeType(),
+			"mso_service_node_types":                          dataSourceMSOServiceNodeTypes(),
 			"mso_schema_site_service_graph":                   datasourceMSOSchemaSiteServiceGraph(),
 			"mso_schema_template_contract_service_graph":      dataSourceMSOSchemaTemplateContractServiceGraph(),
 			"mso_system_config":                               dataSourceMSOSystemConfig(),
 			"mso_rest":                                        datasourceMSORest(),
 			"mso_schema_site_contract_service_graph":          dataSourceMSOSchemaSiteContractServiceGraph(),
 			"mso_schema_site_contract_service_graph_listener": dataSourceMSOSchemaSiteContractServiceGraphListener(),
+			"mso_site_service_devices":                        dataSourceMSOSiteServiceDevices(),
+			"mso_site_cloud_regions":                          dataSourceMSOSiteCloudRegions(),
+			"mso_site_domains":                                dataSourceMSOSiteDomains(),
+			"mso_site_l3outs":                                 dataSourceMSOSiteL3outs(),
+			"mso_site_pods":                                   dataSourceMSOSitePods(),
+			"mso_schema_template_export":                      datasourceMSOSchemaTemplateExport(),
+			"mso_schema_import_ids":                           dataSourceMSOSchemaImportIds(),
+			"mso_current_user":                                datasourceMSOCurrentUser(),
+			"mso_backups":                                     datasourceMSOBackups(),
+			"mso_dhcp_relay_policies":                         dataSourceMSODHCPRelayPolicies(),
+			"mso_dhcp_option_policies":                        dataSourceMSODHCPOptionPolicies(),
+			"mso_schema_template_summary":                     datasourceMSOSchemaTemplateSummary(),
+			"mso_template_policies":                           datasourceMSOTemplatePolicies(),
+			"mso_schema_template_deploy_plan":                 datasourceMSOSchemaTemplateDeployPlan(),
+			"mso_schema_template_deployment_history":          datasourceMSOSchemaTemplateDeploymentHistory(),
 		},
+	}
 
-		ConfigureFunc: configureClient,
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return configureClient(d, provider.StopContext())
 	}
+	return provider
 }
 
-func configureClient(d *schema.ResourceData) (interface{}, error) {
+func configureClient(d *schema.ResourceData, stopContext context.Context) (interface{}, error) {
 	config := Config{
-		Username:   d.Get("username").(string),
-		Password:   d.Get("password").(string),
-		URL:        d.Get("url").(string),
-		IsInsecure: d.Get("insecure").(bool),
-		ProxyUrl:   d.Get("proxy_url").(string),
-		Domain:     d.Get("domain").(string),
-		Platform:   d.Get("platform").(string),
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+		URL:         d.Get("url").(string),
+		IsInsecure:  d.Get("insecure").(bool),
+		ProxyUrl:    d.Get("proxy_url").(string),
+		Domain:      d.Get("domain").(string),
+		Platform:    d.Get("platform").(string),
+		Cluster:     d.Get("cluster").(string),
+		Token:       d.Get("token").(string),
+		PrivateKey:  d.Get("private_key").(string),
+		CertName:    d.Get("cert_name").(string),
+		StopContext: stopContext,
 	}
 
+	rootCAs, certificates, err := loadTLSCertificates(d.Get("ca_cert_file").(string), d.Get("ca_cert_pem").(string), d.Get("client_cert_file").(string), d.Get("client_key_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.RootCAs = rootCAs
+	config.Certificates = certificates
+
 	if err := config.Valid(); err != nil {
 		return nil, err
 	}
 
-	return config.getClient(), nil
+	msoClient, err := config.getClient()
+	if err != nil {
+		return nil, err
+	}
+	err = configureRetryPolicy(msoClient, d.Get("max_retries").(int), d.Get("backoff_min_delay").(int), d.Get("backoff_max_delay").(int), d.Get("backoff_delay_factor").(float64))
+	if err != nil {
+		return nil, err
+	}
+	err = msoClient.SetMaxParallelRequests(d.Get("max_parallel_api_calls").(int))
+	if err != nil {
+		return nil, err
+	}
+
+	setProviderSettings(msoClient, newProviderSettings(d, stopContext))
+
+	return msoClient, nil
 }
 
 func (c Config) Valid() error {
 
-	if c.Username == "" {
-		return fmt.Errorf("Username must be provided for the MSO provider")
+	if c.Token != "" {
+		if c.Password != "" || c.PrivateKey != "" {
+			return fmt.Errorf("Token can not be provided together with password or private_key for the MSO provider")
+		}
+	} else {
+		if c.Username == "" {
+			return fmt.Errorf("Username must be provided for the MSO provider")
+		}
+		if c.Password == "" && c.PrivateKey == "" {
+			return fmt.Errorf("Password, private_key or token must be provided for the MSO provider")
+		}
 	}
-
-	if c.Password == "" {
-
-		return fmt.Errorf("Password must be provided for the MSO provider")
+	if (c.PrivateKey == "") != (c.CertName == "") {
+		return fmt.Errorf("private_key and cert_name must be provided together for the MSO provider")
 	}
 	if c.URL == "" {
 		return fmt.Errorf("URL must be provided for MSO provider")
 	}
+	if c.Cluster != "" && c.Platform != "nd" {
+		return fmt.Errorf("Cluster can only be provided for MSO provider when platform is nd")
+	}
 
 	return nil
 }
 
-func (c Config) getClient() interface{} {
-	if c.Password != "" {
+func (c Config) getClient() (*client.Client, error) {
+	msoClient := client.NewClient(c.clientURL(), c.Username, client.Password(c.Password), client.Insecure(c.IsInsecure), client.ProxyUrl(c.ProxyUrl), client.Domain(c.Domain), client.Platform(c.Platform), client.StopContext(c.StopContext))
+	if c.RootCAs != nil || len(c.Certificates) > 0 {
+		err := configureClientTLSCertificates(msoClient, c.RootCAs, c.Certificates)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.PrivateKey != "" {
+		err := configureCertificateAuthentication(msoClient, c.PrivateKey, c.CertName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c.Token != "" {
+		// The expiry of the token is unknown, it is used until NDO rejects it because the client can not log in without a password.
+		msoClient.AuthToken = &client.Auth{Token: c.Token}
+		msoClient.AuthToken.CalculateExpiry(tokenValidity)
+	}
+	return msoClient, nil
+}
 
-		return client.GetClient(c.URL, c.Username, client.Password(c.Password), client.Insecure(c.IsInsecure), client.ProxyUrl(c.ProxyUrl), client.Domain(c.Domain), client.Platform(c.Platform))
+// tokenValidity is the number of seconds the client considers a configured token valid.
+const tokenValidity = 10 * 365 * 24 * 60 * 60
 
+// configureCertificateAuthentication configures the client to sign the requests with the private key, which is either the PEM content or the path to the key file.
+func configureCertificateAuthentication(msoClient *client.Client, privateKey, certName string) error {
+	if !strings.Contains(privateKey, "-----BEGIN") {
+		content, err := ioutil.ReadFile(privateKey)
+		if err != nil {
+			return fmt.Errorf("Unable to read the private key file: %s", err)
+		}
+		privateKey = string(content)
 	}
-	return nil
+	return msoClient.SetCertificateAuthentication(privateKey, certName)
 }
 
 // Config
 type Config struct {
-	Username   string
-	Password   string
-	IsInsecure bool
-	ProxyUrl   string
-	URL        string
-	Domain     string
-	Platform   string
+	Username     string
+	Password     string
+	IsInsecure   bool
+	ProxyUrl     string
+	URL          string
+	Domain       string
+	Platform     string
+	Cluster      string
+	Token        string
+	PrivateKey   string
+	CertName     string
+	RootCAs      *x509.CertPool
+	Certificates []tls.Certificate
+	StopContext  context.Context
+}
+
+// ndFederationClusterPath is the path prefix the Nexus Dashboard federation proxy uses to forward requests to a member cluster.
+const ndFederationClusterPath = "proxy/cluster/%s/"
+
+// clientURL returns the URL the client resolves the request paths against.
+// When a federation member cluster is configured the proxy prefix is added, the login is still sent to the URL root of the Nexus Dashboard which authenticates for the whole federation.
+func (c Config) clientURL() string {
+	if c.Cluster == "" {
+		return c.URL
+	}
+	return strings.TrimSuffix(c.URL, "/") + "/" + fmt.Sprintf(ndFederationClusterPath, url.PathEscape(c.Cluster))
 }
@@ -0,0 +1,56 @@
+package mso
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// providerSettings holds the settings of a provider configuration which change the behavior of the resources.
+// Every provider configuration creates its own client and stores its settings for it, so the settings of an alias do not apply to the other aliases.
+type providerSettings struct {
+	verifyWrites       bool
+	readOnly           bool
+	validateReferences bool
+	schemaCache        bool
+	// allowedSites holds the site ids of allowed_sites, all sites are allowed when it is empty.
+	allowedSites map[string]bool
+	// plannedStaticPorts holds the static ports which are validated during the plan, it is nil for clients which are not configured by the provider.
+	plannedStaticPorts *plannedStaticPorts
+	// stopContext is the stop context of the provider, it is nil for clients which are not configured by the provider.
+	stopContext context.Context
+}
+
+// settingsByClient holds the providerSettings of the configurations by their client.
+var settingsByClient sync.Map
+
+// newProviderSettings returns the settings of the provider configuration with the stop context of the provider.
+func newProviderSettings(d *schema.ResourceData, stopContext context.Context) *providerSettings {
+	return &providerSettings{
+		verifyWrites:       d.Get("verify_writes").(bool),
+		readOnly:           d.Get("read_only").(bool),
+		validateReferences: d.Get("validate_references").(bool),
+		schemaCache:        d.Get("schema_cache").(bool),
+		allowedSites:       getAllowedSites(d.Get("allowed_sites").([]interface{})),
+		plannedStaticPorts: newPlannedStaticPorts(),
+		stopContext:        stopContext,
+	}
+}
+
+// setProviderSettings stores the settings of the provider configuration of the client.
+func setProviderSettings(msoClient *client.Client, settings *providerSettings) {
+	settingsByClient.Store(msoClient, settings)
+}
+
+// getProviderSettings returns the settings of the provider configuration of the meta, which is the client.
+// Clients which are not configured by the provider, like in tests, get the default settings.
+func getProviderSettings(m interface{}) *providerSettings {
+	if msoClient, ok := m.(*client.Client); ok {
+		if settings, ok := settingsByClient.Load(msoClient); ok {
+			return settings.(*providerSettings)
+		}
+	}
+	return &providerSettings{}
+}
@@ -1,9 +1,11 @@
 package mso
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -28,6 +30,57 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProviderClusterURL(t *testing.T) {
+	config := Config{URL: "https://nd.example.com/", Platform: "nd", Cluster: "cluster 2"}
+	if got, want := config.clientURL(), "https://nd.example.com/proxy/cluster/cluster%202/"; got != want {
+		t.Fatalf("clientURL: got %s, want %s", got, want)
+	}
+	config.Cluster = ""
+	if got, want := config.clientURL(), "https://nd.example.com/"; got != want {
+		t.Fatalf("clientURL: got %s, want %s", got, want)
+	}
+}
+
+func TestProviderConfigurationsDoNotShareClients(t *testing.T) {
+	config := Config{Username: "admin", URL: "https://mso.example.com", Token: "token1"}
+	client1, err := config.getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.Token = "token2"
+	client2, err := config.getClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client1 == client2 {
+		t.Fatal("Expected configurations which only differ in the token to use different clients")
+	}
+	if client1.AuthToken.Token != "token1" || client2.AuthToken.Token != "token2" {
+		t.Errorf("Expected every client to keep its token, got %s and %s", client1.AuthToken.Token, client2.AuthToken.Token)
+	}
+}
+
+func TestProviderConfigValid(t *testing.T) {
+	tests := []struct {
+		config Config
+		valid  bool
+	}{
+		{Config{Username: "admin", Password: "password", URL: "https://mso.example.com"}, true},
+		{Config{Username: "admin", PrivateKey: "admin.key", CertName: "admin", URL: "https://mso.example.com"}, true},
+		{Config{Username: "admin", URL: "https://mso.example.com"}, false},
+		{Config{Username: "admin", PrivateKey: "admin.key", URL: "https://mso.example.com"}, false},
+		{Config{Username: "admin", Password: "password", CertName: "admin", URL: "https://mso.example.com"}, false},
+		{Config{Token: "token", URL: "https://mso.example.com"}, true},
+		{Config{Username: "admin", Token: "token", URL: "https://mso.example.com"}, true},
+		{Config{Username: "admin", Password: "password", Token: "token", URL: "https://mso.example.com"}, false},
+	}
+	for _, test := range tests {
+		if err := test.config.Valid(); (err == nil) != test.valid {
+			t.Errorf("Valid() of %+v returned %v, expected valid %t", test.config, err, test.valid)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// We will use this function later on to make sure our test environment is valid.
 	// For example, you can make sure here that some environment variables are set.
@@ -44,3 +97,65 @@ func testAccPreCheck(t *testing.T) {
 	}
 
 }
+
+// TestAccMSOProvider_MultipleAliases creates a label through two provider aliases which point to different clusters.
+// The second cluster is configured with MSO_URL_2, MSO_USERNAME_2 and MSO_PASSWORD_2, the test is skipped without them.
+func TestAccMSOProvider_MultipleAliases(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			for _, variable := range []string{"MSO_URL_2", "MSO_USERNAME_2", "MSO_PASSWORD_2"} {
+				if os.Getenv(variable) == "" {
+					t.Skipf("%s must be set for the multiple aliases acceptance test", variable)
+				}
+			}
+		},
+		// Every alias is configured on its own provider instance, like in Terraform.
+		ProviderFactories: map[string]terraform.ResourceProviderFactory{
+			"mso": func() (terraform.ResourceProvider, error) { return Provider(), nil },
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMSOProviderMultipleAliasesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("mso_label.cluster1", "label", "acc_alias_cluster1"),
+					resource.TestCheckResourceAttr("mso_label.cluster2", "label", "acc_alias_cluster2"),
+					resource.TestCheckResourceAttrPair("data.mso_label.cluster1", "id", "mso_label.cluster1", "id"),
+					resource.TestCheckResourceAttrPair("data.mso_label.cluster2", "id", "mso_label.cluster2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMSOProviderMultipleAliasesConfig() string {
+	return fmt.Sprintf(`
+	provider "mso" {
+		alias    = "cluster2"
+		url      = "%s"
+		username = "%s"
+		password = "%s"
+		insecure = true
+	}
+
+	resource "mso_label" "cluster1" {
+		label = "acc_alias_cluster1"
+		type  = "site"
+	}
+
+	resource "mso_label" "cluster2" {
+		provider = mso.cluster2
+		label    = "acc_alias_cluster2"
+		type     = "site"
+	}
+
+	data "mso_label" "cluster1" {
+		label = mso_label.cluster1.label
+	}
+
+	data "mso_label" "cluster2" {
+		provider = mso.cluster2
+		label    = mso_label.cluster2.label
+	}
+	`, os.Getenv("MSO_URL_2"), os.Getenv("MSO_USERNAME_2"), os.Getenv("MSO_PASSWORD_2"))
+}
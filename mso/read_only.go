@@ -0,0 +1,35 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// readOnlyResources wraps the create, update and delete functions of the resources, so they fail before any request is sent in read-only mode.
+// Reads, imports and data sources only send GET requests and are not changed.
+func readOnlyResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, resource := range resources {
+		if resource.Create != nil {
+			resource.Create = readOnlyOperation(name, "create", resource.Create)
+		}
+		if resource.Update != nil {
+			resource.Update = readOnlyOperation(name, "update", resource.Update)
+		}
+		if resource.Delete != nil {
+			resource.Delete = readOnlyOperation(name, "delete", resource.Delete)
+		}
+	}
+	return resources
+}
+
+func readOnlyOperation(name, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		if getProviderSettings(m).readOnly {
+			log.Printf("[DEBUG] %s: Rejecting %s of %s in read-only mode", d.Id(), operation, name)
+			return fmt.Errorf("The provider is configured with read_only, the %s of %s is not allowed. Remove read_only from the provider configuration to apply changes.", operation, name)
+		}
+		return f(d, m)
+	}
+}
@@ -0,0 +1,56 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestReadOnly(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	resource := Provider().(*schema.Provider).ResourcesMap["mso_schema_template_vrf"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF1",
+		"display_name": "VRF1",
+	})
+	d.SetId("VRF1")
+
+	setProviderSettings(msoClient, &providerSettings{readOnly: true})
+	if err := resource.Create(d, msoClient); err == nil {
+		t.Error("Expected the create to fail in read-only mode")
+	}
+	if err := resource.Delete(d, msoClient); err == nil {
+		t.Error("Expected the delete to fail in read-only mode")
+	}
+	if requests := server.Requests(); len(requests) != 0 {
+		t.Errorf("Expected no requests in read-only mode, got %v", requests)
+	}
+	if err := resource.Read(d, msoClient); err != nil || d.Id() != "VRF1" {
+		t.Errorf("Expected the read to succeed in read-only mode, got %v", err)
+	}
+}
+
+func TestReadOnlyPerConfiguration(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	writableClient := client.NewClient(server.URL, "admin", client.Password("password"), client.Insecure(true))
+	setProviderSettings(msoClient, &providerSettings{readOnly: true})
+	setProviderSettings(writableClient, &providerSettings{})
+	defer settingsByClient.Delete(writableClient)
+
+	resource := Provider().(*schema.Provider).ResourcesMap["mso_schema_template_vrf"]
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"schema_id":    mockSchemaId,
+		"template":     "Template1",
+		"name":         "VRF2",
+		"display_name": "VRF2",
+	})
+	if err := resource.Create(d, msoClient); err == nil {
+		t.Error("Expected the create to fail for the read-only configuration")
+	}
+	if err := resource.Create(d, writableClient); err != nil {
+		t.Errorf("Expected the create to succeed for the writable configuration, got %v", err)
+	}
+}
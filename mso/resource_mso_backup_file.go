@@ -0,0 +1,176 @@
+package mso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOBackupFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOBackupFileCreate,
+		Read:   resourceMSOBackupFileRead,
+		Delete: resourceMSOBackupFileDelete,
+
+		SchemaVersion: version,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: (map[string]*schema.Schema{
+			"direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "download",
+				ValidateFunc: validation.StringInSlice([]string{
+					"download",
+					"upload",
+				}, false),
+			},
+			"backup_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"local_path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"source_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha256": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			direction := diff.Get("direction").(string)
+			_, backupIdOk := diff.GetOk("backup_id")
+			if direction == "download" && !backupIdOk && diff.NewValueKnown("backup_id") {
+				return errors.New(`"backup_id" is required to download an MSO backup.`)
+			}
+			if direction == "upload" && diff.Id() == "" && backupIdOk {
+				return errors.New(`"backup_id" cannot be provided to upload an MSO backup.`)
+			}
+			return nil
+		},
+	}
+}
+
+func resourceMSOBackupFileCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Backup File: Beginning Create")
+
+	msoClient := m.(*client.Client)
+	localPath := d.Get("local_path").(string)
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if d.Get("direction").(string) == "upload" {
+		cont, err := uploadBackup(ctx, msoClient, localPath)
+		if err != nil {
+			return err
+		}
+		backupId := models.StripQuotes(cont.S("id").String())
+		if backupId == "" || backupId == "{}" {
+			return fmt.Errorf("Upload of backup %s did not return a backup id", localPath)
+		}
+		d.SetId(backupId)
+		d.Set("backup_id", backupId)
+	} else {
+		backupId := d.Get("backup_id").(string)
+		if _, err := downloadBackup(ctx, msoClient, backupId, localPath); err != nil {
+			return err
+		}
+		d.SetId(backupId)
+	}
+
+	log.Printf("[DEBUG] %s: Backup File Create finished successfully", d.Id())
+	return resourceMSOBackupFileRead(d, m)
+}
+
+func resourceMSOBackupFileRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	localPath := d.Get("local_path").(string)
+
+	if d.Get("direction").(string) == "upload" {
+		ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutRead))
+		defer cancel()
+		cont, _, err := doRequestWithContext(ctx, msoClient, "GET", fmt.Sprintf("api/v1/backups/%s", d.Id()), nil)
+		if err == nil && cont == nil {
+			err = errors.New("Empty response body")
+		}
+		if err != nil {
+			return errorForObjectNotFound(err, d.Id(), cont, d)
+		}
+		d.Set("backup_id", d.Id())
+		if name := models.StripQuotes(cont.S("name").String()); name != "{}" {
+			d.Set("name", name)
+		}
+	} else {
+		d.Set("name", "")
+	}
+
+	sha, err := fileSha256(localPath)
+	if os.IsNotExist(err) {
+		if d.Get("direction").(string) == "download" {
+			log.Printf("[WARN] Downloaded backup %s not found, removing from state: %s", localPath, d.Id())
+			d.SetId("")
+			return nil
+		}
+		sha = ""
+	} else if err != nil {
+		return err
+	}
+	d.Set("sha256", sha)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOBackupFileDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+
+	msoClient := m.(*client.Client)
+
+	if d.Get("direction").(string) == "upload" {
+		ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutDelete))
+		defer cancel()
+		_, _, err := doRequestWithContext(ctx, msoClient, "DELETE", fmt.Sprintf("api/v1/backups/%s", d.Id()), nil)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := os.Remove(d.Get("local_path").(string))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,220 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSODHCPOptionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSODHCPOptionPolicyCreate,
+		Read:   resourceMSODHCPOptionPolicyRead,
+		Update: resourceMSODHCPOptionPolicyUpdate,
+		Delete: resourceMSODHCPOptionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSODHCPOptionPolicyImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"tenant_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"option": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"id": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDHCPOptionId,
+						},
+						"data": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		}),
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("option") {
+				return nil
+			}
+			return validateDHCPOptions(diff.Get("option").(*schema.Set).List())
+		},
+	}
+}
+
+// validateDHCPOptionId verifies that the id is a DHCP option code, the codes 0 (pad) and 255 (end) are reserved.
+func validateDHCPOptionId(i interface{}, k string) ([]string, []error) {
+	id, err := strconv.Atoi(i.(string))
+	if err != nil || id < 1 || id > 254 {
+		return nil, []error{fmt.Errorf("expected %s to be a DHCP option code between 1 and 254, got %s", k, i)}
+	}
+	return nil, nil
+}
+
+// validateDHCPOptions verifies that the name and the id of every option are unique within the policy.
+func validateDHCPOptions(options []interface{}) error {
+	names := make(map[string]bool)
+	ids := make(map[string]bool)
+	for _, option := range options {
+		optionMap := option.(map[string]interface{})
+		name := optionMap["name"].(string)
+		id := optionMap["id"].(string)
+		if names[name] {
+			return fmt.Errorf("DHCP option name %s is configured more than once.", name)
+		}
+		if ids[id] {
+			return fmt.Errorf("DHCP option id %s is configured more than once.", id)
+		}
+		names[name] = true
+		ids[id] = true
+	}
+	return nil
+}
+
+func getDHCPOptionPolicyFromConfig(d *schema.ResourceData) *models.DHCPOptionPolicy {
+	policy := models.DHCPOptionPolicy{
+		Name:          d.Get("name").(string),
+		Desc:          d.Get("description").(string),
+		TenantID:      d.Get("tenant_id").(string),
+		PolicyType:    "dhcp",
+		PolicySubtype: "option",
+		DHCPOption:    make([]models.DHCPOption, 0),
+	}
+	for _, option := range d.Get("option").(*schema.Set).List() {
+		optionMap := option.(map[string]interface{})
+		policy.DHCPOption = append(policy.DHCPOption, models.DHCPOption{
+			ID:   optionMap["id"].(string),
+			Name: optionMap["name"].(string),
+			Data: optionMap["data"].(string),
+		})
+	}
+	return models.NewDHCPOptionPolicy(policy)
+}
+
+func setDHCPOptionPolicyAttributes(d *schema.ResourceData, cont *container.Container) error {
+	policy, err := models.DHCPOptionPolicyFromContainer(cont)
+	if err != nil {
+		return err
+	}
+	d.SetId(policy.ID)
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Desc)
+	d.Set("tenant_id", policy.TenantID)
+
+	options := make([]interface{}, 0, len(policy.DHCPOption))
+	for _, option := range policy.DHCPOption {
+		options = append(options, map[string]interface{}{
+			"name": option.Name,
+			"id":   option.ID,
+			"data": option.Data,
+		})
+	}
+	d.Set("option", options)
+	return nil
+}
+
+func resourceMSODHCPOptionPolicyImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	err := resourceMSODHCPOptionPolicyRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Unable to find the DHCP Option Policy")
+	}
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSODHCPOptionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] DHCP Option Policy: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	cont, err := msoClient.CreateDHCPOptionPolicy(getDHCPOptionPolicyFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(models.StripQuotes(cont.S("id").String()))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSODHCPOptionPolicyRead(d, m)
+}
+
+func resourceMSODHCPOptionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	cont, err := msoClient.ReadDHCPOptionPolicy(d.Id())
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	err = setDHCPOptionPolicyAttributes(d, cont)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSODHCPOptionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	policy := getDHCPOptionPolicyFromConfig(d)
+	policy.ID = d.Id()
+	// The policy is replaced as a whole so options that are removed from the configuration are also removed from the policy.
+	_, err := msoClient.Put(fmt.Sprintf("api/v1/policies/dhcp/option/%s", d.Id()), policy)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSODHCPOptionPolicyRead(d, m)
+}
+
+func resourceMSODHCPOptionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := msoClient.DeleteDHCPOptionPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,36 @@
+package mso
+
+import (
+	"testing"
+)
+
+func TestValidateDHCPOptions(t *testing.T) {
+	option := func(name, id string) interface{} {
+		return map[string]interface{}{"name": name, "id": id, "data": ""}
+	}
+
+	tests := []struct {
+		options []interface{}
+		valid   bool
+	}{
+		{[]interface{}{}, true},
+		{[]interface{}{option("router", "3"), option("dns", "6")}, true},
+		{[]interface{}{option("router", "3"), option("router", "6")}, false},
+		{[]interface{}{option("router", "3"), option("gateway", "3")}, false},
+	}
+	for _, test := range tests {
+		err := validateDHCPOptions(test.options)
+		if (err == nil) != test.valid {
+			t.Errorf("validateDHCPOptions(%v) returned %v, expected valid %t", test.options, err, test.valid)
+		}
+	}
+}
+
+func TestValidateDHCPOptionId(t *testing.T) {
+	for id, valid := range map[string]bool{"1": true, "254": true, "0": false, "255": false, "dns": false} {
+		_, errs := validateDHCPOptionId(id, "option.id")
+		if (len(errs) == 0) != valid {
+			t.Errorf("validateDHCPOptionId(%s) returned %v, expected valid %t", id, errs, valid)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var dhcpRelayEpgRefRegex = regexp.MustCompile(`^/schemas/([^/]+)/templates/([^/]+)/anps/([^/]+)/epgs/([^/]+)$`)
+var dhcpRelayExternalEpgRefRegex = regexp.MustCompile(`^/schemas/([^/]+)/templates/([^/]+)/externalEpgs/([^/]+)$`)
+
+func resourceMSODHCPRelayPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSODHCPRelayPolicyCreate,
+		Read:   resourceMSODHCPRelayPolicyRead,
+		Update: resourceMSODHCPRelayPolicyUpdate,
+		Delete: resourceMSODHCPRelayPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSODHCPRelayPolicyImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"tenant_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dhcp_relay_policy_provider": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dhcp_server_address": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"epg_ref": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(dhcpRelayEpgRefRegex, "the expected format is /schemas/{schema_id}/templates/{template_name}/anps/{anp_name}/epgs/{epg_name}"),
+						},
+						"external_epg_ref": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(dhcpRelayExternalEpgRefRegex, "the expected format is /schemas/{schema_id}/templates/{template_name}/externalEpgs/{external_epg_name}"),
+						},
+						"schema_id": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"template_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"anp_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"epg_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"external_epg_name": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+					},
+				},
+			},
+		}),
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if !diff.NewValueKnown("dhcp_relay_policy_provider") {
+				return nil
+			}
+			schemas := make(map[string]*container.Container)
+			for index, provider := range diff.Get("dhcp_relay_policy_provider").([]interface{}) {
+				attribute := fmt.Sprintf("dhcp_relay_policy_provider.%d", index)
+				known := true
+				for _, key := range []string{"epg_ref", "external_epg_ref", "schema_id", "template_name", "anp_name", "epg_name", "external_epg_name"} {
+					if !diff.NewValueKnown(fmt.Sprintf("%s.%s", attribute, key)) {
+						known = false
+					}
+				}
+				if !known {
+					continue
+				}
+				ref, err := getDHCPRelayProviderRef(provider.(map[string]interface{}))
+				if err != nil {
+					return fmt.Errorf("Invalid %s: %s", attribute, err)
+				}
+				_, err = validateDHCPRelayProviderRef(v.(*client.Client), schemas, ref)
+				if err != nil {
+					return fmt.Errorf("Invalid %s: %s", attribute, err)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// getDHCPRelayProviderRef returns the EPG or external EPG ref of a provider, the ref is built from the schema, template and object names when it is not configured directly.
+func getDHCPRelayProviderRef(provider map[string]interface{}) (string, error) {
+	epgRef := provider["epg_ref"].(string)
+	externalEpgRef := provider["external_epg_ref"].(string)
+	schemaId := provider["schema_id"].(string)
+	templateName := provider["template_name"].(string)
+	anpName := provider["anp_name"].(string)
+	epgName := provider["epg_name"].(string)
+	externalEpgName := provider["external_epg_name"].(string)
+
+	configured := 0
+	for _, value := range []string{epgRef, externalEpgRef, epgName, externalEpgName} {
+		if value != "" {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return "", fmt.Errorf("exactly one of epg_ref, external_epg_ref, epg_name or external_epg_name must be provided")
+	}
+
+	if epgRef != "" || externalEpgRef != "" {
+		if schemaId != "" || templateName != "" || anpName != "" {
+			return "", fmt.Errorf("schema_id, template_name and anp_name cannot be used in combination with epg_ref or external_epg_ref")
+		}
+		return epgRef + externalEpgRef, nil
+	}
+
+	if schemaId == "" || templateName == "" {
+		return "", fmt.Errorf("schema_id and template_name are required when epg_name or external_epg_name is provided")
+	}
+	if epgName != "" {
+		if anpName == "" {
+			return "", fmt.Errorf("anp_name is required when epg_name is provided")
+		}
+		return fmt.Sprintf("/schemas/%s/templates/%s/anps/%s/epgs/%s", schemaId, templateName, anpName, epgName), nil
+	}
+	if anpName != "" {
+		return "", fmt.Errorf("anp_name cannot be used in combination with external_epg_name")
+	}
+	return fmt.Sprintf("/schemas/%s/templates/%s/externalEpgs/%s", schemaId, templateName, externalEpgName), nil
+}
+
+// validateDHCPRelayProviderRef verifies that the EPG or external EPG of the ref exists and returns the ID of the tenant of its template.
+// Retrieved schemas are stored in the schemas map so each schema is only retrieved once.
+func validateDHCPRelayProviderRef(msoClient *client.Client, schemas map[string]*container.Container, ref string) (string, error) {
+	var schemaId, templateName, anpName, epgName, externalEpgName string
+	if match := dhcpRelayEpgRefRegex.FindStringSubmatch(ref); match != nil {
+		schemaId, templateName, anpName, epgName = match[1], match[2], match[3], match[4]
+	} else if match := dhcpRelayExternalEpgRefRegex.FindStringSubmatch(ref); match != nil {
+		schemaId, templateName, externalEpgName = match[1], match[2], match[3]
+	} else {
+		return "", fmt.Errorf("unable to parse the ref %s", ref)
+	}
+
+	schemaCont, ok := schemas[schemaId]
+	if !ok {
+		var err error
+		schemaCont, err = getSchemaCont(msoClient, schemaId)
+		if err != nil {
+			return "", fmt.Errorf("unable to find the schema %s: %s", schemaId, err)
+		}
+		schemas[schemaId] = schemaCont
+	}
+
+	templateCount, err := schemaCont.ArrayCount("templates")
+	if err != nil {
+		return "", fmt.Errorf("no templates found in schema %s", schemaId)
+	}
+	for i := 0; i < templateCount; i++ {
+		templateCont, err := schemaCont.ArrayElement(i, "templates")
+		if err != nil {
+			return "", err
+		}
+		if models.StripQuotes(templateCont.S("name").String()) != templateName {
+			continue
+		}
+		tenantId := models.StripQuotes(templateCont.S("tenantId").String())
+		if externalEpgName != "" {
+			if getTemplateObjectIndex(templateCont, "externalEpgs", externalEpgName) == -1 {
+				return "", fmt.Errorf("unable to find the external EPG %s in template %s of schema %s", externalEpgName, templateName, schemaId)
+			}
+			return tenantId, nil
+		}
+		anpIndex := getTemplateObjectIndex(templateCont, "anps", anpName)
+		if anpIndex == -1 {
+			return "", fmt.Errorf("unable to find the ANP %s in template %s of schema %s", anpName, templateName, schemaId)
+		}
+		anpCont, err := templateCont.ArrayElement(anpIndex, "anps")
+		if err != nil {
+			return "", err
+		}
+		if getTemplateObjectIndex(anpCont, "epgs", epgName) == -1 {
+			return "", fmt.Errorf("unable to find the EPG %s in ANP %s of template %s in schema %s", epgName, anpName, templateName, schemaId)
+		}
+		return tenantId, nil
+	}
+	return "", fmt.Errorf("unable to find the template %s in schema %s", templateName, schemaId)
+}
+
+// getTemplateObjectIndex returns the index of the object with name in the listKey list of cont, or -1 when it is not found.
+func getTemplateObjectIndex(cont *container.Container, listKey, name string) int {
+	count, err := cont.ArrayCount(listKey)
+	if err != nil {
+		return -1
+	}
+	for i := 0; i < count; i++ {
+		objectCont, err := cont.ArrayElement(i, listKey)
+		if err != nil {
+			return -1
+		}
+		if models.StripQuotes(objectCont.S("name").String()) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func getDHCPRelayPolicyFromConfig(d *schema.ResourceData, msoClient *client.Client) (*models.DHCPRelayPolicy, error) {
+	policy := models.DHCPRelayPolicy{
+		Name:          d.Get("name").(string),
+		Desc:          d.Get("description").(string),
+		TenantID:      d.Get("tenant_id").(string),
+		PolicyType:    "dhcp",
+		PolicySubtype: "relay",
+		DHCPProvider:  make([]models.DHCPProvider, 0),
+	}
+
+	schemas := make(map[string]*container.Container)
+	for index, provider := range d.Get("dhcp_relay_policy_provider").([]interface{}) {
+		providerMap := provider.(map[string]interface{})
+		ref, err := getDHCPRelayProviderRef(providerMap)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid dhcp_relay_policy_provider.%d: %s", index, err)
+		}
+		tenantId, err := validateDHCPRelayProviderRef(msoClient, schemas, ref)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid dhcp_relay_policy_provider.%d: %s", index, err)
+		}
+		dhcpProvider := models.DHCPProvider{
+			DHCPServerAddress: providerMap["dhcp_server_address"].(string),
+			TenantID:          tenantId,
+		}
+		if dhcpRelayEpgRefRegex.MatchString(ref) {
+			dhcpProvider.EPG = ref
+		} else {
+			dhcpProvider.ExternalEPG = ref
+		}
+		policy.DHCPProvider = append(policy.DHCPProvider, dhcpProvider)
+	}
+	return models.NewDHCPRelayPolicy(policy), nil
+}
+
+func setDHCPRelayPolicyAttributes(d *schema.ResourceData, cont *container.Container) error {
+	policy, err := models.DHCPRelayPolicyFromContainer(cont)
+	if err != nil {
+		return err
+	}
+	d.SetId(policy.ID)
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Desc)
+	d.Set("tenant_id", policy.TenantID)
+
+	// Providers that are configured with schema, template and object names keep that form in the state.
+	stateProviders := make(map[string]map[string]interface{})
+	for _, provider := range d.Get("dhcp_relay_policy_provider").([]interface{}) {
+		providerMap := provider.(map[string]interface{})
+		ref, err := getDHCPRelayProviderRef(providerMap)
+		if err == nil {
+			stateProviders[fmt.Sprintf("%s/%s", ref, providerMap["dhcp_server_address"])] = providerMap
+		}
+	}
+
+	providers := make([]interface{}, 0, len(policy.DHCPProvider))
+	for _, dhcpProvider := range policy.DHCPProvider {
+		ref := dhcpProvider.EPG + dhcpProvider.ExternalEPG
+		if stateProvider, ok := stateProviders[fmt.Sprintf("%s/%s", ref, dhcpProvider.DHCPServerAddress)]; ok {
+			providers = append(providers, stateProvider)
+			continue
+		}
+		providerMap := map[string]interface{}{
+			"dhcp_server_address": dhcpProvider.DHCPServerAddress,
+			"epg_ref":             dhcpProvider.EPG,
+			"external_epg_ref":    dhcpProvider.ExternalEPG,
+		}
+		providers = append(providers, providerMap)
+	}
+	d.Set("dhcp_relay_policy_provider", providers)
+	return nil
+}
+
+func resourceMSODHCPRelayPolicyImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	err := resourceMSODHCPRelayPolicyRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Unable to find the DHCP Relay Policy")
+	}
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSODHCPRelayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] DHCP Relay Policy: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	policy, err := getDHCPRelayPolicyFromConfig(d, msoClient)
+	if err != nil {
+		return err
+	}
+	cont, err := msoClient.CreateDHCPRelayPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(models.StripQuotes(cont.S("id").String()))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSODHCPRelayPolicyRead(d, m)
+}
+
+func resourceMSODHCPRelayPolicyRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	cont, err := msoClient.ReadDHCPRelayPolicy(d.Id())
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	err = setDHCPRelayPolicyAttributes(d, cont)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSODHCPRelayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	policy, err := getDHCPRelayPolicyFromConfig(d, msoClient)
+	if err != nil {
+		return err
+	}
+	policy.ID = d.Id()
+	// The policy is replaced as a whole so providers that are removed from the configuration are also removed from the policy.
+	_, err = msoClient.Put(fmt.Sprintf("api/v1/policies/dhcp/relay/%s", d.Id()), policy)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSODHCPRelayPolicyRead(d, m)
+}
+
+func resourceMSODHCPRelayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := msoClient.DeleteDHCPRelayPolicy(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
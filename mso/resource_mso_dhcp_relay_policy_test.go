@@ -0,0 +1,50 @@
+package mso
+
+import (
+	"testing"
+)
+
+func TestGetDHCPRelayProviderRef(t *testing.T) {
+	newProvider := func(values map[string]interface{}) map[string]interface{} {
+		provider := map[string]interface{}{}
+		for _, key := range []string{"epg_ref", "external_epg_ref", "schema_id", "template_name", "anp_name", "epg_name", "external_epg_name"} {
+			provider[key] = ""
+		}
+		for key, value := range values {
+			provider[key] = value
+		}
+		return provider
+	}
+
+	valid := []struct {
+		provider map[string]interface{}
+		ref      string
+	}{
+		{newProvider(map[string]interface{}{"epg_ref": "/schemas/s1/templates/t1/anps/a1/epgs/e1"}), "/schemas/s1/templates/t1/anps/a1/epgs/e1"},
+		{newProvider(map[string]interface{}{"external_epg_ref": "/schemas/s1/templates/t1/externalEpgs/x1"}), "/schemas/s1/templates/t1/externalEpgs/x1"},
+		{newProvider(map[string]interface{}{"schema_id": "s1", "template_name": "t1", "anp_name": "a1", "epg_name": "e1"}), "/schemas/s1/templates/t1/anps/a1/epgs/e1"},
+		{newProvider(map[string]interface{}{"schema_id": "s1", "template_name": "t1", "external_epg_name": "x1"}), "/schemas/s1/templates/t1/externalEpgs/x1"},
+	}
+	for _, test := range valid {
+		ref, err := getDHCPRelayProviderRef(test.provider)
+		if err != nil {
+			t.Errorf("unexpected error for %v: %s", test.provider, err)
+		} else if ref != test.ref {
+			t.Errorf("expected ref %s, got %s", test.ref, ref)
+		}
+	}
+
+	invalid := []map[string]interface{}{
+		newProvider(nil),
+		newProvider(map[string]interface{}{"epg_ref": "/schemas/s1/templates/t1/anps/a1/epgs/e1", "epg_name": "e1"}),
+		newProvider(map[string]interface{}{"epg_ref": "/schemas/s1/templates/t1/anps/a1/epgs/e1", "schema_id": "s1"}),
+		newProvider(map[string]interface{}{"schema_id": "s1", "template_name": "t1", "epg_name": "e1"}),
+		newProvider(map[string]interface{}{"template_name": "t1", "external_epg_name": "x1"}),
+		newProvider(map[string]interface{}{"schema_id": "s1", "template_name": "t1", "anp_name": "a1", "external_epg_name": "x1"}),
+	}
+	for _, provider := range invalid {
+		if _, err := getDHCPRelayProviderRef(provider); err == nil {
+			t.Errorf("expected an error for %v", provider)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesDnsPolicy = templatePolicyObject{
+	label:        "DNS Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "dnsPolicies",
+	idSegment:    "dnsPolicy",
+	payload:      getDnsPolicyPayload,
+	set:          setDnsPolicyFromTemplate,
+}
+
+func resourceMSOFabricPoliciesDnsPolicy() *schema.Resource {
+	return fabricPoliciesDnsPolicy.resource(map[string]*schema.Schema{
+		"management_epg": templatePolicyManagementEpgSchema(),
+		"providers": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+					"preferred": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"domains": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+					"default": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	})
+}
+
+func getDnsPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	providers := make([]interface{}, 0)
+	for _, provider := range d.Get("providers").([]interface{}) {
+		providerMap := provider.(map[string]interface{})
+		providers = append(providers, map[string]interface{}{
+			"addr":      providerMap["address"],
+			"preferred": providerMap["preferred"],
+		})
+	}
+	domains := make([]interface{}, 0)
+	for _, domain := range d.Get("domains").([]interface{}) {
+		domainMap := domain.(map[string]interface{})
+		domains = append(domains, map[string]interface{}{
+			"name":      domainMap["name"],
+			"isDefault": domainMap["default"],
+		})
+	}
+	return map[string]interface{}{
+		"mgmtEpgType":  d.Get("management_epg").(string),
+		"dnsProviders": providers,
+		"dnsDomains":   domains,
+	}
+}
+
+func setDnsPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "management_epg", "mgmtEpgType")
+	providers := make([]interface{}, 0)
+	for _, provider := range getTemplatePolicyList(policyCont, "dnsProviders") {
+		providers = append(providers, map[string]interface{}{
+			"address":   convertInterfaceToString(provider["addr"]),
+			"preferred": provider["preferred"] == true,
+		})
+	}
+	d.Set("providers", providers)
+	domains := make([]interface{}, 0)
+	for _, domain := range getTemplatePolicyList(policyCont, "dnsDomains") {
+		domains = append(domains, map[string]interface{}{
+			"name":    convertInterfaceToString(domain["name"]),
+			"default": domain["isDefault"] == true,
+		})
+	}
+	d.Set("domains", domains)
+}
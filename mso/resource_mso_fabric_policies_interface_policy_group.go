@@ -0,0 +1,117 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesInterfacePolicyGroup = templatePolicyObject{
+	label:        "Interface Policy Group",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "interfacePolicyGroups",
+	idSegment:    "interfacePolicyGroup",
+	payload:      getInterfacePolicyGroupPayload,
+	set:          setInterfacePolicyGroupFromTemplate,
+}
+
+func resourceMSOFabricPoliciesInterfacePolicyGroup() *schema.Resource {
+	return fabricPoliciesInterfacePolicyGroup.resource(map[string]*schema.Schema{
+		"interface_type": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "physical",
+			ValidateFunc: validation.StringInSlice([]string{
+				"physical",
+				"portchannel",
+			}, false),
+		},
+		"domain_uuids": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		},
+		"speed": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "inherit",
+			ValidateFunc: validation.StringInSlice([]string{
+				"inherit",
+				"100M",
+				"1G",
+				"10G",
+				"25G",
+				"40G",
+				"50G",
+				"100G",
+				"200G",
+				"400G",
+			}, false),
+		},
+		"auto_negotiation": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "on",
+			ValidateFunc: validation.StringInSlice([]string{
+				"on",
+				"off",
+				"on-enforce",
+			}, false),
+		},
+		"cdp_admin_state": templatePolicyAdminStateSchema(),
+		"lldp_receive":    templatePolicyAdminStateSchema(),
+		"lldp_transmit":   templatePolicyAdminStateSchema(),
+		"vlan_scope_local": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	})
+}
+
+func getInterfacePolicyGroupPayload(d *schema.ResourceData) map[string]interface{} {
+	domains := make([]interface{}, 0)
+	for _, domain := range d.Get("domain_uuids").(*schema.Set).List() {
+		domains = append(domains, domain)
+	}
+	vlanScope := "global"
+	if d.Get("vlan_scope_local").(bool) {
+		vlanScope = "portlocal"
+	}
+	return map[string]interface{}{
+		"type":            d.Get("interface_type").(string),
+		"domains":         domains,
+		"speed":           d.Get("speed").(string),
+		"autoNegotiation": d.Get("auto_negotiation").(string),
+		"cdp": map[string]interface{}{
+			"adminState": d.Get("cdp_admin_state").(string),
+		},
+		"lldp": map[string]interface{}{
+			"receiveState":  d.Get("lldp_receive").(string),
+			"transmitState": d.Get("lldp_transmit").(string),
+		},
+		"l2Interface": map[string]interface{}{
+			"vlanScope": vlanScope,
+		},
+	}
+}
+
+func setInterfacePolicyGroupFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "interface_type", "type")
+	domains := make([]interface{}, 0)
+	domainList, _ := policyCont.S("domains").Data().([]interface{})
+	for _, domain := range domainList {
+		domains = append(domains, convertInterfaceToString(domain))
+	}
+	d.Set("domain_uuids", domains)
+	setTemplatePolicyString(d, policyCont, "speed", "speed")
+	setTemplatePolicyString(d, policyCont, "auto_negotiation", "autoNegotiation")
+	setTemplatePolicyString(d, policyCont, "cdp_admin_state", "cdp", "adminState")
+	setTemplatePolicyString(d, policyCont, "lldp_receive", "lldp", "receiveState")
+	setTemplatePolicyString(d, policyCont, "lldp_transmit", "lldp", "transmitState")
+	d.Set("vlan_scope_local", policyCont.S("l2Interface", "vlanScope").Data() == "portlocal")
+}
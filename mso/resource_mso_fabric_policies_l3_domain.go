@@ -0,0 +1,18 @@
+package mso
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var fabricPoliciesL3Domain = templatePolicyObject{
+	label:        "L3 Domain",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "l3Domains",
+	idSegment:    "l3Domain",
+	payload:      getFabricDomainPayload,
+	set:          setFabricDomainFromTemplate,
+}
+
+func resourceMSOFabricPoliciesL3Domain() *schema.Resource {
+	return fabricPoliciesL3Domain.resource(fabricDomainSchema())
+}
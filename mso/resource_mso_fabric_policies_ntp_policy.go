@@ -0,0 +1,93 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesNtpPolicy = templatePolicyObject{
+	label:        "NTP Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "ntpPolicies",
+	idSegment:    "ntpPolicy",
+	payload:      getNtpPolicyPayload,
+	set:          setNtpPolicyFromTemplate,
+}
+
+func resourceMSOFabricPoliciesNtpPolicy() *schema.Resource {
+	return fabricPoliciesNtpPolicy.resource(map[string]*schema.Schema{
+		"admin_state": templatePolicyAdminStateSchema(),
+		"server_state": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"providers": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+					"preferred": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"min_poll_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      4,
+						ValidateFunc: validation.IntBetween(4, 16),
+					},
+					"max_poll_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      6,
+						ValidateFunc: validation.IntBetween(4, 16),
+					},
+					"management_epg": templatePolicyManagementEpgSchema(),
+				},
+			},
+		},
+	})
+}
+
+func getNtpPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	providers := make([]interface{}, 0)
+	for _, provider := range d.Get("providers").([]interface{}) {
+		providerMap := provider.(map[string]interface{})
+		providers = append(providers, map[string]interface{}{
+			"host":        providerMap["host"],
+			"preferred":   providerMap["preferred"],
+			"minPollInt":  providerMap["min_poll_interval"],
+			"maxPollInt":  providerMap["max_poll_interval"],
+			"mgmtEpgType": providerMap["management_epg"],
+		})
+	}
+	return map[string]interface{}{
+		"adminState":   d.Get("admin_state").(string),
+		"serverState":  d.Get("server_state").(bool),
+		"ntpProviders": providers,
+	}
+}
+
+func setNtpPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "admin_state", "adminState")
+	d.Set("server_state", policyCont.S("serverState").Data() == true)
+	providers := make([]interface{}, 0)
+	for _, provider := range getTemplatePolicyList(policyCont, "ntpProviders") {
+		providers = append(providers, map[string]interface{}{
+			"host":              convertInterfaceToString(provider["host"]),
+			"preferred":         provider["preferred"] == true,
+			"min_poll_interval": convertInterfaceToInt(provider["minPollInt"]),
+			"max_poll_interval": convertInterfaceToInt(provider["maxPollInt"]),
+			"management_epg":    convertInterfaceToString(provider["mgmtEpgType"]),
+		})
+	}
+	d.Set("providers", providers)
+}
@@ -0,0 +1,42 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var fabricPoliciesPhysicalDomain = templatePolicyObject{
+	label:        "Physical Domain",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "domains",
+	idSegment:    "domain",
+	payload:      getFabricDomainPayload,
+	set:          setFabricDomainFromTemplate,
+}
+
+func resourceMSOFabricPoliciesPhysicalDomain() *schema.Resource {
+	return fabricPoliciesPhysicalDomain.resource(fabricDomainSchema())
+}
+
+// fabricDomainSchema returns the schema of the domains of fabric policy templates, which are shared by physical and L3 domains.
+func fabricDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"vlan_pool_uuid": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func getFabricDomainPayload(d *schema.ResourceData) map[string]interface{} {
+	domain := make(map[string]interface{})
+	if pool := d.Get("vlan_pool_uuid").(string); pool != "" {
+		domain["pool"] = pool
+	}
+	return domain
+}
+
+func setFabricDomainFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("vlan_pool_uuid", convertInterfaceToString(policyCont.S("pool").Data()))
+}
@@ -0,0 +1,73 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// fabricPoliciesPtpPolicy is the PTP policy of a fabric policy template, of which a template holds at most one.
+var fabricPoliciesPtpPolicy = templatePolicyObject{
+	label:        "PTP Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "ptpPolicy",
+	idSegment:    "ptpPolicy",
+	single:       true,
+	payload:      getPtpPolicyPayload,
+	set:          setPtpPolicyFromTemplate,
+}
+
+func resourceMSOFabricPoliciesPtpPolicy() *schema.Resource {
+	return fabricPoliciesPtpPolicy.resource(map[string]*schema.Schema{
+		"admin_state": templatePolicyAdminStateSchema(),
+		"global_domain": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 128),
+		},
+		"global_priority1": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      255,
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"global_priority2": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      255,
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"fabric_profile": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "aes67",
+			ValidateFunc: validation.StringInSlice([]string{
+				"aes67",
+				"default",
+				"smpte",
+				"telecom_full_path",
+			}, false),
+		},
+	})
+}
+
+func getPtpPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"global": map[string]interface{}{
+			"adminState":         d.Get("admin_state").(string),
+			"domain":             d.Get("global_domain").(int),
+			"prio1":              d.Get("global_priority1").(int),
+			"prio2":              d.Get("global_priority2").(int),
+			"fabProfileTemplate": d.Get("fabric_profile").(string),
+		},
+	}
+}
+
+func setPtpPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "admin_state", "global", "adminState")
+	setTemplatePolicyString(d, policyCont, "fabric_profile", "global", "fabProfileTemplate")
+	d.Set("global_domain", convertInterfaceToInt(policyCont.S("global", "domain").Data()))
+	d.Set("global_priority1", convertInterfaceToInt(policyCont.S("global", "prio1").Data()))
+	d.Set("global_priority2", convertInterfaceToInt(policyCont.S("global", "prio2").Data()))
+}
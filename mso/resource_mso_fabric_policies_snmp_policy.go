@@ -0,0 +1,73 @@
+package mso
+
+import (
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesSnmpPolicy = templatePolicyObject{
+	label:        "SNMP Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "snmpPolicies",
+	idSegment:    "snmpPolicy",
+	payload:      getSnmpPolicyPayload,
+	set:          setSnmpPolicyFromTemplate,
+}
+
+func resourceMSOFabricPoliciesSnmpPolicy() *schema.Resource {
+	return fabricPoliciesSnmpPolicy.resource(map[string]*schema.Schema{
+		"admin_state": templatePolicyAdminStateSchema(),
+		"contact": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 255),
+		},
+		"location": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 255),
+		},
+		"communities": &schema.Schema{
+			Type:      schema.TypeSet,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+		},
+	})
+}
+
+func getSnmpPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	// The communities are sorted to keep the payload stable.
+	names := make([]string, 0)
+	for _, community := range d.Get("communities").(*schema.Set).List() {
+		names = append(names, community.(string))
+	}
+	sort.Strings(names)
+	communities := make([]interface{}, 0)
+	for _, name := range names {
+		communities = append(communities, map[string]interface{}{"name": name})
+	}
+	return map[string]interface{}{
+		"adminState":  d.Get("admin_state").(string),
+		"contact":     d.Get("contact").(string),
+		"location":    d.Get("location").(string),
+		"communities": communities,
+	}
+}
+
+func setSnmpPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "admin_state", "adminState")
+	d.Set("contact", convertInterfaceToString(policyCont.S("contact").Data()))
+	d.Set("location", convertInterfaceToString(policyCont.S("location").Data()))
+	communities := make([]interface{}, 0)
+	for _, community := range getTemplatePolicyList(policyCont, "communities") {
+		communities = append(communities, convertInterfaceToString(community["name"]))
+	}
+	d.Set("communities", communities)
+}
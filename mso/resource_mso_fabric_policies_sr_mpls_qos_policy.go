@@ -0,0 +1,167 @@
+package mso
+
+import (
+	"fmt"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesSrMplsQosPolicy = templatePolicyObject{
+	label:        "SR-MPLS QoS Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "mplsCustomQoSPolicies",
+	idSegment:    "mplsCustomQoSPolicy",
+	payload:      getSrMplsQosPolicyPayload,
+	set:          setSrMplsQosPolicyFromTemplate,
+}
+
+var qosDscpValues = []string{
+	"unspecified", "cs0", "cs1", "af11", "af12", "af13", "cs2", "af21", "af22", "af23", "cs3", "af31", "af32", "af33",
+	"cs4", "af41", "af42", "af43", "cs5", "voiceAdmit", "ef", "cs6", "cs7",
+}
+
+var qosCosValues = []string{"unspecified", "cos0", "cos1", "cos2", "cos3", "cos4", "cos5", "cos6", "cos7"}
+
+var qosPriorityValues = []string{"unspecified", "level1", "level2", "level3", "level4", "level5", "level6"}
+
+func resourceMSOFabricPoliciesSrMplsQosPolicy() *schema.Resource {
+	resource := fabricPoliciesSrMplsQosPolicy.resource(map[string]*schema.Schema{
+		"ingress_rules": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"exp_from": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 7),
+					},
+					"exp_to": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 7),
+					},
+					"dscp_target": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "unspecified",
+						ValidateFunc: validation.StringInSlice(qosDscpValues, false),
+					},
+					"cos_target": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "unspecified",
+						ValidateFunc: validation.StringInSlice(qosCosValues, false),
+					},
+					"priority": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "unspecified",
+						ValidateFunc: validation.StringInSlice(qosPriorityValues, false),
+					},
+				},
+			},
+		},
+		"egress_rules": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"dscp_from": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(qosDscpValues, false),
+					},
+					"dscp_to": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(qosDscpValues, false),
+					},
+					"exp_target": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 7),
+					},
+					"cos_target": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "unspecified",
+						ValidateFunc: validation.StringInSlice(qosCosValues, false),
+					},
+				},
+			},
+		},
+	})
+	resource.CustomizeDiff = func(diff *schema.ResourceDiff, v interface{}) error {
+		for i, rule := range diff.Get("ingress_rules").([]interface{}) {
+			ruleMap := rule.(map[string]interface{})
+			if ruleMap["exp_from"].(int) > ruleMap["exp_to"].(int) {
+				return fmt.Errorf(`"exp_from" must be lower than or equal to "exp_to" in ingress rule %d`, i)
+			}
+		}
+		return nil
+	}
+	return resource
+}
+
+func getSrMplsQosPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	ingressRules := make([]interface{}, 0, 1)
+	for _, rule := range d.Get("ingress_rules").([]interface{}) {
+		ruleMap := rule.(map[string]interface{})
+		ingressRules = append(ingressRules, map[string]interface{}{
+			"expFrom":    ruleMap["exp_from"],
+			"expTo":      ruleMap["exp_to"],
+			"dscpTarget": ruleMap["dscp_target"],
+			"cosTarget":  ruleMap["cos_target"],
+			"priority":   ruleMap["priority"],
+		})
+	}
+	egressRules := make([]interface{}, 0, 1)
+	for _, rule := range d.Get("egress_rules").([]interface{}) {
+		ruleMap := rule.(map[string]interface{})
+		egressRules = append(egressRules, map[string]interface{}{
+			"dscpFrom":  ruleMap["dscp_from"],
+			"dscpTo":    ruleMap["dscp_to"],
+			"expTarget": ruleMap["exp_target"],
+			"cosTarget": ruleMap["cos_target"],
+		})
+	}
+	return map[string]interface{}{
+		"ingressRules": ingressRules,
+		"egressRules":  egressRules,
+	}
+}
+
+func setSrMplsQosPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	ingressRules := make([]interface{}, 0, 1)
+	if rules, ok := policyCont.S("ingressRules").Data().([]interface{}); ok {
+		for _, rule := range rules {
+			ruleMap := rule.(map[string]interface{})
+			ingressRules = append(ingressRules, map[string]interface{}{
+				"exp_from":    convertInterfaceToInt(ruleMap["expFrom"]),
+				"exp_to":      convertInterfaceToInt(ruleMap["expTo"]),
+				"dscp_target": convertInterfaceToString(ruleMap["dscpTarget"]),
+				"cos_target":  convertInterfaceToString(ruleMap["cosTarget"]),
+				"priority":    convertInterfaceToString(ruleMap["priority"]),
+			})
+		}
+	}
+	d.Set("ingress_rules", ingressRules)
+
+	egressRules := make([]interface{}, 0, 1)
+	if rules, ok := policyCont.S("egressRules").Data().([]interface{}); ok {
+		for _, rule := range rules {
+			ruleMap := rule.(map[string]interface{})
+			egressRules = append(egressRules, map[string]interface{}{
+				"dscp_from":  convertInterfaceToString(ruleMap["dscpFrom"]),
+				"dscp_to":    convertInterfaceToString(ruleMap["dscpTo"]),
+				"exp_target": convertInterfaceToInt(ruleMap["expTarget"]),
+				"cos_target": convertInterfaceToString(ruleMap["cosTarget"]),
+			})
+		}
+	}
+	d.Set("egress_rules", egressRules)
+}
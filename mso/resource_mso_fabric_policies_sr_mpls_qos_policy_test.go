@@ -0,0 +1,104 @@
+package mso
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccMSOFabricPoliciesSrMplsQosPolicy_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMSOFabricPoliciesSrMplsQosPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMSOFabricPoliciesSrMplsQosPolicyConfig_basic("af11"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMSOFabricPoliciesSrMplsQosPolicyExists("mso_fabric_policies_sr_mpls_qos_policy.qos1"),
+					resource.TestCheckResourceAttr("mso_fabric_policies_sr_mpls_qos_policy.qos1", "ingress_rules.0.dscp_target", "af11"),
+					resource.TestCheckResourceAttr("mso_fabric_policies_sr_mpls_qos_policy.qos1", "egress_rules.0.exp_target", "3"),
+				),
+			},
+			{
+				Config: testAccCheckMSOFabricPoliciesSrMplsQosPolicyConfig_basic("ef"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMSOFabricPoliciesSrMplsQosPolicyExists("mso_fabric_policies_sr_mpls_qos_policy.qos1"),
+					resource.TestCheckResourceAttr("mso_fabric_policies_sr_mpls_qos_policy.qos1", "ingress_rules.0.dscp_target", "ef"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMSOFabricPoliciesSrMplsQosPolicyConfig_basic(dscp string) string {
+	return fmt.Sprintf(`
+	resource "mso_fabric_policies_template" "template1" {
+		name = "sr_mpls_qos_template_1"
+	}
+
+	resource "mso_fabric_policies_sr_mpls_qos_policy" "qos1" {
+		template_id = mso_fabric_policies_template.template1.id
+		name        = "sr_mpls_qos_1"
+		ingress_rules {
+			exp_from    = 1
+			exp_to      = 2
+			dscp_target = "%s"
+			cos_target  = "cos1"
+			priority    = "level1"
+		}
+		egress_rules {
+			dscp_from  = "af11"
+			dscp_to    = "af13"
+			exp_target = 3
+		}
+	}
+	`, dscp)
+}
+
+func testAccCheckMSOFabricPoliciesSrMplsQosPolicyExists(policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[policyName]
+		if !ok {
+			return fmt.Errorf("SR-MPLS QoS Policy %s not found", policyName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SR-MPLS QoS Policy id was set")
+		}
+
+		client := testAccProvider.Meta().(*client.Client)
+		cont, err := client.GetViaURL("api/v1/templates/" + rs.Primary.Attributes["template_id"])
+		if err != nil {
+			return err
+		}
+		policyCont, _, err := getTemplatePolicy(cont, fabricPolicyTemplate, "mplsCustomQoSPolicies", rs.Primary.Attributes["name"])
+		if err != nil {
+			return err
+		}
+		if policyCont == nil {
+			return fmt.Errorf("SR-MPLS QoS Policy %s not found in template", rs.Primary.Attributes["name"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMSOFabricPoliciesSrMplsQosPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*client.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "mso_fabric_policies_sr_mpls_qos_policy" {
+			cont, err := client.GetViaURL("api/v1/templates/" + rs.Primary.Attributes["template_id"])
+			if err != nil {
+				return nil
+			}
+			policyCont, _, _ := getTemplatePolicy(cont, fabricPolicyTemplate, "mplsCustomQoSPolicies", rs.Primary.Attributes["name"])
+			if policyCont != nil {
+				return fmt.Errorf("SR-MPLS QoS Policy still exists")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// syslogSeverities are the severities of syslog messages, from the most to the least severe.
+var syslogSeverities = []string{
+	"emergencies",
+	"alerts",
+	"critical",
+	"errors",
+	"warnings",
+	"notifications",
+	"information",
+	"debugging",
+}
+
+var fabricPoliciesSyslogPolicy = templatePolicyObject{
+	label:        "Syslog Policy",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "syslogPolicies",
+	idSegment:    "syslogPolicy",
+	payload:      getSyslogPolicyPayload,
+	set:          setSyslogPolicyFromTemplate,
+}
+
+func resourceMSOFabricPoliciesSyslogPolicy() *schema.Resource {
+	return fabricPoliciesSyslogPolicy.resource(map[string]*schema.Schema{
+		"admin_state": templatePolicyAdminStateSchema(),
+		"destinations": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+					"port": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      514,
+						ValidateFunc: validation.IntBetween(1, 65535),
+					},
+					"severity": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "warnings",
+						ValidateFunc: validation.StringInSlice(syslogSeverities, false),
+					},
+					"facility": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "local7",
+						ValidateFunc: validation.StringInSlice([]string{
+							"local0",
+							"local1",
+							"local2",
+							"local3",
+							"local4",
+							"local5",
+							"local6",
+							"local7",
+						}, false),
+					},
+					"management_epg": templatePolicyManagementEpgSchema(),
+				},
+			},
+		},
+	})
+}
+
+func getSyslogPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	destinations := make([]interface{}, 0)
+	for _, destination := range d.Get("destinations").([]interface{}) {
+		destinationMap := destination.(map[string]interface{})
+		destinations = append(destinations, map[string]interface{}{
+			"host":        destinationMap["host"],
+			"port":        destinationMap["port"],
+			"severity":    destinationMap["severity"],
+			"facility":    destinationMap["facility"],
+			"mgmtEpgType": destinationMap["management_epg"],
+		})
+	}
+	return map[string]interface{}{
+		"adminState":         d.Get("admin_state").(string),
+		"remoteDestinations": destinations,
+	}
+}
+
+func setSyslogPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "admin_state", "adminState")
+	destinations := make([]interface{}, 0)
+	for _, destination := range getTemplatePolicyList(policyCont, "remoteDestinations") {
+		destinations = append(destinations, map[string]interface{}{
+			"host":           convertInterfaceToString(destination["host"]),
+			"port":           convertInterfaceToInt(destination["port"]),
+			"severity":       convertInterfaceToString(destination["severity"]),
+			"facility":       convertInterfaceToString(destination["facility"]),
+			"management_epg": convertInterfaceToString(destination["mgmtEpgType"]),
+		})
+	}
+	d.Set("destinations", destinations)
+}
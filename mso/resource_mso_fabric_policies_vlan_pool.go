@@ -0,0 +1,97 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricPoliciesVlanPool = templatePolicyObject{
+	label:        "VLAN Pool",
+	templateType: fabricPolicyTemplate,
+	objectKey:    "vlanPools",
+	idSegment:    "vlanPool",
+	payload:      getVlanPoolPayload,
+	set:          setVlanPoolFromTemplate,
+}
+
+func resourceMSOFabricPoliciesVlanPool() *schema.Resource {
+	return fabricPoliciesVlanPool.resource(map[string]*schema.Schema{
+		"allocation_mode": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "static",
+			ValidateFunc: validation.StringInSlice([]string{
+				"static",
+				"dynamic",
+			}, false),
+		},
+		"vlan_ranges": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 4094),
+					},
+					"to": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 4094),
+					},
+					"allocation_mode": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "inherit",
+						ValidateFunc: validation.StringInSlice([]string{
+							"inherit",
+							"static",
+							"dynamic",
+						}, false),
+					},
+				},
+			},
+		},
+	})
+}
+
+func getVlanPoolPayload(d *schema.ResourceData) map[string]interface{} {
+	encapBlocks := make([]interface{}, 0)
+	for _, vlanRange := range d.Get("vlan_ranges").([]interface{}) {
+		rangeMap := vlanRange.(map[string]interface{})
+		encapBlocks = append(encapBlocks, map[string]interface{}{
+			"range": map[string]interface{}{
+				"from": rangeMap["from"],
+				"to":   rangeMap["to"],
+			},
+			"allocMode": rangeMap["allocation_mode"],
+		})
+	}
+	return map[string]interface{}{
+		"allocMode":   d.Get("allocation_mode").(string),
+		"encapBlocks": encapBlocks,
+	}
+}
+
+func setVlanPoolFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	if policyCont.Exists("allocMode") {
+		d.Set("allocation_mode", convertInterfaceToString(policyCont.S("allocMode").Data()))
+	}
+	vlanRanges := make([]interface{}, 0)
+	for _, encapBlock := range getTemplatePolicyList(policyCont, "encapBlocks") {
+		blockRange, _ := encapBlock["range"].(map[string]interface{})
+		allocMode := convertInterfaceToString(encapBlock["allocMode"])
+		if allocMode == "" {
+			allocMode = "inherit"
+		}
+		vlanRanges = append(vlanRanges, map[string]interface{}{
+			"from":            convertInterfaceToInt(blockRange["from"]),
+			"to":              convertInterfaceToInt(blockRange["to"]),
+			"allocation_mode": allocMode,
+		})
+	}
+	d.Set("vlan_ranges", vlanRanges)
+}
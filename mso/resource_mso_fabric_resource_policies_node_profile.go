@@ -0,0 +1,50 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricResourcePoliciesNodeProfile = templatePolicyObject{
+	label:        "Node Profile",
+	templateType: fabricResourceTemplate,
+	objectKey:    "nodeProfiles",
+	idSegment:    "nodeProfile",
+	payload:      getNodeProfilePayload,
+	set:          setNodeProfileFromTemplate,
+}
+
+func resourceMSOFabricResourcePoliciesNodeProfile() *schema.Resource {
+	return fabricResourcePoliciesNodeProfile.resource(map[string]*schema.Schema{
+		"node_ids": &schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+		"node_policy_group_uuid": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	})
+}
+
+func getNodeProfilePayload(d *schema.ResourceData) map[string]interface{} {
+	nodeProfile := map[string]interface{}{
+		"nodes": getSortedSetStrings(d, "node_ids"),
+	}
+	if policy := d.Get("node_policy_group_uuid").(string); policy != "" {
+		nodeProfile["policy"] = policy
+	}
+	return nodeProfile
+}
+
+func setNodeProfileFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("node_ids", getFabricResourceStrings(policyCont, "nodes"))
+	d.Set("node_policy_group_uuid", convertInterfaceToString(policyCont.S("policy").Data()))
+}
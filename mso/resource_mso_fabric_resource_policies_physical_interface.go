@@ -0,0 +1,77 @@
+package mso
+
+import (
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricResourcePoliciesPhysicalInterface = templatePolicyObject{
+	label:        "Physical Interface",
+	templateType: fabricResourceTemplate,
+	objectKey:    "interfaceProfiles",
+	idSegment:    "physicalInterface",
+	payload:      getPhysicalInterfacePayload,
+	set:          setPhysicalInterfaceFromTemplate,
+}
+
+func resourceMSOFabricResourcePoliciesPhysicalInterface() *schema.Resource {
+	return fabricResourcePoliciesPhysicalInterface.resource(map[string]*schema.Schema{
+		"node_ids": &schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+		"interfaces": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"interface_policy_group_uuid": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+	})
+}
+
+// getSortedSetStrings returns the sorted strings of the set attribute, to keep the payload stable.
+func getSortedSetStrings(d *schema.ResourceData, attribute string) []string {
+	nodes := make([]string, 0)
+	for _, node := range d.Get(attribute).(*schema.Set).List() {
+		nodes = append(nodes, node.(string))
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// getFabricResourceStrings returns the strings of the list at the path of the policy.
+func getFabricResourceStrings(policyCont *container.Container, path ...string) []interface{} {
+	values := make([]interface{}, 0)
+	list, _ := policyCont.S(path...).Data().([]interface{})
+	for _, value := range list {
+		values = append(values, convertInterfaceToString(value))
+	}
+	return values
+}
+
+func getPhysicalInterfacePayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"nodes":           getSortedSetStrings(d, "node_ids"),
+		"interfaces":      d.Get("interfaces").(string),
+		"policyGroupType": "physical",
+		"policy":          d.Get("interface_policy_group_uuid").(string),
+	}
+}
+
+func setPhysicalInterfaceFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("node_ids", getFabricResourceStrings(policyCont, "nodes"))
+	d.Set("interfaces", convertInterfaceToString(policyCont.S("interfaces").Data()))
+	d.Set("interface_policy_group_uuid", convertInterfaceToString(policyCont.S("policy").Data()))
+}
@@ -0,0 +1,75 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricResourcePoliciesPortChannel = templatePolicyObject{
+	label:        "Port Channel",
+	templateType: fabricResourceTemplate,
+	objectKey:    "portChannels",
+	idSegment:    "portChannel",
+	payload:      getPortChannelPayload,
+	set:          setPortChannelFromTemplate,
+}
+
+func resourceMSOFabricResourcePoliciesPortChannel() *schema.Resource {
+	return fabricResourcePoliciesPortChannel.resource(map[string]*schema.Schema{
+		"node_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"interfaces": &schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+		"interface_policy_group_uuid": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+	})
+}
+
+// getMemberInterfacesPayload returns the member interfaces payload of the interfaces of the set attribute.
+func getMemberInterfacesPayload(d *schema.ResourceData, attribute string) []interface{} {
+	memberInterfaces := make([]interface{}, 0)
+	for _, memberInterface := range getSortedSetStrings(d, attribute) {
+		memberInterfaces = append(memberInterfaces, map[string]interface{}{"interfaceID": memberInterface})
+	}
+	return memberInterfaces
+}
+
+// getMemberInterfaces returns the interfaces of the member interfaces list at the path of the policy.
+func getMemberInterfaces(policyCont *container.Container, path ...string) []interface{} {
+	memberInterfaces := make([]interface{}, 0)
+	list, _ := policyCont.S(path...).Data().([]interface{})
+	for _, memberInterface := range list {
+		if memberInterfaceMap, ok := memberInterface.(map[string]interface{}); ok {
+			memberInterfaces = append(memberInterfaces, convertInterfaceToString(memberInterfaceMap["interfaceID"]))
+		}
+	}
+	return memberInterfaces
+}
+
+func getPortChannelPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"node":             d.Get("node_id").(string),
+		"memberInterfaces": getMemberInterfacesPayload(d, "interfaces"),
+		"policy":           d.Get("interface_policy_group_uuid").(string),
+	}
+}
+
+func setPortChannelFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("node_id", convertInterfaceToString(policyCont.S("node").Data()))
+	d.Set("interfaces", getMemberInterfaces(policyCont, "memberInterfaces"))
+	d.Set("interface_policy_group_uuid", convertInterfaceToString(policyCont.S("policy").Data()))
+}
@@ -0,0 +1,64 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var fabricResourcePoliciesVpc = templatePolicyObject{
+	label:        "Virtual Port Channel",
+	templateType: fabricResourceTemplate,
+	objectKey:    "virtualPortChannels",
+	idSegment:    "virtualPortChannel",
+	payload:      getVpcPayload,
+	set:          setVpcFromTemplate,
+}
+
+func resourceMSOFabricResourcePoliciesVpc() *schema.Resource {
+	vpcSchema := map[string]*schema.Schema{
+		"interface_policy_group_uuid": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+	}
+	for _, node := range []string{"node1", "node2"} {
+		vpcSchema[node+"_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		}
+		vpcSchema[node+"_interfaces"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		}
+	}
+	return fabricResourcePoliciesVpc.resource(vpcSchema)
+}
+
+func getVpcPayload(d *schema.ResourceData) map[string]interface{} {
+	vpc := map[string]interface{}{
+		"policy": d.Get("interface_policy_group_uuid").(string),
+	}
+	for _, node := range []string{"node1", "node2"} {
+		vpc[node+"Details"] = map[string]interface{}{
+			"node":             d.Get(node + "_id").(string),
+			"memberInterfaces": getMemberInterfacesPayload(d, node+"_interfaces"),
+		}
+	}
+	return vpc
+}
+
+func setVpcFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("interface_policy_group_uuid", convertInterfaceToString(policyCont.S("policy").Data()))
+	for _, node := range []string{"node1", "node2"} {
+		d.Set(node+"_id", convertInterfaceToString(policyCont.S(node+"Details", "node").Data()))
+		d.Set(node+"_interfaces", getMemberInterfaces(policyCont, node+"Details", "memberInterfaces"))
+	}
+}
@@ -0,0 +1,96 @@
+package mso
+
+import (
+	"sort"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var l3outTemplateInterfaceGroup = l3outTemplateObject{
+	label:          "L3Out Interface Group",
+	listKey:        "interfaceGroups",
+	idSegment:      "interfaceGroup",
+	matchKey:       "name",
+	matchAttribute: "name",
+	payload:        getL3outTemplateInterfaceGroupPayload,
+	set:            setL3outTemplateInterfaceGroupFromTemplate,
+}
+
+func resourceMSOL3outTemplateInterfaceGroup() *schema.Resource {
+	return l3outTemplateInterfaceGroup.resource(map[string]*schema.Schema{
+		"template_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"l3out_name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"interface_routing_policy_uuid": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"interfaces": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		},
+	})
+}
+
+func getL3outTemplateInterfaceGroupPayload(d *schema.ResourceData) map[string]interface{} {
+	interfaceGroup := map[string]interface{}{
+		"name":                      d.Get("name").(string),
+		"description":               d.Get("description").(string),
+		"interfaceRoutingPolicyRef": nil,
+		"interfaces":                nil,
+	}
+	if policy := d.Get("interface_routing_policy_uuid").(string); policy != "" {
+		interfaceGroup["interfaceRoutingPolicyRef"] = policy
+	}
+
+	// The interfaces are sorted to keep the payload stable.
+	interfaces := make([]string, 0)
+	for _, l3outInterface := range d.Get("interfaces").(*schema.Set).List() {
+		interfaces = append(interfaces, l3outInterface.(string))
+	}
+	sort.Strings(interfaces)
+	if len(interfaces) > 0 {
+		interfaceGroup["interfaces"] = interfaces
+	}
+	return interfaceGroup
+}
+
+func setL3outTemplateInterfaceGroupFromTemplate(d *schema.ResourceData, interfaceGroupCont *container.Container) {
+	setTemplateObjectString(d, interfaceGroupCont, "name", "name")
+	setTemplateObjectString(d, interfaceGroupCont, "description", "description")
+	setTemplateObjectString(d, interfaceGroupCont, "interface_routing_policy_uuid", "interfaceRoutingPolicyRef")
+
+	interfaces := make([]interface{}, 0)
+	interfaceList, _ := interfaceGroupCont.S("interfaces").Data().([]interface{})
+	for _, l3outInterface := range interfaceList {
+		interfaces = append(interfaces, convertInterfaceToString(l3outInterface))
+	}
+	d.Set("interfaces", interfaces)
+}
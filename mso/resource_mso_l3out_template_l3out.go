@@ -0,0 +1,140 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var l3outTemplateL3out = l3outTemplateObject{
+	label:   "L3Out",
+	payload: getL3outTemplateL3outPayload,
+	set:     setL3outTemplateL3outFromTemplate,
+}
+
+func resourceMSOL3outTemplateL3out() *schema.Resource {
+	return l3outTemplateL3out.resource(map[string]*schema.Schema{
+		"template_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"uuid": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vrf_uuid": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"l3_domain": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"pim": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"routing_protocol": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "none",
+			ValidateFunc: validation.StringInSlice([]string{
+				"none",
+				"bgp",
+				"ospf",
+				"bgpOspf",
+			}, false),
+		},
+		"ospf": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"area_id": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"area_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "regular",
+						ValidateFunc: validation.StringInSlice([]string{
+							"regular",
+							"stub",
+							"nssa",
+						}, false),
+					},
+					"cost": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(0, 16777215),
+					},
+				},
+			},
+		},
+	})
+}
+
+func getL3outTemplateL3outPayload(d *schema.ResourceData) map[string]interface{} {
+	l3out := map[string]interface{}{
+		"name":            d.Get("name").(string),
+		"description":     d.Get("description").(string),
+		"vrfRef":          d.Get("vrf_uuid").(string),
+		"l3domain":        d.Get("l3_domain").(string),
+		"pim":             d.Get("pim").(bool),
+		"routingProtocol": d.Get("routing_protocol").(string),
+		"ospfAreaConfig":  nil,
+	}
+	if ospf, ok := d.GetOk("ospf"); ok {
+		ospfConfig := ospf.([]interface{})[0].(map[string]interface{})
+		l3out["ospfAreaConfig"] = map[string]interface{}{
+			"id":       ospfConfig["area_id"],
+			"areaType": ospfConfig["area_type"],
+			"cost":     ospfConfig["cost"],
+		}
+	}
+	return l3out
+}
+
+func setL3outTemplateL3outFromTemplate(d *schema.ResourceData, l3outCont *container.Container) {
+	setTemplateObjectString(d, l3outCont, "name", "name")
+	setTemplateObjectString(d, l3outCont, "description", "description")
+	setTemplateObjectString(d, l3outCont, "uuid", "uuid")
+	setTemplateObjectString(d, l3outCont, "vrf_uuid", "vrfRef")
+	setTemplateObjectString(d, l3outCont, "l3_domain", "l3domain")
+	d.Set("pim", l3outCont.S("pim").Data() == true)
+	if l3outCont.Exists("routingProtocol") {
+		setTemplateObjectString(d, l3outCont, "routing_protocol", "routingProtocol")
+	} else {
+		d.Set("routing_protocol", "none")
+	}
+
+	ospf := make([]interface{}, 0)
+	if l3outCont.Exists("ospfAreaConfig") {
+		ospf = append(ospf, map[string]interface{}{
+			"area_id":   convertInterfaceToString(l3outCont.S("ospfAreaConfig", "id").Data()),
+			"area_type": convertInterfaceToString(l3outCont.S("ospfAreaConfig", "areaType").Data()),
+			"cost":      convertInterfaceToInt(l3outCont.S("ospfAreaConfig", "cost").Data()),
+		})
+	}
+	d.Set("ospf", ospf)
+}
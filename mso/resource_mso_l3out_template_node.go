@@ -0,0 +1,227 @@
+package mso
+
+import (
+	"fmt"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var l3outTemplateNode = l3outTemplateObject{
+	label:          "L3Out Node",
+	listKey:        "nodes",
+	idSegment:      "node",
+	matchKey:       "nodeID",
+	matchAttribute: "node_id",
+	payload:        getL3outTemplateNodePayload,
+	set:            setL3outTemplateNodeFromTemplate,
+}
+
+func resourceMSOL3outTemplateNode() *schema.Resource {
+	return l3outTemplateNode.resource(map[string]*schema.Schema{
+		"template_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"l3out_name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"node_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"pod_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1",
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"router_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+		"router_id_as_loopback": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"loopback_address": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"static_routes": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"prefix": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"next_hops": &schema.Schema{
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"ip": &schema.Schema{
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringLenBetween(1, 64),
+								},
+								"preference": &schema.Schema{
+									Type:         schema.TypeInt,
+									Optional:     true,
+									Default:      0,
+									ValidateFunc: validation.IntBetween(0, 255),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"bgp_peers": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"peer_address": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"peer_asn": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 4294967295),
+					},
+					"bfd": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"disable_connected_check": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	})
+}
+
+func getL3outTemplateNodePayload(d *schema.ResourceData) map[string]interface{} {
+	node := map[string]interface{}{
+		"nodeID":               d.Get("node_id").(string),
+		"podID":                d.Get("pod_id").(string),
+		"routerID":             d.Get("router_id").(string),
+		"useRouteIDAsLoopback": d.Get("router_id_as_loopback").(bool),
+		"loopbackIPs":          nil,
+		"staticRoutes":         nil,
+		"bgpPeers":             nil,
+	}
+	if loopback := d.Get("loopback_address").(string); loopback != "" && !d.Get("router_id_as_loopback").(bool) {
+		node["loopbackIPs"] = map[string]interface{}{"ipv4": loopback}
+	}
+
+	staticRoutes := make([]interface{}, 0)
+	for _, route := range d.Get("static_routes").([]interface{}) {
+		routeMap := route.(map[string]interface{})
+		nextHops := make([]interface{}, 0)
+		for _, nextHop := range routeMap["next_hops"].([]interface{}) {
+			nextHopMap := nextHop.(map[string]interface{})
+			nextHops = append(nextHops, map[string]interface{}{
+				"nextHopIP":  nextHopMap["ip"],
+				"preference": nextHopMap["preference"],
+			})
+		}
+		staticRoutes = append(staticRoutes, map[string]interface{}{
+			"prefix":   routeMap["prefix"],
+			"nextHops": nextHops,
+		})
+	}
+	if len(staticRoutes) > 0 {
+		node["staticRoutes"] = staticRoutes
+	}
+
+	bgpPeers := make([]interface{}, 0)
+	for _, peer := range d.Get("bgp_peers").([]interface{}) {
+		peerMap := peer.(map[string]interface{})
+		bgpPeers = append(bgpPeers, map[string]interface{}{
+			"peerAddressV4": peerMap["peer_address"],
+			"peerAsn":       peerMap["peer_asn"],
+			"peerControls": map[string]interface{}{
+				"bfd":                   peerMap["bfd"],
+				"disableConnectedCheck": peerMap["disable_connected_check"],
+			},
+		})
+	}
+	if len(bgpPeers) > 0 {
+		node["bgpPeers"] = bgpPeers
+	}
+	return node
+}
+
+func setL3outTemplateNodeFromTemplate(d *schema.ResourceData, nodeCont *container.Container) {
+	d.Set("node_id", fmt.Sprint(nodeCont.S("nodeID").Data()))
+	if nodeCont.Exists("podID") {
+		d.Set("pod_id", fmt.Sprint(nodeCont.S("podID").Data()))
+	}
+	setTemplateObjectString(d, nodeCont, "router_id", "routerID")
+	d.Set("router_id_as_loopback", nodeCont.S("useRouteIDAsLoopback").Data() == true)
+	d.Set("loopback_address", convertInterfaceToString(nodeCont.S("loopbackIPs", "ipv4").Data()))
+
+	staticRoutes := make([]interface{}, 0)
+	routeCount, _ := nodeCont.ArrayCount("staticRoutes")
+	for i := 0; i < routeCount; i++ {
+		routeCont, err := nodeCont.ArrayElement(i, "staticRoutes")
+		if err != nil {
+			continue
+		}
+		nextHops := make([]interface{}, 0)
+		nextHopCount, _ := routeCont.ArrayCount("nextHops")
+		for j := 0; j < nextHopCount; j++ {
+			nextHopCont, err := routeCont.ArrayElement(j, "nextHops")
+			if err != nil {
+				continue
+			}
+			nextHops = append(nextHops, map[string]interface{}{
+				"ip":         convertInterfaceToString(nextHopCont.S("nextHopIP").Data()),
+				"preference": convertInterfaceToInt(nextHopCont.S("preference").Data()),
+			})
+		}
+		staticRoutes = append(staticRoutes, map[string]interface{}{
+			"prefix":    convertInterfaceToString(routeCont.S("prefix").Data()),
+			"next_hops": nextHops,
+		})
+	}
+	d.Set("static_routes", staticRoutes)
+
+	bgpPeers := make([]interface{}, 0)
+	peerCount, _ := nodeCont.ArrayCount("bgpPeers")
+	for i := 0; i < peerCount; i++ {
+		peerCont, err := nodeCont.ArrayElement(i, "bgpPeers")
+		if err != nil {
+			continue
+		}
+		bgpPeers = append(bgpPeers, map[string]interface{}{
+			"peer_address":            convertInterfaceToString(peerCont.S("peerAddressV4").Data()),
+			"peer_asn":                convertInterfaceToInt(peerCont.S("peerAsn").Data()),
+			"bfd":                     peerCont.S("peerControls", "bfd").Data() == true,
+			"disable_connected_check": peerCont.S("peerControls", "disableConnectedCheck").Data() == true,
+		})
+	}
+	d.Set("bgp_peers", bgpPeers)
+}
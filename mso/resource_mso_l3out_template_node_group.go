@@ -0,0 +1,107 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var l3outTemplateNodeGroup = l3outTemplateObject{
+	label:          "L3Out Node Group",
+	listKey:        "nodeGroups",
+	idSegment:      "nodeGroup",
+	matchKey:       "name",
+	matchAttribute: "name",
+	payload:        getL3outTemplateNodeGroupPayload,
+	set:            setL3outTemplateNodeGroupFromTemplate,
+}
+
+func resourceMSOL3outTemplateNodeGroup() *schema.Resource {
+	return l3outTemplateNodeGroup.resource(map[string]*schema.Schema{
+		"template_id": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"l3out_name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+		"description": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"node_routing_policy_uuid": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"bfd_multi_hop_authentication": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key_id": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 255),
+					},
+					"key": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringLenBetween(1, 20),
+					},
+				},
+			},
+		},
+	})
+}
+
+func getL3outTemplateNodeGroupPayload(d *schema.ResourceData) map[string]interface{} {
+	nodeGroup := map[string]interface{}{
+		"name":                 d.Get("name").(string),
+		"description":          d.Get("description").(string),
+		"nodeRoutingPolicyRef": nil,
+		"bfdMultiHop":          nil,
+	}
+	if policy := d.Get("node_routing_policy_uuid").(string); policy != "" {
+		nodeGroup["nodeRoutingPolicyRef"] = policy
+	}
+	if authentication, ok := d.GetOk("bfd_multi_hop_authentication"); ok {
+		authenticationMap := authentication.([]interface{})[0].(map[string]interface{})
+		nodeGroup["bfdMultiHop"] = map[string]interface{}{
+			"authEnabled": true,
+			"keyID":       authenticationMap["key_id"],
+			"key":         map[string]interface{}{"value": authenticationMap["key"]},
+		}
+	}
+	return nodeGroup
+}
+
+func setL3outTemplateNodeGroupFromTemplate(d *schema.ResourceData, nodeGroupCont *container.Container) {
+	setTemplateObjectString(d, nodeGroupCont, "name", "name")
+	setTemplateObjectString(d, nodeGroupCont, "description", "description")
+	setTemplateObjectString(d, nodeGroupCont, "node_routing_policy_uuid", "nodeRoutingPolicyRef")
+
+	// The key is not returned by NDO, so the key of the configuration is kept.
+	authentication := make([]interface{}, 0, 1)
+	if nodeGroupCont.S("bfdMultiHop", "authEnabled").Data() == true {
+		authentication = append(authentication, map[string]interface{}{
+			"key_id": convertInterfaceToInt(nodeGroupCont.S("bfdMultiHop", "keyID").Data()),
+			"key":    d.Get("bfd_multi_hop_authentication.0.key").(string),
+		})
+	}
+	d.Set("bfd_multi_hop_authentication", authentication)
+}
@@ -0,0 +1,43 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestMockNDOL3outTemplateNodeGroupBfdMultiHop(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/templates/template1", map[string]interface{}{
+		l3outTemplate: map[string]interface{}{
+			"l3outs": []interface{}{map[string]interface{}{"name": "l3out1"}},
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceMSOL3outTemplateNodeGroup().Schema, map[string]interface{}{
+		"template_id": "template1",
+		"l3out_name":  "l3out1",
+		"name":        "nodeGroup1",
+		"bfd_multi_hop_authentication": []interface{}{map[string]interface{}{
+			"key_id": 5,
+			"key":    "secret",
+		}},
+	})
+	if err := l3outTemplateNodeGroup.create(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+
+	object, _ := server.Object("api/v1/templates/template1")
+	l3out := object.(map[string]interface{})[l3outTemplate].(map[string]interface{})["l3outs"].([]interface{})[0].(map[string]interface{})
+	nodeGroup := l3out["nodeGroups"].([]interface{})[0].(map[string]interface{})
+	bfdMultiHop, _ := nodeGroup["bfdMultiHop"].(map[string]interface{})
+	if bfdMultiHop["authEnabled"] != true || bfdMultiHop["keyID"] != float64(5) {
+		t.Errorf("Unexpected BFD multihop settings %v", nodeGroup["bfdMultiHop"])
+	}
+	if keyId := d.Get("bfd_multi_hop_authentication.0.key_id"); keyId != 5 {
+		t.Errorf("Expected key ID 5, got %v", keyId)
+	}
+	if key := d.Get("bfd_multi_hop_authentication.0.key"); key != "secret" {
+		t.Errorf("Expected the key of the configuration to be kept, got %v", key)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/models"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceMSOLabel() *schema.Resource {
@@ -32,26 +33,24 @@ func resourceMSOLabel() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"site",
+					"user",
+				}, false),
 			},
 		}),
 	}
 }
 
 func resourceMSOLabelImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	log.Printf("[DEBUG] Label: Beginning Import")
+	log.Printf("[DEBUG] %s: Label: Beginning Import", d.Id())
 
-	msoClient := m.(*client.Client)
-	con, err := msoClient.GetViaURL("api/v1/labels" + d.Id())
+	err := resourceMSOLabelRead(d, m)
 	if err != nil {
 		return nil, err
 	}
-
-	d.SetId(models.StripQuotes(con.S("id").String()))
-	if con.Exists("displayName") {
-		d.Set("label", models.StripQuotes(con.S("displayName").String()))
-	}
-	if con.Exists("type") {
-		d.Set("type", models.StripQuotes(con.S("type").String()))
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Unable to find the Label")
 	}
 
 	log.Printf("[DEBUG] %s: Label Import finished successfully", d.Id())
@@ -0,0 +1,187 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var monitoringPoliciesSpanSession = templatePolicyObject{
+	label:        "SPAN Session",
+	templateType: monitoringTemplate,
+	objectKey:    "spanSessions",
+	idSegment:    "spanSession",
+	payload:      getSpanSessionPayload,
+	set:          setSpanSessionFromTemplate,
+}
+
+// spanSessionInterfaceSchema returns the schema of the node and interface of a SPAN source or destination, which are set for access SPAN sessions.
+func spanSessionInterfaceSchema(spanSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	spanSchema["node_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 64),
+	}
+	spanSchema["interface"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 64),
+	}
+	return spanSchema
+}
+
+func resourceMSOMonitoringPoliciesSpanSession() *schema.Resource {
+	return monitoringPoliciesSpanSession.resource(map[string]*schema.Schema{
+		"admin_state": templatePolicyAdminStateSchema(),
+		"mtu": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1518,
+			ValidateFunc: validation.IntBetween(64, 9216),
+		},
+		"sources": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: spanSessionInterfaceSchema(map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"direction": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "both",
+						ValidateFunc: validation.StringInSlice([]string{
+							"incoming",
+							"outgoing",
+							"both",
+						}, false),
+					},
+					"epg_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+				}),
+			},
+		},
+		"destination": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: spanSessionInterfaceSchema(map[string]*schema.Schema{
+					"epg_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"ip": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+					"source_ip_prefix": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"span_version": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "v2",
+						ValidateFunc: validation.StringInSlice([]string{
+							"v1",
+							"v2",
+						}, false),
+					},
+				}),
+			},
+		},
+	})
+}
+
+// getSpanSessionPath returns the path payload of the node and interface of a SPAN source or destination, or nil when they are not set.
+func getSpanSessionPath(spanMap map[string]interface{}) map[string]interface{} {
+	if spanMap["node_id"].(string) == "" && spanMap["interface"].(string) == "" {
+		return nil
+	}
+	return map[string]interface{}{
+		"node":      spanMap["node_id"],
+		"interface": spanMap["interface"],
+	}
+}
+
+func getSpanSessionPayload(d *schema.ResourceData) map[string]interface{} {
+	sources := make([]interface{}, 0)
+	for _, source := range d.Get("sources").([]interface{}) {
+		sourceMap := source.(map[string]interface{})
+		spanSource := map[string]interface{}{
+			"name":      sourceMap["name"],
+			"direction": sourceMap["direction"],
+		}
+		if epg := sourceMap["epg_uuid"].(string); epg != "" {
+			spanSource["epgRef"] = epg
+		}
+		if path := getSpanSessionPath(sourceMap); path != nil {
+			spanSource["path"] = path
+		}
+		sources = append(sources, spanSource)
+	}
+
+	destinationMap := d.Get("destination").([]interface{})[0].(map[string]interface{})
+	destination := map[string]interface{}{
+		"spanVersion": destinationMap["span_version"],
+	}
+	for attribute, key := range map[string]string{"epg_uuid": "epgRef", "ip": "destIPAddress", "source_ip_prefix": "srcIPPrefix"} {
+		if value := destinationMap[attribute].(string); value != "" {
+			destination[key] = value
+		}
+	}
+	if path := getSpanSessionPath(destinationMap); path != nil {
+		destination["path"] = path
+	}
+
+	return map[string]interface{}{
+		"adminState": d.Get("admin_state").(string),
+		"mtu":        d.Get("mtu").(int),
+		"sourceGroup": map[string]interface{}{
+			"sources": sources,
+		},
+		"destination": destination,
+	}
+}
+
+func setSpanSessionFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	setTemplatePolicyString(d, policyCont, "admin_state", "adminState")
+	if policyCont.Exists("mtu") {
+		d.Set("mtu", convertInterfaceToInt(policyCont.S("mtu").Data()))
+	}
+
+	sources := make([]interface{}, 0)
+	for _, source := range getTemplatePolicyList(policyCont.S("sourceGroup"), "sources") {
+		path, _ := source["path"].(map[string]interface{})
+		sources = append(sources, map[string]interface{}{
+			"name":      convertInterfaceToString(source["name"]),
+			"direction": convertInterfaceToString(source["direction"]),
+			"epg_uuid":  convertInterfaceToString(source["epgRef"]),
+			"node_id":   convertInterfaceToString(path["node"]),
+			"interface": convertInterfaceToString(path["interface"]),
+		})
+	}
+	d.Set("sources", sources)
+
+	destination, _ := policyCont.S("destination").Data().(map[string]interface{})
+	path, _ := destination["path"].(map[string]interface{})
+	d.Set("destination", []interface{}{map[string]interface{}{
+		"epg_uuid":         convertInterfaceToString(destination["epgRef"]),
+		"ip":               convertInterfaceToString(destination["destIPAddress"]),
+		"source_ip_prefix": convertInterfaceToString(destination["srcIPPrefix"]),
+		"span_version":     convertInterfaceToString(destination["spanVersion"]),
+		"node_id":          convertInterfaceToString(path["node"]),
+		"interface":        convertInterfaceToString(path["interface"]),
+	}})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -84,9 +85,28 @@ func resourceMSOSchema() *schema.Resource {
 							// validation func does not work inside typeset
 							ValidateFunc: validation.StringInSlice(getSchemaTemplateTypes(), false),
 						},
+						"depends_on_templates": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
+			"template_order": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"undeploy_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		}),
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 			// check if template_type is changed between known state and provided configuration and error out during plan if it is
@@ -101,6 +121,13 @@ func resourceMSOSchema() *schema.Resource {
 					}
 				}
 			}
+			if diff.HasChange("template") {
+				err := validateSchemaTemplateDependencies(configTemplate.(*schema.Set).List())
+				if err != nil {
+					return err
+				}
+				diff.SetNewComputed("template_order")
+			}
 			return nil
 		},
 	}
@@ -159,6 +186,83 @@ func getTemplateSubType(template_type string) []string {
 	return nil
 }
 
+// getSchemaTemplateDependencies returns the names of the templates the template depends on.
+func getSchemaTemplateDependencies(template map[string]interface{}) []string {
+	dependencies := make([]string, 0)
+	if dependsOn, ok := template["depends_on_templates"].([]interface{}); ok {
+		for _, dependency := range dependsOn {
+			dependencies = append(dependencies, convertInterfaceToString(dependency))
+		}
+	}
+	return dependencies
+}
+
+// validateSchemaTemplateDependencies checks that the templates only depend on templates of the schema and that the dependencies have no cycle.
+func validateSchemaTemplateDependencies(templates []interface{}) error {
+	names := make(map[string]bool)
+	for _, template := range templates {
+		names[template.(map[string]interface{})["name"].(string)] = true
+	}
+	for _, template := range templates {
+		templateMap := template.(map[string]interface{})
+		for _, dependency := range getSchemaTemplateDependencies(templateMap) {
+			// Unknown names are only known during apply and are validated then.
+			if dependency == "" || templateMap["name"] == "" {
+				continue
+			}
+			if dependency == templateMap["name"] {
+				return fmt.Errorf("Template '%s' cannot depend on itself.", dependency)
+			}
+			if !names[dependency] {
+				return fmt.Errorf("Template '%s' depends on template '%s' which is not a template of the schema.", templateMap["name"], dependency)
+			}
+		}
+	}
+	_, err := sortSchemaTemplatesByDependency(templates)
+	return err
+}
+
+// sortSchemaTemplatesByDependency returns the templates ordered so that every template comes after the templates it depends on.
+// Templates without a dependency between them are ordered by name, dependencies on templates that are not in the list are ignored.
+func sortSchemaTemplatesByDependency(templates []interface{}) ([]interface{}, error) {
+	remaining := make(map[string]map[string]interface{})
+	for _, template := range templates {
+		templateMap := template.(map[string]interface{})
+		remaining[templateMap["name"].(string)] = templateMap
+	}
+
+	sorted := make([]interface{}, 0, len(templates))
+	for len(remaining) > 0 {
+		ready := make([]string, 0)
+		for name, templateMap := range remaining {
+			dependenciesDone := true
+			for _, dependency := range getSchemaTemplateDependencies(templateMap) {
+				if _, ok := remaining[dependency]; ok && dependency != name {
+					dependenciesDone = false
+					break
+				}
+			}
+			if dependenciesDone {
+				ready = append(ready, name)
+			}
+		}
+		if len(ready) == 0 {
+			cycle := make([]string, 0, len(remaining))
+			for name := range remaining {
+				cycle = append(cycle, name)
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("The dependencies of templates %s form a cycle.", strings.Join(cycle, ", "))
+		}
+		sort.Strings(ready)
+		for _, name := range ready {
+			sorted = append(sorted, remaining[name])
+			delete(remaining, name)
+		}
+	}
+	return sorted, nil
+}
+
 func resourceMSOSchemaCreate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Schema: Beginning Creation")
 	msoClient := m.(*client.Client)
@@ -191,7 +295,10 @@ func resourceMSOSchemaCreate(d *schema.ResourceData, m interface{}) error {
 	} else {
 		templates := make([]interface{}, 0, 1)
 		if ok_templates {
-			template_list := tempVarTemplates.(*schema.Set).List()
+			template_list, err := sortSchemaTemplatesByDependency(tempVarTemplates.(*schema.Set).List())
+			if err != nil {
+				return err
+			}
 			for _, val := range template_list {
 				map_templates := make(map[string]interface{})
 				inner_templates := val.(map[string]interface{})
@@ -216,6 +323,10 @@ func resourceMSOSchemaCreate(d *schema.ResourceData, m interface{}) error {
 
 	id := models.StripQuotes(cont.S("id").String())
 	d.SetId(fmt.Sprintf("%s", id))
+	err = waitForCreatedObject(msoClient, fmt.Sprintf("api/v1/schemas/%s", id), nil)
+	if err != nil {
+		return err
+	}
 	log.Printf("[DEBUG] %s: Schema Creation finished successfully", d.Id())
 
 	return resourceMSOSchemaRead(d, m)
@@ -224,13 +335,14 @@ func resourceMSOSchemaCreate(d *schema.ResourceData, m interface{}) error {
 func resourceMSOSchemaImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] Schema: Beginning Import")
 	msoClient := m.(*client.Client)
-	con, err := msoClient.GetViaURL("api/v1/schemas/" + d.Id())
+	con, err := getSchemaCont(msoClient, d.Id())
 	if err != nil {
 		return nil, err
 	}
 	d.SetId(models.StripQuotes(con.S("id").String()))
 	d.Set("name", models.StripQuotes(con.S("displayName").String()))
 	d.Set("description", models.StripQuotes(con.S("description").String()))
+	d.Set("undeploy_on_destroy", false)
 
 	// Currently in NDO 4.1 the templates container is initialized as null instead of empty list
 	//  so when no templates are provided during create or import it is impossible to PATCH add a template
@@ -273,6 +385,10 @@ func resourceMSOSchemaImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Schema: Beginning Update")
 	msoClient := m.(*client.Client)
+	// undeploy_on_destroy is only used on destroy and requires no request.
+	if !d.HasChanges("name", "description", "template_name", "tenant_id", "template") {
+		return resourceMSOSchemaRead(d, m)
+	}
 	name := d.Get("name").(string)
 	description := d.Get("description").(string)
 	_, ok_template_name := d.GetOk("template_name")
@@ -346,6 +462,7 @@ func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 			return err
 		}
 		cont, _, err := msoClient.Do(req)
+		invalidateSchemaCache(msoClient, path)
 		if err != nil {
 			return err
 		}
@@ -394,6 +511,12 @@ func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 				for _, valueMapNew := range getDifferenceNew {
 					valueNew := valueMapNew.(map[string]interface{})
 
+					// Dependencies of template have been changed, these are only kept in the state
+					if valueOld["name"] == valueNew["name"] && !reflect.DeepEqual(valueOld["depends_on_templates"], valueNew["depends_on_templates"]) {
+						listMapsReplaced = append(listMapsReplaced, valueNew)
+						listMapsToReplace = append(listMapsToReplace, valueOld)
+					}
+
 					// Tenant Id of template has been changed
 					if valueOld["name"] == valueNew["name"] && valueOld["tenant_id"] != valueNew["tenant_id"] {
 						listMapsReplaced = append(listMapsReplaced, valueNew)
@@ -446,11 +569,15 @@ func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 				}
 			}
 
-			// New templates have been added to the block.
-			listMapsToAdd := differenceInLists(getDifferenceNew, listMapsReplaced)
+			// New templates have been added to the block, the templates are added after the templates they depend on.
+			listMapsToAdd, err := sortSchemaTemplatesByDependency(differenceInLists(getDifferenceNew, listMapsReplaced))
+			if err != nil {
+				return err
+			}
 			for _, MapToAdd := range listMapsToAdd {
 
 				changedMap := MapToAdd.(map[string]interface{})
+				delete(changedMap, "depends_on_templates")
 
 				if val, ok := changedMap["template_type"]; ok && val.(string) != "" {
 					changedMap["templateType"] = getTemplateType(changedMap["template_type"].(string))
@@ -468,10 +595,14 @@ func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 						`, map_values))
 			}
 
-			// templates have been removed from the block
-			listMapsToRemove := differenceInLists(getDifferenceOld, listMapsToReplace)
-			for _, MapToRemove := range listMapsToRemove {
-				valueRemove := MapToRemove.(map[string]interface{})
+			// templates have been removed from the block, the templates are removed before the templates they depend on.
+			listMapsToRemove, err := sortSchemaTemplatesByDependency(differenceInLists(getDifferenceOld, listMapsToReplace))
+			if err != nil {
+				return err
+			}
+			for i := len(listMapsToRemove) - 1; i >= 0; i-- {
+				valueRemove := listMapsToRemove[i].(map[string]interface{})
+				delete(valueRemove, "depends_on_templates")
 				map_remove, _ := json.Marshal(valueRemove)
 				map_values := strings.Replace(strings.Replace(string(map_remove), "display_name", "displayName", 1), "tenant_id", "tenantId", 1)
 				listAttributesToChange = append(listAttributesToChange, fmt.Sprintf(`
@@ -502,6 +633,7 @@ func resourceMSOSchemaUpdate(d *schema.ResourceData, m interface{}) error {
 				return err
 			}
 			cont, _, err := msoClient.Do(req)
+			invalidateSchemaCache(msoClient, path)
 			if err != nil {
 				return err
 			}
@@ -522,7 +654,7 @@ func resourceMSOSchemaRead(d *schema.ResourceData, m interface{}) error {
 	msoClient := m.(*client.Client)
 
 	dn := d.Id()
-	con, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/" + dn))
+	con, err := getSchemaCont(msoClient, dn)
 	if err != nil {
 		return errorForObjectNotFound(err, dn, con, d)
 	}
@@ -544,6 +676,14 @@ func resourceMSOSchemaRead(d *schema.ResourceData, m interface{}) error {
 	}
 	stateTemplate := d.Get("template_name").(string)
 	stateTenant := d.Get("tenant_id").(string)
+	// The dependencies between templates are not stored in NDO, these are kept from the state.
+	stateDependencies := make(map[string]interface{})
+	if stateTemplates, ok := d.Get("template").(*schema.Set); ok {
+		for _, stateTemplate := range stateTemplates.List() {
+			stateTemplateMap := stateTemplate.(map[string]interface{})
+			stateDependencies[stateTemplateMap["name"].(string)] = stateTemplateMap["depends_on_templates"]
+		}
+	}
 	templates := make([]interface{}, 0)
 	for i := 0; i < count; i++ {
 		templatesCont, err := con.ArrayElement(i, "templates")
@@ -558,6 +698,9 @@ func resourceMSOSchemaRead(d *schema.ResourceData, m interface{}) error {
 		if templatesCont.Exists("templateType") {
 			map_template["template_type"] = getSchemaTemplateType(templatesCont)
 		}
+		if dependencies, ok := stateDependencies[map_template["name"].(string)]; ok {
+			map_template["depends_on_templates"] = dependencies
+		}
 		templates = append(templates, map_template)
 
 		apiTemplate := models.StripQuotes(templatesCont.S("name").String())
@@ -572,16 +715,50 @@ func resourceMSOSchemaRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("template_name", "")
 		d.Set("tenant_id", "")
 	}
+	d.Set("template_order", getSchemaTemplateOrder(templates))
 	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
 	return nil
 }
 
+// getSchemaTemplateOrder returns the names of the templates in the order of their dependencies.
+func getSchemaTemplateOrder(templates []interface{}) []string {
+	sorted, err := sortSchemaTemplatesByDependency(templates)
+	if err != nil {
+		sorted = templates
+	}
+	order := make([]string, 0, len(sorted))
+	for _, template := range sorted {
+		order = append(order, template.(map[string]interface{})["name"].(string))
+	}
+	return order
+}
+
 func resourceMSOSchemaDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
 
 	msoClient := m.(*client.Client)
 	dn := d.Id()
-	err := msoClient.DeletebyId("api/v1/schemas/" + dn)
+
+	schemaCont, err := getSchemaCont(msoClient, dn)
+	if err != nil {
+		return errorForObjectNotFound(err, dn, schemaCont, d)
+	}
+	templateNames := make([]string, 0)
+	count, _ := schemaCont.ArrayCount("templates")
+	for i := 0; i < count; i++ {
+		templateCont, err := schemaCont.ArrayElement(i, "templates")
+		if err != nil {
+			return err
+		}
+		templateNames = append(templateNames, models.StripQuotes(templateCont.S("name").String()))
+	}
+	err = undeployOrProtectTemplates(getStopContext(msoClient), msoClient, dn, templateNames, d.Get("undeploy_on_destroy").(bool))
+	if err != nil {
+		return err
+	}
+
+	err = msoClient.DeletebyId("api/v1/schemas/" + dn)
+	invalidateSchemaCache(msoClient, "api/v1/schemas/"+dn)
 	if err != nil {
 		return err
 	}
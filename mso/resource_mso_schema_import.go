@@ -0,0 +1,258 @@
+package mso
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSchemaJsonImport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaJsonImportCreate,
+		Read:   resourceMSOSchemaJsonImportRead,
+		Update: resourceMSOSchemaJsonImportUpdate,
+		Delete: resourceMSOSchemaJsonImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSchemaJsonImportImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"json": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"tenant_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		}),
+	}
+}
+
+// getSchemaImportPayload returns the payload for the schema from the export JSON, with the NDO generated keys removed
+// and the name and tenant of the templates replaced when they are configured.
+func getSchemaImportPayload(d *schema.ResourceData) (*container.Container, error) {
+	var schemaData interface{}
+	err := json.Unmarshal([]byte(d.Get("json").(string)), &schemaData)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the json: %s", err)
+	}
+	payload, err := exportSchemaTemplate(schemaData, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		payload["displayName"] = name.(string)
+	}
+	if tenantId, ok := d.GetOk("tenant_id"); ok {
+		if templates, ok := payload["templates"].([]interface{}); ok {
+			for _, template := range templates {
+				if templateMap, ok := template.(map[string]interface{}); ok {
+					templateMap["tenantId"] = tenantId.(string)
+				}
+			}
+		}
+	}
+
+	payloadCon := container.New()
+	_, err = payloadCon.Set(payload)
+	if err != nil {
+		return nil, err
+	}
+	return payloadCon, nil
+}
+
+// schemaImportRefRegex matches the reference to an object of a template of a schema.
+var schemaImportRefRegex = regexp.MustCompile(`^/schemas/[^/]+/templates/([^/]+)(/.*)?$`)
+
+// normalizeSchemaImportRefs returns the value with the schema id removed from the references to the templates of the schema,
+// because the references in the JSON contain the id of the exported schema and the references of NDO the id of the created schema.
+func normalizeSchemaImportRefs(value interface{}, templateNames []string) interface{} {
+	switch v := value.(type) {
+	case string:
+		if match := schemaImportRefRegex.FindStringSubmatch(v); match != nil && valueInSliceofStrings(match[1], templateNames) {
+			return fmt.Sprintf("/schemas//templates/%s%s", match[1], match[2])
+		}
+	case []interface{}:
+		normalized := make([]interface{}, 0, len(v))
+		for _, element := range v {
+			normalized = append(normalized, normalizeSchemaImportRefs(element, templateNames))
+		}
+		return normalized
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, property := range v {
+			normalized[key] = normalizeSchemaImportRefs(property, templateNames)
+		}
+		if templateName, ok := v["templateName"].(string); ok && valueInSliceofStrings(templateName, templateNames) {
+			delete(normalized, "schemaId")
+		}
+		return normalized
+	}
+	return value
+}
+
+// isSchemaImportPayloadApplied returns whether the schema still contains the objects and values of the payload.
+// The name is compared separately and the properties which NDO adds to the objects are ignored.
+func isSchemaImportPayloadApplied(payload, export map[string]interface{}) bool {
+	templateNames := make([]string, 0)
+	if templates, ok := payload["templates"].([]interface{}); ok {
+		for _, template := range templates {
+			if templateMap, ok := template.(map[string]interface{}); ok {
+				templateNames = append(templateNames, convertInterfaceToString(templateMap["name"]))
+			}
+		}
+	}
+	expected := normalizeSchemaImportRefs(normalizePatchValue(payload), templateNames).(map[string]interface{})
+	current := normalizeSchemaImportRefs(normalizePatchValue(export), templateNames).(map[string]interface{})
+	delete(expected, "displayName")
+	delete(current, "displayName")
+	return containsPatchValue(current, expected)
+}
+
+func resourceMSOSchemaJsonImportImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	msoClient := m.(*client.Client)
+
+	cont, err := getSchemaCont(msoClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	export, err := exportSchemaTemplate(cont.Data(), "")
+	if err != nil {
+		return nil, err
+	}
+	exportJson, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("json", string(exportJson))
+	d.Set("name", models.G(cont, "displayName"))
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSchemaJsonImportCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Schema Import: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	payload, err := getSchemaImportPayload(d)
+	if err != nil {
+		return err
+	}
+
+	req, err := msoClient.MakeRestRequest("POST", "api/v1/schemas", payload, true)
+	if err != nil {
+		return err
+	}
+	cont, _, err := msoClient.Do(req)
+	if err != nil {
+		return err
+	}
+	err = client.CheckForErrors(cont, "POST")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(models.G(cont, "id"))
+	err = waitForCreatedObject(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Id()), nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] %s: Schema Import Creation finished successfully", d.Id())
+	return resourceMSOSchemaJsonImportRead(d, m)
+}
+
+func resourceMSOSchemaJsonImportRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	cont, err := getSchemaCont(msoClient, d.Id())
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	d.Set("name", models.G(cont, "displayName"))
+
+	// The json is only set to the exported schema when the schema changed, so the formatting of the configured json is kept.
+	export, err := exportSchemaTemplate(cont.Data(), "")
+	if err != nil {
+		return err
+	}
+	payload, err := getSchemaImportPayload(d)
+	if err != nil {
+		return err
+	}
+	if !isSchemaImportPayloadApplied(payload.Data().(map[string]interface{}), export) {
+		exportJson, err := json.Marshal(export)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] %s: Schema differs from the imported json", d.Id())
+		d.Set("json", string(exportJson))
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+// resourceMSOSchemaJsonImportUpdate only changes the name of the schema, because a change of the json or tenant_id creates the schema again.
+func resourceMSOSchemaJsonImportUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	if d.HasChange("name") {
+		payloadCon := container.New()
+		payloadCon.Array()
+		err := addPatchPayloadToContainer(payloadCon, "replace", "/displayName", d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+		err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Id()), payloadCon)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSchemaJsonImportRead(d, m)
+}
+
+func resourceMSOSchemaJsonImportDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	path := fmt.Sprintf("api/v1/schemas/%s", d.Id())
+	err := msoClient.DeletebyId(path)
+	invalidateSchemaCache(msoClient, path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
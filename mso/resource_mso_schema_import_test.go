@@ -0,0 +1,190 @@
+package mso
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccMSOSchemaImport_Basic(t *testing.T) {
+	var s SchemaTest
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMSOSchemaImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMSOSchemaImportConfig_basic("schema_import_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMSOSchemaImportExists("mso_schema_import.schema1", &s),
+					resource.TestCheckResourceAttr("mso_schema_import.schema1", "name", "schema_import_1"),
+				),
+			},
+			{
+				Config: testAccCheckMSOSchemaImportConfig_basic("schema_import_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMSOSchemaImportExists("mso_schema_import.schema1", &s),
+					resource.TestCheckResourceAttr("mso_schema_import.schema1", "name", "schema_import_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMSOSchemaImportConfig_basic(name string) string {
+	return fmt.Sprintf(`
+	resource "mso_schema_import" "schema1" {
+		name      = "%s"
+		tenant_id = "5e9d09482c000068500a269a"
+		json      = jsonencode({
+			displayName = "exported_schema"
+			templates = [{
+				name        = "temp1"
+				displayName = "temp1"
+				tenantId    = "5e9d09482c000068500a269b"
+				anps        = []
+				contracts   = []
+				vrfs        = []
+				bds         = []
+				filters     = []
+				externalEpgs = []
+				serviceGraphs = []
+				intersiteL3outs = []
+			}]
+			sites = []
+		})
+	}
+	`, name)
+}
+
+func testAccCheckMSOSchemaImportExists(schemaName string, st *SchemaTest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[schemaName]
+		if !ok {
+			return fmt.Errorf("Schema %s not found", schemaName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Schema id was set")
+		}
+
+		client := testAccProvider.Meta().(*client.Client)
+		cont, err := client.GetViaURL("api/v1/schemas/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		sts, err := schemaFromcontainer(cont)
+		if err != nil {
+			return err
+		}
+		if sts.TenantId != "5e9d09482c000068500a269a" {
+			return fmt.Errorf("Bad Template tenant %s", sts.TenantId)
+		}
+		*st = *sts
+		return nil
+	}
+}
+
+func testAccCheckMSOSchemaImportDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*client.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "mso_schema_import" {
+			_, err := client.GetViaURL("api/v1/schemas/" + rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("Schema still exists")
+			}
+		}
+	}
+	return nil
+}
+
+func TestMockNDOSchemaImportReadAndRename(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	schemaObject, _ := server.Object("api/v1/schemas/" + mockSchemaId)
+	export, err := exportSchemaTemplate(schemaObject, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exportJson, _ := json.Marshal(export)
+
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaJsonImport().Schema, map[string]interface{}{
+		"json": string(exportJson),
+	})
+	d.SetId(mockSchemaId)
+	if err := resourceMSOSchemaJsonImportRead(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("json") != string(exportJson) {
+		t.Errorf("Expected the json of an unchanged schema to be kept, got %s", d.Get("json"))
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceMSOSchemaJsonImport().Schema, map[string]interface{}{
+		"json": string(exportJson),
+		"name": "renamed",
+	})
+	d.SetId(mockSchemaId)
+	if err := resourceMSOSchemaJsonImportUpdate(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPut {
+			t.Errorf("Expected the name to be changed without replacing the schema, got %s %s", request.Method, request.Path)
+		}
+	}
+	schemaObject, _ = server.Object("api/v1/schemas/" + mockSchemaId)
+	if name := schemaObject.(map[string]interface{})["displayName"]; name != "renamed" {
+		t.Errorf("Expected the schema to be renamed, got %v", name)
+	}
+	if d.Get("json") != string(exportJson) {
+		t.Errorf("Expected the json to be kept after a rename, got %s", d.Get("json"))
+	}
+
+	templates := schemaObject.(map[string]interface{})["templates"].([]interface{})
+	templates[0].(map[string]interface{})["bds"] = []interface{}{}
+	server.SetObject("api/v1/schemas/"+mockSchemaId, schemaObject)
+	if err := resourceMSOSchemaJsonImportRead(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("json") == string(exportJson) {
+		t.Error("Expected the json to show the removed BD")
+	}
+}
+
+func TestIsSchemaImportPayloadApplied(t *testing.T) {
+	payload := map[string]interface{}{
+		"displayName": "exported_schema",
+		"templates": []interface{}{map[string]interface{}{
+			"name": "Template1",
+			"bds": []interface{}{map[string]interface{}{
+				"name":   "BD1",
+				"vrfRef": "/schemas/5c4d5bb72700000401f80948/templates/Template1/vrfs/VRF1",
+			}},
+		}},
+	}
+	export := map[string]interface{}{
+		"displayName": "imported_schema",
+		"templates": []interface{}{map[string]interface{}{
+			"name": "Template1",
+			"bds": []interface{}{map[string]interface{}{
+				"name":   "BD1",
+				"uuid":   "bd1-uuid",
+				"vrfRef": "/schemas/6537b5fb6d4a3b0ffc8d3c30/templates/Template1/vrfs/VRF1",
+			}},
+		}},
+	}
+	if !isSchemaImportPayloadApplied(payload, export) {
+		t.Error("Expected the references to the templates of the schema to match regardless of the schema id")
+	}
+
+	export["templates"].([]interface{})[0].(map[string]interface{})["bds"].([]interface{})[0].(map[string]interface{})["vrfRef"] = "/schemas/6537b5fb6d4a3b0ffc8d3c30/templates/Template1/vrfs/VRF2"
+	if isSchemaImportPayloadApplied(payload, export) {
+		t.Error("Expected a changed reference to differ")
+	}
+}
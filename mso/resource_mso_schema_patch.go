@@ -0,0 +1,187 @@
+package mso
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var schemaPatchPathRegex = regexp.MustCompile(`^/.+`)
+
+func schemaPatchOperationSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"op": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"add",
+					"replace",
+					"remove",
+				}, false),
+			},
+			"path": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(schemaPatchPathRegex, "the path must start with a /"),
+			},
+			"value": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceMSOSchemaPatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaPatchCreate,
+		Read:   resourceMSOSchemaPatchRead,
+		Update: resourceMSOSchemaPatchUpdate,
+		Delete: resourceMSOSchemaPatchDelete,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"operation": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     schemaPatchOperationSchema(),
+			},
+			"destroy_operation": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     schemaPatchOperationSchema(),
+			},
+		}),
+	}
+}
+
+// applySchemaPatchOperations sends the configured operations to the schema in a single PATCH request.
+func applySchemaPatchOperations(msoClient *client.Client, schemaId, attribute string, operations []interface{}) error {
+	payloadCon := container.New()
+	payloadCon.Array()
+	for index, operation := range operations {
+		operationMap := operation.(map[string]interface{})
+		op := operationMap["op"].(string)
+		var value interface{}
+		if op != "remove" {
+			if operationMap["value"].(string) == "" {
+				return fmt.Errorf("%s.%d.value is required when op is '%s'", attribute, index, op)
+			}
+			err := json.Unmarshal([]byte(operationMap["value"].(string)), &value)
+			if err != nil {
+				return fmt.Errorf("Unable to parse %s.%d.value: %s", attribute, index, err)
+			}
+		}
+		err := addPatchPayloadToContainer(payloadCon, op, operationMap["path"].(string), value)
+		if err != nil {
+			return err
+		}
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+}
+
+func resourceMSOSchemaPatchCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Schema Patch: Beginning Creation")
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	operations := d.Get("operation").([]interface{})
+
+	err := applySchemaPatchOperations(msoClient, schemaId, "operation", operations)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		paths = append(paths, operation.(map[string]interface{})["path"].(string))
+	}
+	d.SetId(fmt.Sprintf("%s/patch/%d", schemaId, hashcode.String(strings.Join(paths, ","))))
+	log.Printf("[DEBUG] %s: Schema Patch Creation finished successfully", d.Id())
+
+	return resourceMSOSchemaPatchRead(d, m)
+}
+
+func resourceMSOSchemaPatchUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	if d.HasChange("operation") {
+		err := applySchemaPatchOperations(m.(*client.Client), d.Get("schema_id").(string), "operation", d.Get("operation").([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Schema Patch Update finished successfully", d.Id())
+	return resourceMSOSchemaPatchRead(d, m)
+}
+
+// resourceMSOSchemaPatchRead sets the value of every operation to the current value of its path in the schema, so changes made outside of Terraform show as a diff.
+// The value of an add or replace operation is empty when the path no longer exists, the value of a remove operation is set when the path exists again.
+func resourceMSOSchemaPatchRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
+	}
+
+	operations := d.Get("operation").([]interface{})
+	for _, operation := range operations {
+		operationMap := operation.(map[string]interface{})
+		segments := splitPatchPath(operationMap["path"].(string))
+		// Appended list elements have no path by which they can be found again.
+		if segments[len(segments)-1] == "-" {
+			continue
+		}
+		value, ok := getPatchPathValue(schemaCont.Data(), segments)
+		if !ok {
+			operationMap["value"] = ""
+			continue
+		}
+		valueJson, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		operationMap["value"] = string(valueJson)
+	}
+	d.Set("operation", operations)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSchemaPatchDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+
+	if operations := d.Get("destroy_operation").([]interface{}); len(operations) > 0 {
+		err := applySchemaPatchOperations(m.(*client.Client), d.Get("schema_id").(string), "destroy_operation", operations)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -1,12 +1,13 @@
 package mso
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
-	"github.com/ciscoecosystem/mso-go-client/container"
 	"github.com/ciscoecosystem/mso-go-client/models"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -26,6 +27,10 @@ func resourceMSOSchemaSite() *schema.Resource {
 
 		SchemaVersion: version,
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: (map[string]*schema.Schema{
 			"schema_id": &schema.Schema{
 				Type:         schema.TypeString,
@@ -86,7 +91,7 @@ func resourceMSOSchemaSiteImport(d *schema.ResourceData, m interface{}) ([]*sche
 	dataCon := con.S("sites").Index(count)
 	stateSiteId := models.StripQuotes(dataCon.S("id").String())
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +140,7 @@ func resourceMSOSchemaSiteCreate(d *schema.ResourceData, m interface{}) error {
 
 	schemasite := models.NewSchemaSite("add", "/sites/-", siteId, templateName)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemasite)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemasite)
 	if err != nil {
 		return err
 	}
@@ -150,14 +155,14 @@ func resourceMSOSchemaSiteRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	stateSiteId := d.Get("site_id").(string)
@@ -205,36 +210,19 @@ func resourceMSOSchemaSiteDelete(d *schema.ResourceData, m interface{}) error {
 	siteId := d.Get("site_id").(string)
 	templateName := d.Get("template_name").(string)
 
-	versionInt, err := msoClient.CompareVersion("3.7.0.0")
-
-	if d.Get("undeploy_on_destroy").(bool) && versionInt == -1 {
-		payload, err := container.ParseJSON([]byte(fmt.Sprintf(`{"schemaId": "%s", "templateName": "%s", "undeploy": ["%s"]}`, schemaId, templateName, siteId)))
-		if err != nil {
-			log.Printf("[DEBUG] Parse of JSON failed with err: %s.", err)
-			return err
-		}
-		req, err := msoClient.MakeRestRequest("POST", "api/v1/task", payload, true)
-		if err != nil {
-			log.Printf("[DEBUG] MakeRestRequest failed with err: %s.", err)
-			return err
-		}
-		_, resp, err := msoClient.Do(req)
-		if err != nil || resp.StatusCode != 202 {
-			log.Printf("[DEBUG] Request failed with resp: %v. Err: %s.", resp, err)
-			return err
-		}
-	} else if d.Get("undeploy_on_destroy").(bool) && err == nil {
-		_, err := msoClient.GetViaURL(fmt.Sprintf("/api/v1/execute/schema/%s/template/%s?undeploy=%s", schemaId, templateName, siteId))
+	// The site is only disassociated after the undeploy completed, otherwise its objects remain on the fabric.
+	if d.Get("undeploy_on_destroy").(bool) {
+		ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutDelete))
+		defer cancel()
+		err := undeployTemplateFromSite(ctx, msoClient, schemaId, templateName, siteId)
 		if err != nil {
 			return err
 		}
-	} else if d.Get("undeploy_on_destroy").(bool) {
-		log.Printf("[WARNING] Failed to compare version. Template could not be undeployed prior to schema site deletion. Err: %s.", err)
 	}
 
 	schemasite := models.NewSchemaSite("remove", fmt.Sprintf("/sites/%s-%s", siteId, templateName), siteId, templateName)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemasite)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemasite)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -59,7 +59,7 @@ func resourceMSOSchemaSiteAnpImport(d *schema.ResourceData, m interface{}) ([]*s
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -139,13 +139,13 @@ func resourceMSOSchemaSiteAnpCreate(d *schema.ResourceData, m interface{}) error
 	if versionInt != 1 {
 		path := fmt.Sprintf("/sites/%s-%s/anps/%s", siteId, templateName, anpName)
 		anpStruct := models.NewSchemaSiteAnp("replace", path, anpRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 	}
 
 	if versionInt == 1 || err != nil {
 		path := fmt.Sprintf("/sites/%s-%s/anps/-", siteId, templateName)
 		anpStruct := models.NewSchemaSiteAnp("add", path, anpRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 	}
 
 	if err != nil {
@@ -162,13 +162,13 @@ func resourceMSOSchemaSiteAnpRead(d *schema.ResourceData, m interface{}) error {
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	found := false
@@ -183,7 +183,7 @@ func resourceMSOSchemaSiteAnpRead(d *schema.ResourceData, m interface{}) error {
 		if apiSite == stateSite {
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -235,7 +235,7 @@ func resourceMSOSchemaSiteAnpDelete(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s", siteId, templateName, anpName)
 	anpStruct := models.NewSchemaSiteAnp("remove", path, anpRefMap)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
 	"github.com/ciscoecosystem/mso-go-client/models"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -69,7 +70,7 @@ func resourceMSOSchemaSiteAnpEpgImport(d *schema.ResourceData, m interface{}) ([
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -179,13 +180,13 @@ func resourceMSOSchemaSiteAnpEpgCreate(d *schema.ResourceData, m interface{}) er
 	if versionInt != 1 {
 		path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s", siteId, templateName, anpName, epgName)
 		anpEpgStruct := models.NewSchemaSiteAnpEpg("replace", path, privateLinkLabel, anpEpgRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 	}
 
 	if versionInt == 1 || err != nil {
 		path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/-", siteId, templateName, anpName)
 		anpEpgStruct := models.NewSchemaSiteAnpEpg("add", path, privateLinkLabel, anpEpgRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 	}
 
 	if err != nil {
@@ -202,13 +203,13 @@ func resourceMSOSchemaSiteAnpEpgRead(d *schema.ResourceData, m interface{}) erro
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -226,7 +227,7 @@ func resourceMSOSchemaSiteAnpEpgRead(d *schema.ResourceData, m interface{}) erro
 		if apiSite == stateSite {
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -239,7 +240,7 @@ func resourceMSOSchemaSiteAnpEpgRead(d *schema.ResourceData, m interface{}) erro
 				if apiAnp == stateAnp {
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -256,6 +257,12 @@ func resourceMSOSchemaSiteAnpEpgRead(d *schema.ResourceData, m interface{}) erro
 							d.Set("template_name", split[4])
 							d.Set("anp_name", split[6])
 							d.Set("epg_name", apiEPG)
+							privatelinklabelsCont := epgCont.S("privateLinkLabel")
+							if models.StripQuotes(privatelinklabelsCont.S("name").String()) == "{}" {
+								d.Set("private_link_label", "")
+							} else {
+								d.Set("private_link_label", models.StripQuotes(privatelinklabelsCont.S("name").String()))
+							}
 							found = true
 							break
 						}
@@ -289,26 +296,27 @@ func resourceMSOSchemaSiteAnpEpgUpdate(d *schema.ResourceData, m interface{}) er
 	anpName := d.Get("anp_name").(string)
 	epgName := d.Get("epg_name").(string)
 
-	anpEpgRefMap := make(map[string]interface{})
-	anpEpgRefMap["schemaId"] = schemaId
-	anpEpgRefMap["templateName"] = templateName
-	anpEpgRefMap["anpName"] = anpName
-	anpEpgRefMap["epgName"] = epgName
-
-	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s", siteId, templateName, anpName, epgName)
-
-	privateLinkLabel := make(map[string]interface{})
-	if val, ok := d.GetOk("private_link_label"); ok {
-		map_private_link_label := make(map[string]interface{})
-		map_private_link_label["name"] = val
-		privateLinkLabel = map_private_link_label
-	} else {
-		privateLinkLabel = nil
-	}
-	anpEpgStruct := models.NewSchemaSiteAnpEpg("replace", path, privateLinkLabel, anpEpgRefMap)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
-	if err != nil {
-		return err
+	// Only the private link label is replaced, so the static ports, domains and other children of the site EPG are kept.
+	if d.HasChange("private_link_label") {
+		path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/privateLinkLabel", siteId, templateName, anpName, epgName)
+		oldLabel, newLabel := d.GetChange("private_link_label")
+		payloadCon := container.New()
+		payloadCon.Array()
+		var err error
+		if newLabel.(string) == "" {
+			err = addPatchPayloadToContainer(payloadCon, "remove", path, nil)
+		} else if oldLabel.(string) == "" {
+			err = addPatchPayloadToContainer(payloadCon, "add", path, map[string]interface{}{"name": newLabel})
+		} else {
+			err = addPatchPayloadToContainer(payloadCon, "replace", path, map[string]interface{}{"name": newLabel})
+		}
+		if err != nil {
+			return err
+		}
+		err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+		if err != nil {
+			return err
+		}
 	}
 
 	return resourceMSOSchemaSiteAnpEpgRead(d, m)
@@ -340,7 +348,7 @@ func resourceMSOSchemaSiteAnpEpgDelete(d *schema.ResourceData, m interface{}) er
 		privateLinkLabel = nil
 	}
 	anpEpgStruct := models.NewSchemaSiteAnpEpg("remove", path, privateLinkLabel, anpEpgRefMap)
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
 		return err
@@ -125,7 +125,55 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPort() *schema.Resource {
 				},
 				Required: true,
 			},
+			"validate_vlan": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the static port vlans against the VLAN pools of the EPG domains and the static ports of other EPGs during plan.",
+			},
+			"validate_vlan_severity": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "error",
+				ValidateFunc: validation.StringInSlice([]string{
+					"error",
+					"warning",
+				}, false),
+			},
 		}),
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.NewValueKnown("static_ports") {
+				for _, val := range diff.Get("static_ports").([]interface{}) {
+					staticPort := val.(map[string]interface{})
+					err := validateStaticPortPath(staticPort["path_type"].(string), staticPort["pod"].(string), staticPort["leaf"].(string), staticPort["path"].(string), staticPort["fex"].(string))
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if !diff.Get("validate_vlan").(bool) {
+				return nil
+			}
+			for _, attribute := range []string{"schema_id", "site_id", "template_name", "anp_name", "epg_name", "static_ports"} {
+				if !diff.NewValueKnown(attribute) {
+					return nil
+				}
+			}
+			if diff.Id() != "" && !diff.HasChange("static_ports") && !diff.HasChange("validate_vlan") && !diff.HasChange("validate_vlan_severity") {
+				return nil
+			}
+			staticPorts := make([]staticPortVlan, 0, 1)
+			for _, val := range diff.Get("static_ports").([]interface{}) {
+				staticPort := val.(map[string]interface{})
+				staticPorts = append(staticPorts, staticPortVlan{
+					path: getStaticPortPath(staticPort["path_type"].(string), staticPort["pod"].(string), staticPort["leaf"].(string), staticPort["path"].(string), staticPort["fex"].(string)),
+					vlan: staticPort["vlan"].(int),
+				})
+			}
+			err := validateStaticPortVlans(v.(*client.Client), diff.Get("schema_id").(string), diff.Get("site_id").(string), diff.Get("template_name").(string), diff.Get("anp_name").(string), diff.Get("epg_name").(string), staticPorts)
+			return staticPortVlanValidationResult(err, diff.Get("validate_vlan_severity").(string))
+		},
 	}
 }
 
@@ -219,6 +267,8 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortImport(d *schema.ResourceData, m i
 		staticPortsList = append(staticPortsList, staticPortMap)
 	}
 	d.Set("static_ports", staticPortsList)
+	d.Set("validate_vlan", false)
+	d.Set("validate_vlan_severity", "error")
 
 	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
 	return []*schema.ResourceData{d}, nil
@@ -324,7 +374,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortCreate(d *schema.ResourceData, m i
 		pathAnp := fmt.Sprintf("/sites/%s-%s/anps/-", siteId, templateName)
 		anpStruct := models.NewSchemaSiteAnp("add", pathAnp, anpRefMap)
 
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+		_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 		if err != nil {
 			return err
 		}
@@ -342,7 +392,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortCreate(d *schema.ResourceData, m i
 		//private_link_label argument used in resource site_anp_epg is set to nil here
 		anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-		_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+		_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 		if ers != nil {
 			return ers
 		}
@@ -350,7 +400,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortCreate(d *schema.ResourceData, m i
 
 	pathsp := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts", siteId, templateName, anp, epg)
 	staticStruct := models.NewSchemaSiteAnpEpgBulkStaticPort("add", pathsp, staticPortsList)
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
 	if errs != nil {
 		return errs
 	}
@@ -375,7 +425,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortRead(d *schema.ResourceData, m int
 
 	siteCont, err := getSiteFromSiteIdAndTemplate(schemaId, siteId, templateName, msoClient)
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), nil, d)
 	} else {
 		d.Set("site_id", siteId)
 		d.Set("template_name", templateName)
@@ -383,21 +433,21 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortRead(d *schema.ResourceData, m int
 
 	anpCont, err := getSiteAnp(anp, siteCont)
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), nil, d)
 	} else {
 		d.Set("anp_name", anp)
 	}
 
 	epgCont, err := getSiteEpg(epg, anpCont)
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), nil, d)
 	} else {
 		d.Set("epg_name", epg)
 	}
 
 	portCount, err := epgCont.ArrayCount("staticPorts")
 	if err != nil {
-		return fmt.Errorf("Unable to get Static Port list")
+		return errorForObjectNotFound(fmt.Errorf("Unable to get Static Port list"), d.Id(), nil, d)
 	}
 
 	portPath := regexp.MustCompile(`(topology\/(?P<podValue>.*)\/paths-(?P<leafValue>.*)\/extpaths-(?P<fexValue>.*)\/pathep-\[(?P<pathValue>.*)\])`)
@@ -540,7 +590,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortUpdate(d *schema.ResourceData, m i
 
 	pathsp := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts", siteId, templateName, anp, epg)
 	staticStruct := models.NewSchemaSiteAnpEpgBulkStaticPort("replace", pathsp, staticPortsList)
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
 	if errs != nil {
 		return errs
 	}
@@ -634,7 +684,7 @@ func resourceMSOSchemaSiteAnpEpgBulkStaticPortDelete(d *schema.ResourceData, m i
 
 	pathsp := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts", siteId, templateName, anp, epg)
 	staticStruct := models.NewSchemaSiteAnpEpgBulkStaticPort("remove", pathsp, staticPortsList)
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
 
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
 		return err
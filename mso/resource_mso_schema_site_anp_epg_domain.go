@@ -274,7 +274,7 @@ func resourceMSOSchemaSiteAnpEpgDomainImport(d *schema.ResourceData, m interface
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -636,7 +636,7 @@ func resourceMSOSchemaSiteAnpEpgDomainCreate(d *schema.ResourceData, m interface
 
 	foundAnp := false
 	foundEpg := false
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -703,7 +703,7 @@ func resourceMSOSchemaSiteAnpEpgDomainCreate(d *schema.ResourceData, m interface
 						//private_link_label argument used in resource site_anp_epg is set to nil here
 						anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-						_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+						_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 						if ers != nil {
 							return ers
 						}
@@ -724,7 +724,7 @@ func resourceMSOSchemaSiteAnpEpgDomainCreate(d *schema.ResourceData, m interface
 				pathAnp := fmt.Sprintf("/sites/%s-%s/anps/-", apiSite, apiTemplate)
 				anpStruct := models.NewSchemaSiteAnp("add", pathAnp, anpRefMap)
 
-				_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+				_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 				if err != nil {
 					return err
 				}
@@ -740,7 +740,7 @@ func resourceMSOSchemaSiteAnpEpgDomainCreate(d *schema.ResourceData, m interface
 				//private_link_label argument used in resource site_anp_epg is set to nil here
 				anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-				_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+				_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 				if ers != nil {
 					return ers
 				}
@@ -752,7 +752,7 @@ func resourceMSOSchemaSiteAnpEpgDomainCreate(d *schema.ResourceData, m interface
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/domainAssociations/-", siteId, templateName, anpName, epgName)
 	anpEpgDomainStruct := models.NewSchemaSiteAnpEpgDomain("add", path, domainType, DN, deployImmediacy, resolutionImmediacy, vmmDomainPropertiesRefMap)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
 	if errs != nil {
 		return errs
 	}
@@ -768,13 +768,13 @@ func resourceMSOSchemaSiteAnpEpgDomainRead(d *schema.ResourceData, m interface{}
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -825,7 +825,7 @@ func resourceMSOSchemaSiteAnpEpgDomainRead(d *schema.ResourceData, m interface{}
 		if apiSite == stateSite && apiTemplate == stateTemplate {
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -838,7 +838,7 @@ func resourceMSOSchemaSiteAnpEpgDomainRead(d *schema.ResourceData, m interface{}
 				if apiAnp == stateAnp {
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -856,7 +856,7 @@ func resourceMSOSchemaSiteAnpEpgDomainRead(d *schema.ResourceData, m interface{}
 
 							domainCount, err := epgCont.ArrayCount("domainAssociations")
 							if err != nil {
-								return fmt.Errorf("Unable to get Domain Associations list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Domain Associations list"), d.Id(), cont, d)
 							}
 							for l := 0; l < domainCount; l++ {
 								domainCont, err := epgCont.ArrayElement(l, "domainAssociations")
@@ -1156,7 +1156,7 @@ func resourceMSOSchemaSiteAnpEpgDomainUpdate(d *schema.ResourceData, m interface
 		log.Print("Passing Blank Value to the Model")
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -1165,14 +1165,14 @@ func resourceMSOSchemaSiteAnpEpgDomainUpdate(d *schema.ResourceData, m interface
 		return err
 	}
 	if index == -1 {
-		fmt.Errorf("The given Anp Epg Domain is not found")
+		return fmt.Errorf("The given Anp Epg Domain is not found")
 	}
 	indexs := strconv.Itoa(index)
 
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/domainAssociations/%s", siteId, templateName, anpName, epgName, indexs)
 	anpEpgDomainStruct := models.NewSchemaSiteAnpEpgDomain("replace", path, domainType, DN, deployImmediacy, resolutionImmediacy, vmmDomainPropertiesRefMap)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
 	if errs != nil {
 		return errs
 	}
@@ -1321,7 +1321,7 @@ func resourceMSOSchemaSiteAnpEpgDomainDelete(d *schema.ResourceData, m interface
 		log.Print("Passing Blank Value to the Model")
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -1338,7 +1338,7 @@ func resourceMSOSchemaSiteAnpEpgDomainDelete(d *schema.ResourceData, m interface
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/domainAssociations/%s", siteId, templateName, anpName, epgName, indexs)
 	anpEpgDomainStruct := models.NewSchemaSiteAnpEpgDomain("remove", path, domainType, DN, deployImmediacy, resolutionImmediacy, vmmDomainPropertiesRefMap)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgDomainStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
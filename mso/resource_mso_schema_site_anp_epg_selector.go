@@ -116,7 +116,7 @@ func resourceSchemaSiteApnEpgSelectorImport(d *schema.ResourceData, m interface{
 	epgName := get_attribute[8]
 	name := get_attribute[10]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +277,7 @@ func resourceSchemaSiteApnEpgSelectorCreate(d *schema.ResourceData, m interface{
 
 	schemasiteanpepgselector := models.NewSchemaTemplateAnpEpgSelector("add", path, schemasiteanpepgselectorMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
 	if err != nil {
 		return err
 	}
@@ -328,7 +328,7 @@ func resourceSchemaSiteApnEpgSelectorUpdate(d *schema.ResourceData, m interface{
 	schemasiteanpepgselectorMap["name"] = name
 	schemasiteanpepgselectorMap["expressions"] = expList
 
-	contGet, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	contGet, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -345,7 +345,7 @@ func resourceSchemaSiteApnEpgSelectorUpdate(d *schema.ResourceData, m interface{
 
 	schemasiteanpepgselector := models.NewSchemaTemplateAnpEpgSelector("replace", path, schemasiteanpepgselectorMap)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
 	if err != nil {
 		return err
 	}
@@ -365,14 +365,14 @@ func resourceSchemaSiteApnEpgSelectorRead(d *schema.ResourceData, m interface{})
 	anpName := d.Get("anp_name").(string)
 	epgName := d.Get("epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, dn, cont, d)
 	}
 
 	siteCount, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	for i := 0; i < siteCount; i++ {
@@ -387,7 +387,7 @@ func resourceSchemaSiteApnEpgSelectorRead(d *schema.ResourceData, m interface{})
 		if currentTemp == template && currentSite == siteID {
 			anpCount, err := siteCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("No Anp found")
+				return errorForObjectNotFound(fmt.Errorf("No Anp found"), d.Id(), cont, d)
 			}
 
 			for j := 0; j < anpCount; j++ {
@@ -402,7 +402,7 @@ func resourceSchemaSiteApnEpgSelectorRead(d *schema.ResourceData, m interface{})
 				if currentAnpName == anpName {
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("No Epg found")
+						return errorForObjectNotFound(fmt.Errorf("No Epg found"), d.Id(), cont, d)
 					}
 
 					for k := 0; k < epgCount; k++ {
@@ -417,7 +417,7 @@ func resourceSchemaSiteApnEpgSelectorRead(d *schema.ResourceData, m interface{})
 						if currentEpgName == epgName {
 							selectorCount, err := epgCont.ArrayCount("selectors")
 							if err != nil {
-								return fmt.Errorf("No selectors found")
+								return errorForObjectNotFound(fmt.Errorf("No selectors found"), d.Id(), cont, d)
 							}
 
 							for s := 0; s < selectorCount; s++ {
@@ -521,7 +521,7 @@ func resourceSchemaSiteApnEpgSelectorDelete(d *schema.ResourceData, m interface{
 	schemasiteanpepgselectorMap["name"] = name
 	schemasiteanpepgselectorMap["expressions"] = expList
 
-	contGet, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	contGet, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -539,7 +539,7 @@ func resourceSchemaSiteApnEpgSelectorDelete(d *schema.ResourceData, m interface{
 
 	schemasiteanpepgselector := models.NewSchemaTemplateAnpEpgSelector("remove", path, schemasiteanpepgselectorMap)
 
-	response, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
+	response, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemasiteanpepgselector)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err1 != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -78,7 +78,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafImport(d *schema.ResourceData, m inter
 	import_attribute := regexp.MustCompile("(.*)/path/(.*)")
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +188,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafCreate(d *schema.ResourceData, m inter
 
 	foundAnp := false
 	foundEpg := false
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -254,7 +254,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafCreate(d *schema.ResourceData, m inter
 						//private_link_label argument used in resource site_anp_epg is set to nil here
 						anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-						_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+						_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 						if ers != nil {
 							return ers
 						}
@@ -275,7 +275,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafCreate(d *schema.ResourceData, m inter
 				pathAnp := fmt.Sprintf("/sites/%s-%s/anps/-", apiSite, apiTemplate)
 				anpStruct := models.NewSchemaSiteAnp("add", pathAnp, anpRefMap)
 
-				_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+				_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 				if err != nil {
 					return err
 				}
@@ -291,7 +291,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafCreate(d *schema.ResourceData, m inter
 				//private_link_label argument used in resource site_anp_epg is set to nil here
 				anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-				_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+				_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 				if ers != nil {
 					return ers
 				}
@@ -304,7 +304,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafCreate(d *schema.ResourceData, m inter
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticLeafs/-", siteId, templateName, anpName, epgName)
 	anpEpgStaticStruct := models.NewSchemaSiteAnpEpgStaticleaf("add", path, paths, portEncapVlan)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStaticStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStaticStruct)
 	if errs != nil {
 		return errs
 	}
@@ -318,13 +318,13 @@ func resourceMSOSchemaSiteAnpEpgStaticleafRead(d *schema.ResourceData, m interfa
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -343,7 +343,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafRead(d *schema.ResourceData, m interfa
 		if apiSite == stateSite {
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -356,7 +356,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafRead(d *schema.ResourceData, m interfa
 				if apiAnp == stateAnp {
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -369,7 +369,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafRead(d *schema.ResourceData, m interfa
 						if apiEPG == stateEpg {
 							staticLeafCount, err := epgCont.ArrayCount("staticLeafs")
 							if err != nil {
-								return fmt.Errorf("Unable to get Static Leaf list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Static Leaf list"), d.Id(), cont, d)
 							}
 							for s := 0; s < staticLeafCount; s++ {
 								staticLeafCont, err := epgCont.ArrayElement(s, "staticLeafs")
@@ -424,7 +424,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafDelete(d *schema.ResourceData, m inter
 	epgName := d.Get("epg_name").(string)
 	paths := d.Get("path").(string)
 	portEncapVlan := d.Get("port_encap_vlan").(int)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -503,7 +503,7 @@ func resourceMSOSchemaSiteAnpEpgStaticleafDelete(d *schema.ResourceData, m inter
 	indexs := strconv.Itoa(index)
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticLeafs/%s", siteId, templateName, anpName, epgName, indexs)
 	anpEpgStaticStruct := models.NewSchemaSiteAnpEpgStaticleaf("remove", path, paths, portEncapVlan)
-	response, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStaticStruct)
+	response, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStaticStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err1 != nil && !(response.Exists("code") && response.S("code").String() == "141") {
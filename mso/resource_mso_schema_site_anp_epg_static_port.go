@@ -118,7 +118,48 @@ func resourceMSOSchemaSiteAnpEpgStaticPort() *schema.Resource {
 					"untagged",
 				}, false),
 			},
+			"validate_vlan": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the vlan against the VLAN pools of the EPG domains and the static ports of other EPGs during plan.",
+			},
+			"validate_vlan_severity": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "error",
+				ValidateFunc: validation.StringInSlice([]string{
+					"error",
+					"warning",
+				}, false),
+			},
 		}),
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if diff.NewValueKnown("path_type") && diff.NewValueKnown("pod") && diff.NewValueKnown("leaf") && diff.NewValueKnown("path") && diff.NewValueKnown("fex") {
+				err := validateStaticPortPath(diff.Get("path_type").(string), diff.Get("pod").(string), diff.Get("leaf").(string), diff.Get("path").(string), diff.Get("fex").(string))
+				if err != nil {
+					return err
+				}
+			}
+			if !diff.Get("validate_vlan").(bool) {
+				return nil
+			}
+			for _, attribute := range []string{"schema_id", "site_id", "template_name", "anp_name", "epg_name", "path_type", "pod", "leaf", "path", "vlan"} {
+				if !diff.NewValueKnown(attribute) {
+					return nil
+				}
+			}
+			if diff.Id() != "" && !diff.HasChange("vlan") && !diff.HasChange("validate_vlan") && !diff.HasChange("validate_vlan_severity") {
+				return nil
+			}
+			staticPort := staticPortVlan{
+				path: getStaticPortPath(diff.Get("path_type").(string), diff.Get("pod").(string), diff.Get("leaf").(string), diff.Get("path").(string), diff.Get("fex").(string)),
+				vlan: diff.Get("vlan").(int),
+			}
+			err := validateStaticPortVlans(v.(*client.Client), diff.Get("schema_id").(string), diff.Get("site_id").(string), diff.Get("template_name").(string), diff.Get("anp_name").(string), diff.Get("epg_name").(string), []staticPortVlan{staticPort})
+			return staticPortVlanValidationResult(err, diff.Get("validate_vlan_severity").(string))
+		},
 	}
 }
 
@@ -129,7 +170,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortImport(d *schema.ResourceData, m inter
 	import_attribute := regexp.MustCompile("(.*)/path/(.*)")
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +291,8 @@ func resourceMSOSchemaSiteAnpEpgStaticPortImport(d *schema.ResourceData, m inter
 		d.SetId("")
 		return nil, fmt.Errorf("Unable to find the static port entry")
 	}
+	d.Set("validate_vlan", false)
+	d.Set("validate_vlan_severity", "error")
 	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
 	return []*schema.ResourceData{d}, nil
 }
@@ -295,7 +338,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortCreate(d *schema.ResourceData, m inter
 		fex = tempVar.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -360,7 +403,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortCreate(d *schema.ResourceData, m inter
 						//private_link_label argument used in resource site_anp_epg is set to nil here
 						anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-						_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+						_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 						if ers != nil {
 							return ers
 						}
@@ -379,7 +422,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortCreate(d *schema.ResourceData, m inter
 				pathAnp := fmt.Sprintf("/sites/%s-%s/anps/-", stateSiteId, stateTemplateName)
 				anpStruct := models.NewSchemaSiteAnp("add", pathAnp, anpRefMap)
 
-				_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+				_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 				if err != nil {
 					return err
 				}
@@ -396,7 +439,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortCreate(d *schema.ResourceData, m inter
 				//private_link_label argument used in resource site_anp_epg is set to nil here
 				anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-				_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+				_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 				if ers != nil {
 					return ers
 				}
@@ -412,11 +455,10 @@ func resourceMSOSchemaSiteAnpEpgStaticPortCreate(d *schema.ResourceData, m inter
 		portpath = fmt.Sprintf("topology/%s/paths-%s/pathep-[%s]", pod, leaf, path)
 	}
 
-	pathsp := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts/-", stateSiteId, stateTemplateName, stateANPName, stateEpgName)
-	staticStruct := models.NewSchemaSiteAnpEpgStaticPort("add", pathsp, pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), staticStruct)
-	if errs != nil {
-		return errs
+	staticStruct := models.NewSchemaSiteAnpEpgStaticPort("add", "", pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
+	err = patchStaticPort(msoClient, schemaId, staticPortOperation{stateSiteId, stateTemplateName, stateANPName, stateEpgName, staticStruct})
+	if err != nil {
+		return err
 	}
 	return resourceMSOSchemaSiteAnpEpgStaticPortRead(d, m)
 }
@@ -428,13 +470,13 @@ func resourceMSOSchemaSiteAnpEpgStaticPortRead(d *schema.ResourceData, m interfa
 
 	schemaId := d.Get("schema_id").(string)
 	var fex, pathType string
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	found := false
@@ -463,7 +505,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortRead(d *schema.ResourceData, m interfa
 			d.Set("template_name", apiTemplate)
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -477,7 +519,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortRead(d *schema.ResourceData, m interfa
 					d.Set("anp_name", match[3])
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -492,7 +534,7 @@ func resourceMSOSchemaSiteAnpEpgStaticPortRead(d *schema.ResourceData, m interfa
 							d.Set("epg_name", apiEPG)
 							portCount, err := epgCont.ArrayCount("staticPorts")
 							if err != nil {
-								return fmt.Errorf("Unable to get Static Port list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Static Port list"), d.Id(), cont, d)
 							}
 							for l := 0; l < portCount; l++ {
 								portCont, err := epgCont.ArrayElement(l, "staticPorts")
@@ -602,95 +644,12 @@ func resourceMSOSchemaSiteAnpEpgStaticPortUpdate(d *schema.ResourceData, m inter
 		fex = tempVar.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	portpath := getStaticPortPath(pathType, pod, leaf, path, fex)
+	staticStruct := models.NewSchemaSiteAnpEpgStaticPort("replace", "", pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
+	err := patchStaticPort(msoClient, schemaId, staticPortOperation{stateSiteId, stateTemplateName, stateANPName, stateEpgName, staticStruct})
 	if err != nil {
 		return err
 	}
-	count, err := cont.ArrayCount("sites")
-	if err != nil {
-		return fmt.Errorf("No Sites found")
-	}
-
-	found := false
-
-	for i := 0; i < count; i++ {
-		tempCont, err := cont.ArrayElement(i, "sites")
-		if err != nil {
-			return err
-		}
-		apiSite := models.StripQuotes(tempCont.S("siteId").String())
-		apiTemplate := models.StripQuotes(tempCont.S("templateName").String())
-
-		if apiSite == stateSiteId && apiTemplate == stateTemplateName {
-			anpCount, err := tempCont.ArrayCount("anps")
-			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
-			}
-			for j := 0; j < anpCount; j++ {
-				anpCont, err := tempCont.ArrayElement(j, "anps")
-				if err != nil {
-					return err
-				}
-				anpRef := models.StripQuotes(anpCont.S("anpRef").String())
-				re := regexp.MustCompile("/schemas/(.*)/templates/(.*)/anps/(.*)")
-				match := re.FindStringSubmatch(anpRef)
-				if match[3] == stateANPName {
-					epgCount, err := anpCont.ArrayCount("epgs")
-					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
-					}
-					for k := 0; k < epgCount; k++ {
-						epgCont, err := anpCont.ArrayElement(k, "epgs")
-						if err != nil {
-							return err
-						}
-						apiEpgRef := models.StripQuotes(epgCont.S("epgRef").String())
-						re := regexp.MustCompile("/schemas/(.*)/templates/(.*)/epgs/(.*)")
-						match := re.FindStringSubmatch(apiEpgRef)
-						apiEPG := match[3]
-						if apiEPG == stateEpgName {
-							portCount, err := epgCont.ArrayCount("staticPorts")
-							if err != nil {
-								return fmt.Errorf("Unable to get Static Port list")
-							}
-							for l := 0; l < portCount; l++ {
-								portCont, err := epgCont.ArrayElement(l, "staticPorts")
-								if err != nil {
-									return err
-								}
-								var portpath string
-								if pathType == "port" && fex != "" {
-									portpath = fmt.Sprintf("topology/%s/paths-%s/extpaths-%s/pathep-[%s]", pod, leaf, fex, path)
-								} else if pathType == "vpc" {
-									portpath = fmt.Sprintf("topology/%s/protpaths-%s/pathep-[%s]", pod, leaf, path)
-								} else {
-									portpath = fmt.Sprintf("topology/%s/paths-%s/pathep-[%s]", pod, leaf, path)
-								}
-								apiportpath := models.StripQuotes(portCont.S("path").String())
-								if portpath == apiportpath {
-									index := l
-									path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts/%v", stateSiteId, stateTemplateName, stateANPName, stateEpgName, index)
-									anpStruct := models.NewSchemaSiteAnpEpgStaticPort("replace", path, pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
-									_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
-
-									if err != nil {
-										return err
-									}
-									found = true
-									break
-
-								}
-							}
-						}
-
-					}
-				}
-			}
-		}
-	}
-	if !found {
-		return fmt.Errorf("The specified parameters to update static port entry not found")
-	}
 
 	return resourceMSOSchemaSiteAnpEpgStaticPortRead(d, m)
 }
@@ -704,15 +663,6 @@ func resourceMSOSchemaSiteAnpEpgStaticPortDelete(d *schema.ResourceData, m inter
 	stateTemplate := d.Get("template_name").(string)
 	stateAnp := d.Get("anp_name").(string)
 	stateEpg := d.Get("epg_name").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
-	if err != nil {
-		return err
-	}
-	count, err := cont.ArrayCount("sites")
-	if err != nil {
-		return fmt.Errorf("No Sites found")
-	}
-
 	var pathType, pod, leaf, path, deploymentImmediacy, mode, fex string
 	var vlan, microsegvlan int
 
@@ -744,79 +694,11 @@ func resourceMSOSchemaSiteAnpEpgStaticPortDelete(d *schema.ResourceData, m inter
 		fex = tempVar.(string)
 	}
 
-	for i := 0; i < count; i++ {
-		tempCont, err := cont.ArrayElement(i, "sites")
-		if err != nil {
-			return err
-		}
-		apiSite := models.StripQuotes(tempCont.S("siteId").String())
-		apiTemplate := models.StripQuotes(tempCont.S("templateName").String())
-
-		if apiSite == stateSite && apiTemplate == stateTemplate {
-			anpCount, err := tempCont.ArrayCount("anps")
-			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
-			}
-			for j := 0; j < anpCount; j++ {
-				anpCont, err := tempCont.ArrayElement(j, "anps")
-				if err != nil {
-					return err
-				}
-				anpRef := models.StripQuotes(anpCont.S("anpRef").String())
-				re := regexp.MustCompile("/schemas/(.*)/templates/(.*)/anps/(.*)")
-				match := re.FindStringSubmatch(anpRef)
-				if match[3] == stateAnp {
-					epgCount, err := anpCont.ArrayCount("epgs")
-					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
-					}
-					for k := 0; k < epgCount; k++ {
-						epgCont, err := anpCont.ArrayElement(k, "epgs")
-						if err != nil {
-							return err
-						}
-						apiEpgRef := models.StripQuotes(epgCont.S("epgRef").String())
-						re := regexp.MustCompile("/schemas/(.*)/templates/(.*)/epgs/(.*)")
-						match := re.FindStringSubmatch(apiEpgRef)
-						apiEPG := match[3]
-						if apiEPG == stateEpg {
-							portCount, err := epgCont.ArrayCount("staticPorts")
-							if err != nil {
-								return fmt.Errorf("Unable to get Static Port list")
-							}
-							for l := 0; l < portCount; l++ {
-								portCont, err := epgCont.ArrayElement(l, "staticPorts")
-								if err != nil {
-									return err
-								}
-								var portpath string
-								if pathType == "port" && fex != "" {
-									portpath = fmt.Sprintf("topology/%s/paths-%s/extpaths-%s/pathep-[%s]", pod, leaf, fex, path)
-								} else if pathType == "vpc" {
-									portpath = fmt.Sprintf("topology/%s/protpaths-%s/pathep-[%s]", pod, leaf, path)
-								} else {
-									portpath = fmt.Sprintf("topology/%s/paths-%s/pathep-[%s]", pod, leaf, path)
-								}
-								apiportpath := models.StripQuotes(portCont.S("path").String())
-								if portpath == apiportpath {
-									index := l
-									path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts/%v", stateSite, stateTemplate, stateAnp, stateEpg, index)
-									anpStruct := models.NewSchemaSiteAnpEpgStaticPort("remove", path, pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
-									response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
-
-									// Ignoring Error with code 141: Resource Not Found when deleting
-									if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
-										return err
-									}
-									break
-								}
-							}
-						}
-
-					}
-				}
-			}
-		}
+	portpath := getStaticPortPath(pathType, pod, leaf, path, fex)
+	staticStruct := models.NewSchemaSiteAnpEpgStaticPort("remove", "", pathType, portpath, vlan, deploymentImmediacy, microsegvlan, mode)
+	err := patchStaticPort(msoClient, schemaId, staticPortOperation{stateSite, stateTemplate, stateAnp, stateEpg, staticStruct})
+	if err != nil {
+		return err
 	}
 	d.SetId("")
 	return resourceMSOSchemaSiteAnpEpgStaticPortRead(d, m)
@@ -108,7 +108,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetImport(d *schema.ResourceData, m interface
 	import_attribute := regexp.MustCompile("(.*)/ip/(.*)")
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -257,7 +257,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetCreate(d *schema.ResourceData, m interface
 		Primary = d.(bool)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -322,7 +322,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetCreate(d *schema.ResourceData, m interface
 						//private_link_label argument used in resource site_anp_epg is set to nil here
 						anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-						_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+						_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 						if ers != nil {
 							return ers
 						}
@@ -341,7 +341,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetCreate(d *schema.ResourceData, m interface
 				pathAnp := fmt.Sprintf("/sites/%s-%s/anps/-", stateSiteId, stateTemplateName)
 				anpStruct := models.NewSchemaSiteAnp("add", pathAnp, anpRefMap)
 
-				_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
+				_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpStruct)
 				if err != nil {
 					return err
 				}
@@ -358,7 +358,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetCreate(d *schema.ResourceData, m interface
 				//private_link_label argument used in resource site_anp_epg is set to nil here
 				anpEpgStruct := models.NewSchemaSiteAnpEpg("add", pathEpg, nil, anpEpgRefMap)
 
-				_, ers := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+				_, ers := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 				if ers != nil {
 					return ers
 				}
@@ -368,7 +368,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetCreate(d *schema.ResourceData, m interface
 
 	path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/subnets/-", stateSiteId, stateTemplateName, stateANPName, stateEpgName)
 	AnpEpgSubnetStruct := models.NewSchemaSiteAnpEpgSubnet("add", path, IP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary)
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
 	if errs != nil {
 		return errs
 	}
@@ -382,13 +382,13 @@ func resourceMSOSchemaSiteAnpEpgSubnetRead(d *schema.ResourceData, m interface{}
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	found := false
@@ -409,7 +409,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetRead(d *schema.ResourceData, m interface{}
 			d.Set("template_name", apiTemplate)
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get Anp list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Anp list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -423,7 +423,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetRead(d *schema.ResourceData, m interface{}
 					d.Set("anp_name", match[3])
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -438,7 +438,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetRead(d *schema.ResourceData, m interface{}
 							d.Set("epg_name", apiEPG)
 							subnetCount, err := epgCont.ArrayCount("subnets")
 							if err != nil {
-								return fmt.Errorf("Unable to get Subnet list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Subnet list"), d.Id(), cont, d)
 							}
 							for l := 0; l < subnetCount; l++ {
 								subnetCont, err := epgCont.ArrayElement(l, "subnets")
@@ -533,7 +533,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetUpdate(d *schema.ResourceData, m interface
 	if d, ok := d.GetOk("primary"); ok {
 		Primary = d.(bool)
 	}
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -599,7 +599,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetUpdate(d *schema.ResourceData, m interface
 									index := l
 									path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/subnets/%v", statesiteId, stateTemplateName, stateANPName, stateEpgName, index)
 									AnpEpgSubnetStruct := models.NewSchemaSiteAnpEpgSubnet("replace", path, IP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary)
-									_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
+									_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
 									if err != nil {
 										return err
 									}
@@ -637,7 +637,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetDelete(d *schema.ResourceData, m interface
 		IP = ip.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -695,7 +695,7 @@ func resourceMSOSchemaSiteAnpEpgSubnetDelete(d *schema.ResourceData, m interface
 									index := l
 									path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/subnets/%v", stateSite, stateTemplate, stateAnp, stateEpg, index)
 									AnpEpgSubnetStruct := models.GetRemovePatchPayload(path)
-									response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
+									response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), AnpEpgSubnetStruct)
 
 									// Ignoring Error with code 141: Resource Not Found when deleting
 									if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -57,9 +57,40 @@ func resourceMSOSchemaSiteBd() *schema.Resource {
 				Computed: true,
 			},
 			"svi_mac": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsMACAddress,
+				DiffSuppressFunc: suppressMacAddressDiff,
+			},
+			"dhcp_policies": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Configure the dhcp policies of the BD on the site",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"dhcp_option_policy_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"dhcp_option_policy_version": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
 			},
 		}),
 	}
@@ -70,7 +101,7 @@ func resourceMSOSchemaSiteBdImport(d *schema.ResourceData, m interface{}) ([]*sc
 
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +146,11 @@ func resourceMSOSchemaSiteBdImport(d *schema.ResourceData, m interface{}) ([]*sc
 					if bdCont.Exists("mac") {
 						d.Set("svi_mac", models.StripQuotes(bdCont.S("mac").String()))
 					}
+					dhcpPolicies, err := getSiteBdDhcpPolicies(msoClient, match[1], match[2], bdCont)
+					if err != nil {
+						return nil, err
+					}
+					d.Set("dhcp_policies", dhcpPolicies)
 					found = true
 					break
 				}
@@ -167,19 +203,36 @@ func resourceMSOSchemaSiteBdCreate(d *schema.ResourceData, m interface{}) error
 	if versionInt != 1 {
 		path := fmt.Sprintf("/sites/%s-%s/bds/%s", siteId, templateName, bdName)
 		bdStruct := models.NewSchemaSiteBd("replace", path, mac, bdRefMap, host)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
 	}
 
 	if versionInt == 1 || err != nil {
 		path := fmt.Sprintf("/sites/%s-%s/bds/-", siteId, templateName)
 		bdStruct := models.NewSchemaSiteBd("add", path, mac, bdRefMap, host)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
 	}
 
 	if err != nil {
 		return err
 	}
 
+	if dhcpPolicies, ok := d.GetOk("dhcp_policies"); ok {
+		dhcpLabels, err := getSiteBdDhcpLabels(msoClient, schemaId, templateName, dhcpPolicies.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		payloadCon := container.New()
+		payloadCon.Array()
+		err = addPatchPayloadToContainer(payloadCon, "add", fmt.Sprintf("/sites/%s-%s/bds/%s/dhcpLabels", siteId, templateName, bdName), dhcpLabels)
+		if err != nil {
+			return err
+		}
+		err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceMSOSchemaSiteBdRead(d, m)
 }
 
@@ -190,13 +243,13 @@ func resourceMSOSchemaSiteBdRead(d *schema.ResourceData, m interface{}) error {
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	stateTemplate := d.Get("template_name").(string)
@@ -213,7 +266,7 @@ func resourceMSOSchemaSiteBdRead(d *schema.ResourceData, m interface{}) error {
 		if apiSite == stateSite && apiTemplate == stateTemplate {
 			bdCount, err := tempCont.ArrayCount("bds")
 			if err != nil {
-				return fmt.Errorf("Unable to get bd list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get bd list"), d.Id(), cont, d)
 			}
 			for j := 0; j < bdCount; j++ {
 				bdCont, err := tempCont.ArrayElement(j, "bds")
@@ -235,6 +288,11 @@ func resourceMSOSchemaSiteBdRead(d *schema.ResourceData, m interface{}) error {
 					if bdCont.Exists("mac") {
 						d.Set("svi_mac", models.StripQuotes(bdCont.S("mac").String()))
 					}
+					dhcpPolicies, err := getSiteBdDhcpPolicies(msoClient, match[1], match[2], bdCont)
+					if err != nil {
+						return err
+					}
+					d.Set("dhcp_policies", dhcpPolicies)
 					found = true
 					break
 				}
@@ -300,6 +358,17 @@ func resourceMSOSchemaSiteBdUpdate(d *schema.ResourceData, m interface{}) error
 		}
 	}
 
+	if d.HasChange("dhcp_policies") {
+		dhcpLabels, err := getSiteBdDhcpLabels(msoClient, schemaId, templateName, d.Get("dhcp_policies").(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		err = addPatchPayloadToContainer(payloadCon, "add", fmt.Sprintf("/sites/%s-%s/bds/%s/dhcpLabels", siteId, templateName, bdName), dhcpLabels)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
 	if err != nil {
 		return err
@@ -339,7 +408,7 @@ func resourceMSOSchemaSiteBdDelete(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/sites/%s-%s/bds/%s", siteId, templateName, bdName)
 	bdStruct := models.NewSchemaSiteBd("remove", path, mac, bdRefMap, host)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), bdStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -348,3 +417,66 @@ func resourceMSOSchemaSiteBdDelete(d *schema.ResourceData, m interface{}) error
 	d.SetId("")
 	return nil
 }
+
+// getSiteBdDhcpLabels returns the dhcpLabels payload of the dhcp policies of a site BD.
+// The policies are referenced by UUID from NDO 4.0 onwards and by name and version before.
+func getSiteBdDhcpLabels(msoClient *client.Client, schemaId, templateName string, dhcpPolicies []interface{}) ([]interface{}, error) {
+	versionInt, err := msoClient.CompareVersion("4.0.0.0")
+	if err != nil {
+		return nil, err
+	}
+	if versionInt == -1 {
+		return mapDHCPPoliciesRefByName(schemaId, templateName, dhcpPolicies, msoClient)
+	}
+
+	dhcpLabels := make([]interface{}, 0, len(dhcpPolicies))
+	for _, dhcpPolicy := range dhcpPolicies {
+		policy := dhcpPolicy.(map[string]interface{})
+		dhcpLabel := map[string]interface{}{
+			"name":    policy["name"],
+			"version": policy["version"],
+		}
+		if policy["dhcp_option_policy_name"] != "" {
+			dhcpLabel["dhcpOptionLabel"] = map[string]interface{}{
+				"name":    policy["dhcp_option_policy_name"],
+				"version": policy["dhcp_option_policy_version"],
+			}
+		}
+		dhcpLabels = append(dhcpLabels, dhcpLabel)
+	}
+	return dhcpLabels, nil
+}
+
+// getSiteBdDhcpPolicies returns the dhcp policies of the dhcpLabels of a site BD.
+func getSiteBdDhcpPolicies(msoClient *client.Client, schemaId, templateName string, bdCont *container.Container) ([]interface{}, error) {
+	dhcpPolicies := make([]interface{}, 0)
+	dhcpCount, err := bdCont.ArrayCount("dhcpLabels")
+	if err != nil || dhcpCount == 0 {
+		return dhcpPolicies, nil
+	}
+
+	versionInt, err := msoClient.CompareVersion("4.0.0.0")
+	if err != nil {
+		return nil, err
+	}
+	if versionInt == -1 {
+		return getDHCPPolicesNameByRef(dhcpCount, schemaId, templateName, bdCont, msoClient)
+	}
+
+	for i := 0; i < dhcpCount; i++ {
+		dhcpLabel, err := bdCont.ArrayElement(i, "dhcpLabels")
+		if err != nil {
+			return nil, err
+		}
+		dhcpPolicy := map[string]interface{}{
+			"name":    models.StripQuotes(dhcpLabel.S("name").String()),
+			"version": convertInterfaceToInt(dhcpLabel.S("version").Data()),
+		}
+		if dhcpLabel.Exists("dhcpOptionLabel") {
+			dhcpPolicy["dhcp_option_policy_name"] = convertInterfaceToString(dhcpLabel.S("dhcpOptionLabel", "name").Data())
+			dhcpPolicy["dhcp_option_policy_version"] = convertInterfaceToInt(dhcpLabel.S("dhcpOptionLabel", "version").Data())
+		}
+		dhcpPolicies = append(dhcpPolicies, dhcpPolicy)
+	}
+	return dhcpPolicies, nil
+}
@@ -65,7 +65,7 @@ func resourceMSOSchemaSiteBdL3outImport(d *schema.ResourceData, m interface{}) (
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +148,7 @@ func resourceMSOSchemaSiteBdL3outCreate(d *schema.ResourceData, m interface{}) e
 	path := fmt.Sprintf("/sites/%s-%s/bds/%s/l3Outs/-", siteId, templateName, bdName)
 	BdL3outStruct := models.NewSchemaSiteBdL3out("add", path, l3outName)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), BdL3outStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), BdL3outStruct)
 	if err != nil {
 		return err
 	}
@@ -162,13 +162,13 @@ func resourceMSOSchemaSiteBdL3outRead(d *schema.ResourceData, m interface{}) err
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -186,7 +186,7 @@ func resourceMSOSchemaSiteBdL3outRead(d *schema.ResourceData, m interface{}) err
 		if apiSite == stateSite {
 			bdCount, err := tempCont.ArrayCount("bds")
 			if err != nil {
-				return fmt.Errorf("Unable to get Bd list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Bd list"), d.Id(), cont, d)
 			}
 			for j := 0; j < bdCount; j++ {
 				bdCont, err := tempCont.ArrayElement(j, "bds")
@@ -203,7 +203,7 @@ func resourceMSOSchemaSiteBdL3outRead(d *schema.ResourceData, m interface{}) err
 					d.Set("bd_name", split[6])
 					l3outCount, err := bdCont.ArrayCount("l3Outs")
 					if err != nil {
-						return fmt.Errorf("Unable to get l3Outs list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get l3Outs list"), d.Id(), cont, d)
 					}
 					for k := 0; k < l3outCount; k++ {
 						l3outCont, err := bdCont.ArrayElement(k, "l3Outs")
@@ -250,7 +250,7 @@ func resourceMSOSchemaSiteBdL3outDelete(d *schema.ResourceData, m interface{}) e
 	l3outName := d.Get("l3out_name").(string)
 
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -267,7 +267,7 @@ func resourceMSOSchemaSiteBdL3outDelete(d *schema.ResourceData, m interface{}) e
 	path := fmt.Sprintf("/sites/%s-%s/bds/%s/l3Outs/%s", siteId, templateName, bdName, indexs)
 	BdL3outStruct := models.NewSchemaSiteBdL3out("remove", path, l3outName)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), BdL3outStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), BdL3outStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
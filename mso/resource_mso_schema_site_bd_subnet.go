@@ -106,8 +106,16 @@ func resourceMSOSchemaSiteBdSubnetImport(d *schema.ResourceData, m interface{})
 	get_attribute := strings.Split(d.Id(), "/")
 	import_attribute := regexp.MustCompile("(.*)/ip/(.*)")
 	import_split := import_attribute.FindStringSubmatch(d.Id())
+	if len(import_split) != 3 || len(get_attribute) < 5 {
+		return nil, fmt.Errorf("Invalid import id %s, the expected format is {schema_id}/site/{site_id}/template/{template_name}/bd/{bd_name}/ip/{ip}", d.Id())
+	}
+	// Both the documented {schema_id}/site/{site_id}/template/{template_name}/bd/{bd_name}/ip/{ip} format and the
+	// {schema_id}/{site_id}/{template_name}/{bd_name}/ip/{ip} format of earlier releases are supported.
+	if len(get_attribute) >= 8 && get_attribute[1] == "site" && get_attribute[3] == "template" && get_attribute[5] == "bd" {
+		get_attribute = []string{get_attribute[0], get_attribute[2], get_attribute[4], get_attribute[6]}
+	}
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -245,15 +253,63 @@ func resourceMSOSchemaSiteBdSubnetCreate(d *schema.ResourceData, m interface{})
 		Virtual = d.(bool)
 	}
 
+	err := validateSiteBdSubnet(msoClient, schemaId, stateTemplateName, stateBd, IP)
+	if err != nil {
+		return err
+	}
+
 	path := fmt.Sprintf("/sites/%s-%s/bds/%s/subnets/-", statesiteId, stateTemplateName, stateBd)
 	BdSubnetStruct := models.NewSchemaSiteBdSubnet("add", path, IP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary, Virtual)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), BdSubnetStruct)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), BdSubnetStruct)
 	if err != nil {
 		return err
 	}
 	return resourceMSOSchemaSiteBdSubnetRead(d, m)
 }
 
+// validateSiteBdSubnet checks that the template BD is not stretched and does not have the subnet, site level subnets are only supported for site local BDs.
+func validateSiteBdSubnet(msoClient *client.Client, schemaId, templateName, bdName, ip string) error {
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+	templateCount, _ := cont.ArrayCount("templates")
+	for i := 0; i < templateCount; i++ {
+		templateCont, err := cont.ArrayElement(i, "templates")
+		if err != nil {
+			return err
+		}
+		if models.StripQuotes(templateCont.S("name").String()) != templateName {
+			continue
+		}
+		bdCount, _ := templateCont.ArrayCount("bds")
+		for j := 0; j < bdCount; j++ {
+			bdCont, err := templateCont.ArrayElement(j, "bds")
+			if err != nil {
+				return err
+			}
+			if models.StripQuotes(bdCont.S("name").String()) != bdName {
+				continue
+			}
+			if l2Stretch, ok := bdCont.S("l2Stretch").Data().(bool); ok && l2Stretch {
+				return fmt.Errorf("BD %s of template %s has layer2_stretch enabled, the subnets of a stretched BD must be configured in the template with mso_schema_template_bd_subnet", bdName, templateName)
+			}
+			subnetCount, _ := bdCont.ArrayCount("subnets")
+			for l := 0; l < subnetCount; l++ {
+				subnetCont, err := bdCont.ArrayElement(l, "subnets")
+				if err != nil {
+					return err
+				}
+				if models.StripQuotes(subnetCont.S("ip").String()) == ip {
+					return fmt.Errorf("Subnet %s is already configured on BD %s of template %s", ip, bdName, templateName)
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
 func resourceMSOSchemaSiteBdSubnetRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 
@@ -261,13 +317,13 @@ func resourceMSOSchemaSiteBdSubnetRead(d *schema.ResourceData, m interface{}) er
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	found := false
@@ -287,7 +343,7 @@ func resourceMSOSchemaSiteBdSubnetRead(d *schema.ResourceData, m interface{}) er
 			d.Set("template_name", apiTemplate)
 			bdCount, err := tempCont.ArrayCount("bds")
 			if err != nil {
-				return fmt.Errorf("Unable to get Bd list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Bd list"), d.Id(), cont, d)
 			}
 			for j := 0; j < bdCount && !found; j++ {
 				bdCont, err := tempCont.ArrayElement(j, "bds")
@@ -301,7 +357,7 @@ func resourceMSOSchemaSiteBdSubnetRead(d *schema.ResourceData, m interface{}) er
 					d.Set("bd_name", match[3])
 					subnetCount, err := bdCont.ArrayCount("subnets")
 					if err != nil {
-						return fmt.Errorf("Unable to get Subnet list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Subnet list"), d.Id(), cont, d)
 					}
 					for l := 0; l < subnetCount; l++ {
 						subnetCont, err := bdCont.ArrayElement(l, "subnets")
@@ -400,7 +456,7 @@ func resourceMSOSchemaSiteBdSubnetUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	index := -1
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -451,7 +507,7 @@ func resourceMSOSchemaSiteBdSubnetUpdate(d *schema.ResourceData, m interface{})
 							index = l
 							path := fmt.Sprintf("/sites/%s-%s/bds/%s/subnets/%v", statesiteId, stateTemplateName, stateBd, index)
 							BdSubnetStruct := models.NewSchemaSiteBdSubnet("replace", path, IP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary, Virtual)
-							_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), BdSubnetStruct)
+							_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), BdSubnetStruct)
 							if err != nil {
 								return err
 							}
@@ -483,7 +539,7 @@ func resourceMSOSchemaSiteBdSubnetDelete(d *schema.ResourceData, m interface{})
 		IP = ip.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -525,7 +581,7 @@ func resourceMSOSchemaSiteBdSubnetDelete(d *schema.ResourceData, m interface{})
 						apiIP := models.StripQuotes(subnetCont.S("ip").String())
 						if IP == apiIP {
 							path := fmt.Sprintf("/sites/%s-%s/bds/%s/subnets/%v", stateSite, stateTemplate, stateBd, l)
-							response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
+							response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
 
 							// Ignoring Error with code 141: Resource Not Found when deleting
 							if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
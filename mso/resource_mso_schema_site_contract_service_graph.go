@@ -128,7 +128,7 @@ func resourceMSOSchemaSiteContractServiceGraphImport(d *schema.ResourceData, m i
 	d.Set("template_name", serviceGraphTokens[4])
 	d.Set("contract_name", serviceGraphTokens[6])
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", serviceGraphTokens[0]))
+	cont, err := getSchemaCont(msoClient, serviceGraphTokens[0])
 	if err != nil {
 		return nil, err
 	}
@@ -166,14 +166,14 @@ func resourceMSOSchemaSiteContractServiceGraphRead(d *schema.ResourceData, m int
 	log.Printf("[DEBUG] Begining Read Site Template Contract Service Graph")
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	err = setSiteContractServiceGraphAttrs(cont, d)
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	log.Printf("[DEBUG] Completed Read Site Template Contract Service Graph")
 	return nil
@@ -187,7 +187,7 @@ func resourceMSOSchemaSiteContractServiceGraphDelete(d *schema.ResourceData, m i
 	templateName := d.Get("template_name").(string)
 	contractName := d.Get("contract_name").(string)
 	sitePath := fmt.Sprintf("/sites/%s-%s/contracts/%s/serviceGraphRelationship", siteID, templateName, contractName)
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(sitePath))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(sitePath))
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -547,7 +547,7 @@ func postSiteContractServiceGraphConfig(ops string, d *schema.ResourceData, m in
 		serviceGraphRef["templateName"] = templateName
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", serviceGraphRef["schemaId"].(string)))
+	cont, err := getSchemaCont(msoClient, serviceGraphRef["schemaId"].(string))
 	if err != nil {
 		return err
 	}
@@ -575,7 +575,7 @@ func postSiteContractServiceGraphConfig(ops string, d *schema.ResourceData, m in
 	sitePath := fmt.Sprintf("/sites/%s-%s/contracts/%s/serviceGraphRelationship", siteID, templateName, contractName)
 	siteContractServiceGraphObject := models.NewSiteContractServiceGraph(ops, sitePath, serviceGraphRef, siteNodes)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), siteContractServiceGraphObject)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), siteContractServiceGraphObject)
 	if err != nil {
 		return err
 	}
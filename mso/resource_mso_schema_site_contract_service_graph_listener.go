@@ -694,7 +694,7 @@ func resourceMSOSchemaSiteContractServiceGraphListenerImport(d *schema.ResourceD
 	d.Set("listener_name", ListenerTokens[10])
 
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", ListenerTokens[0]))
+	cont, err := getSchemaCont(msoClient, ListenerTokens[0])
 	if err != nil {
 		return nil, err
 	}
@@ -731,7 +731,7 @@ func resourceMSOSchemaSiteContractServiceGraphListenerRead(d *schema.ResourceDat
 	log.Printf("[DEBUG] Begining Read Site Contract Service Graph Listener")
 	msoClient := m.(*client.Client)
 	schemaID := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -739,7 +739,7 @@ func resourceMSOSchemaSiteContractServiceGraphListenerRead(d *schema.ResourceDat
 	err = setSchemaSiteContractServiceGraphListenerAttrs(cont, d)
 
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	log.Printf("[DEBUG] Completed Read Site Contract Service Graph Listener")
 	return nil
@@ -760,7 +760,7 @@ func resourceMSOSchemaSiteContractServiceGraphListenerDelete(d *schema.ResourceD
 		siteID, templateName, contractName, serviceNodeIndex, listenerName,
 	)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(listenerPath))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(listenerPath))
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -1009,7 +1009,7 @@ func postSchemaSiteContractServiceGraphListenerConfig(ops string, d *schema.Reso
 
 	listenerPayload := models.NewSiteContractServiceGraphListener(ops, listenerPath, listenerName, protocol, securityPolicy, port, sslCertsPayloadMap, rulesPayloadMap, frontendIpDnMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), listenerPayload)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), listenerPayload)
 
 	if err != nil {
 		return err
@@ -65,7 +65,7 @@ func resourceMSOSchemaSiteExternalEpgImport(d *schema.ResourceData, m interface{
 
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -184,13 +184,13 @@ func resourceMSOSchemaSiteExternalEpgCreate(d *schema.ResourceData, m interface{
 	if versionInt != 1 {
 		path := fmt.Sprintf("/sites/%s-%s/externalEpgs/%s", siteId, templateName, externalEpgName)
 		siteExternalEpgStruct := models.NewSchemaSiteExternalEpg("replace", path, siteEpgMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
 	}
 
 	if versionInt == 1 || err != nil {
 		path := fmt.Sprintf("/sites/%s-%s/externalEpgs/-", siteId, templateName)
 		siteExternalEpgStruct := models.NewSchemaSiteExternalEpg("add", path, siteEpgMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
 	}
 
 	if err != nil {
@@ -207,13 +207,13 @@ func resourceMSOSchemaSiteExternalEpgRead(d *schema.ResourceData, m interface{})
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSiteId := d.Get("site_id").(string)
 	found := false
@@ -228,7 +228,7 @@ func resourceMSOSchemaSiteExternalEpgRead(d *schema.ResourceData, m interface{})
 		if apiSiteId == stateSiteId {
 			externalEpgCount, err := siteCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return fmt.Errorf("Unable to get External EPG list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get External EPG list"), d.Id(), cont, d)
 			}
 			for j := 0; j < externalEpgCount; j++ {
 				externalEpgCont, err := siteCont.ArrayElement(j, "externalEpgs")
@@ -319,7 +319,7 @@ func resourceMSOSchemaSiteExternalEpgUpdate(d *schema.ResourceData, m interface{
 	path := fmt.Sprintf("/sites/%s-%s/externalEpgs/%s", siteId, templateName, externalEpgName)
 	siteExternalEpgStruct := models.NewSchemaSiteExternalEpg("replace", path, siteEpgMap)
 
-	_, patchErr := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
+	_, patchErr := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
 	if patchErr != nil {
 		return patchErr
 	}
@@ -344,7 +344,7 @@ func resourceMSOSchemaSiteExternalEpgDelete(d *schema.ResourceData, m interface{
 	path := fmt.Sprintf("/sites/%s-%s/externalEpgs/%s", siteId, templateName, externalEpgName)
 	siteExternalEpgStruct := models.NewSchemaSiteExternalEpg("remove", path, externalEpgRefMap)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), siteExternalEpgStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -356,48 +356,30 @@ func resourceMSOSchemaSiteExternalEpgDelete(d *schema.ResourceData, m interface{
 }
 
 // Gets tenant name by doing the following
-// GET and loop through all the schemas and check if the schema is present ("api/v1/schemas/list-identity")
-// GET and loop through all the templates in the schema and check if the template is present
+// GET the schema and loop through all the templates in the schema and check if the template is present
 // If template present then get tenantId from template contents
 // GET tenant_name from tenantId "api/v1/tenants/{id}"
 func GetTenantNameViaTemplateName(msoClient *client.Client, id string, tempName string) (string, error) {
-	cont, err := msoClient.GetViaURL("api/v1/schemas/list-identity")
+	schemaCont, err := getSchemaCont(msoClient, id)
 	if err != nil {
 		return "", err
 	}
-	schemaCount, err := cont.ArrayCount("schemas")
-	if err != nil {
-		return "", err
-	}
-
-	for i := 0; i < schemaCount; i++ {
-		schemaCont, err := cont.ArrayElement(i, "schemas")
-		if err != nil {
-			return "", err
-		}
-		schemaId := models.StripQuotes(schemaCont.S("id").String())
-
-		if schemaId == id {
-			allTemplates := schemaCont.S("templates").Data().([]interface{})
 
-			for _, info := range allTemplates {
-				template := info.(map[string]interface{})
-				if tempName == template["name"] {
-					tenantId := template["tenantId"]
-					tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%v", tenantId))
-
-					if err != nil {
-						return "", err
-					}
-
-					tenantMap := tenantCont.Data().(map[string]interface{})
-					tenantName := tenantMap["name"].(string)
-					return tenantName, nil
-				}
+	allTemplates, _ := schemaCont.S("templates").Data().([]interface{})
+	for _, info := range allTemplates {
+		template := info.(map[string]interface{})
+		if tempName == template["name"] {
+			tenantId := template["tenantId"]
+			tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%v", tenantId))
 
+			if err != nil {
+				return "", err
 			}
-		}
 
+			tenantMap := tenantCont.Data().(map[string]interface{})
+			tenantName := tenantMap["name"].(string)
+			return tenantName, nil
+		}
 	}
 	return "", fmt.Errorf(tempName)
 }
@@ -78,7 +78,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorImport(d *schema.ResourceData, m in
 	templateName := get_attribute[4]
 	externalEpgName := get_attribute[6]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +174,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorCreate(d *schema.ResourceData, m in
 	selectorMap["name"] = name
 	selectorMap["ip"] = ip
 
-	contGet, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	contGet, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -191,7 +191,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorCreate(d *schema.ResourceData, m in
 
 	schemaSiteExternalEpgSelector := models.NewSchemaSiteExternalEpgSelector("add", path, selectorMap)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
 	if err != nil {
 		return err
 	}
@@ -217,7 +217,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorUpdate(d *schema.ResourceData, m in
 	selectorMap["name"] = name
 	selectorMap["ip"] = ip
 
-	contGet, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	contGet, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -234,7 +234,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorUpdate(d *schema.ResourceData, m in
 
 	schemaSiteExternalEpgSelector := models.NewSchemaSiteExternalEpgSelector("replace", path, selectorMap)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
 	if err != nil {
 		return err
 	}
@@ -254,7 +254,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorRead(d *schema.ResourceData, m inte
 	templateName := d.Get("template_name").(string)
 	externalEpgName := d.Get("external_epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, dn, cont, d)
 	}
@@ -263,7 +263,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorRead(d *schema.ResourceData, m inte
 
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return err
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), dn, cont, d)
 	}
 
 	for i := 0; i < count; i++ {
@@ -278,7 +278,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorRead(d *schema.ResourceData, m inte
 		if currSite == siteID && currTemplate == templateName {
 			extEpgCount, err := siteCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return err
+				return errorForObjectNotFound(fmt.Errorf("No External EPGs found"), dn, cont, d)
 			}
 
 			for j := 0; j < extEpgCount; j++ {
@@ -345,7 +345,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorDelete(d *schema.ResourceData, m in
 	templateName := d.Get("template_name").(string)
 	extrEpgName := d.Get("external_epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -363,7 +363,7 @@ func resourceMSOSchemaSiteExternalEpgSelectorDelete(d *schema.ResourceData, m in
 
 	schemaSiteExternalEpgSelector := models.NewSchemaSiteExternalEpgSelector("remove", path, nil)
 
-	response, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
+	response, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaSiteExternalEpgSelector)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err1 != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -0,0 +1,257 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSchemaSiteExternalEpgSubnet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaSiteExternalEpgSubnetCreate,
+		Update: resourceMSOSchemaSiteExternalEpgSubnetUpdate,
+		Read:   resourceMSOSchemaSiteExternalEpgSubnetRead,
+		Delete: resourceMSOSchemaSiteExternalEpgSubnetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSchemaSiteExternalEpgSubnetImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"external_epg_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"scope": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"shared-rtctrl",
+						"export-rtctrl",
+						"shared-security",
+						"import-rtctrl",
+						"import-security",
+					}, false),
+				},
+			},
+			"aggregate": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"shared-rtctrl",
+						"export-rtctrl",
+						"shared-security",
+						"import-rtctrl",
+					}, false),
+				},
+			},
+		}),
+	}
+}
+
+// getSiteExternalEpgSubnet returns the subnet of a site external EPG and its index, the index is -1 when it is not found.
+func getSiteExternalEpgSubnet(schemaCont *container.Container, siteId, templateName, externalEpgName, ip string) (map[string]interface{}, int, error) {
+	siteCont, err := getSiteFromSiteIdAndTemplateFromContainer(schemaCont, siteId, templateName)
+	if err != nil {
+		return nil, -1, err
+	}
+	externalEpgCont, err := getSiteExternalEpg(externalEpgName, siteCont)
+	if err != nil {
+		return nil, -1, err
+	}
+	if subnets, ok := externalEpgCont.S("subnets").Data().([]interface{}); ok {
+		for index, subnet := range subnets {
+			subnetMap := subnet.(map[string]interface{})
+			if convertInterfaceToString(subnetMap["ip"]) == ip {
+				return subnetMap, index, nil
+			}
+		}
+	}
+	return nil, -1, nil
+}
+
+func getSiteExternalEpgSubnetId(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/site/%s/template/%s/externalEPG/%s/ip/%s", d.Get("schema_id").(string), d.Get("site_id").(string), d.Get("template_name").(string), d.Get("external_epg_name").(string), d.Get("ip").(string))
+}
+
+func patchSiteExternalEpgSubnet(msoClient *client.Client, d *schema.ResourceData, op, index string) error {
+	var value interface{}
+	if op != "remove" {
+		value = map[string]interface{}{
+			"ip":        d.Get("ip").(string),
+			"name":      d.Get("name").(string),
+			"scope":     d.Get("scope").([]interface{}),
+			"aggregate": d.Get("aggregate").([]interface{}),
+		}
+	}
+	path := fmt.Sprintf("/sites/%s-%s/externalEpgs/%s/subnets/%s", d.Get("site_id").(string), d.Get("template_name").(string), d.Get("external_epg_name").(string), index)
+	payloadCon := container.New()
+	payloadCon.Array()
+	err := addPatchPayloadToContainer(payloadCon, op, path, value)
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), payloadCon)
+}
+
+func resourceMSOSchemaSiteExternalEpgSubnetImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	// The ip contains a slash, so the id is matched with a regular expression instead of split.
+	match := regexp.MustCompile("^([^/]+)/site/([^/]+)/template/([^/]+)/externalEPG/([^/]+)/ip/(.+)$").FindStringSubmatch(d.Id())
+	if match == nil {
+		return nil, fmt.Errorf("Invalid import ID %s, expected {schema_id}/site/{site_id}/template/{template_name}/externalEPG/{external_epg_name}/ip/{ip}", d.Id())
+	}
+	d.Set("schema_id", match[1])
+	d.Set("site_id", match[2])
+	d.Set("template_name", match[3])
+	d.Set("external_epg_name", match[4])
+	d.Set("ip", match[5])
+
+	err := resourceMSOSchemaSiteExternalEpgSubnetRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Subnet %s not found in the Site External EPG %s", match[5], match[4])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSchemaSiteExternalEpgSubnetCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Site External EPG Subnet: Beginning Create")
+	msoClient := m.(*client.Client)
+
+	err := patchSiteExternalEpgSubnet(msoClient, d, "add", "-")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(getSiteExternalEpgSubnetId(d))
+	log.Printf("[DEBUG] %s: Create finished successfully", d.Id())
+	return resourceMSOSchemaSiteExternalEpgSubnetRead(d, m)
+}
+
+func resourceMSOSchemaSiteExternalEpgSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return err
+	}
+	_, index, err := getSiteExternalEpgSubnet(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("external_epg_name").(string), d.Get("ip").(string))
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		return fmt.Errorf("Subnet %s not found in the Site External EPG %s", d.Get("ip").(string), d.Get("external_epg_name").(string))
+	}
+
+	err = patchSiteExternalEpgSubnet(msoClient, d, "replace", fmt.Sprintf("%d", index))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSchemaSiteExternalEpgSubnetRead(d, m)
+}
+
+func resourceMSOSchemaSiteExternalEpgSubnetRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
+	}
+	subnet, _, err := getSiteExternalEpgSubnet(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("external_epg_name").(string), d.Get("ip").(string))
+	if err != nil || subnet == nil {
+		log.Printf("[WARN] Site External EPG Subnet not found, removing from state: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(getSiteExternalEpgSubnetId(d))
+	d.Set("name", convertInterfaceToString(subnet["name"]))
+	if scope, ok := subnet["scope"].([]interface{}); ok {
+		d.Set("scope", scope)
+	} else {
+		d.Set("scope", make([]interface{}, 0))
+	}
+	if aggregate, ok := subnet["aggregate"].([]interface{}); ok {
+		d.Set("aggregate", aggregate)
+	} else {
+		d.Set("aggregate", make([]interface{}, 0))
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSchemaSiteExternalEpgSubnetDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Delete", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
+	}
+	_, index, err := getSiteExternalEpgSubnet(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("external_epg_name").(string), d.Get("ip").(string))
+	if err == nil && index != -1 {
+		err = patchSiteExternalEpgSubnet(msoClient, d, "remove", fmt.Sprintf("%d", index))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Delete finished successfully")
+	d.SetId("")
+	return nil
+}
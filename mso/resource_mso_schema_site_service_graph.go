@@ -3,6 +3,7 @@ package mso
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -57,9 +58,12 @@ func resourceMSOSchemaSiteServiceGraph() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"device_dn": &schema.Schema{
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1000),
+								validation.StringMatch(deviceDnRegex, "expected device_dn to be a device DN in the format uni/tn-{tenant_name}/{device}"),
+							),
 						},
 						"consumer_connector_type": &schema.Schema{
 							Type:     schema.TypeString,
@@ -88,6 +92,12 @@ func resourceMSOSchemaSiteServiceGraph() *schema.Resource {
 					},
 				},
 			},
+			"validate_device_dn": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the device_dn of the service nodes against the L4-L7 devices of the site during plan.",
+			},
 		}),
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
@@ -106,7 +116,7 @@ func resourceMSOSchemaSiteServiceGraph() *schema.Resource {
 			_, schemaId := diff.GetChange("schema_id")
 			_, templateName := diff.GetChange("template_name")
 			_, graphName := diff.GetChange("service_graph_name")
-			cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+			cont, err := getSchemaCont(msoClient, schemaId.(string))
 			if err != nil {
 				return err
 			}
@@ -135,6 +145,11 @@ func resourceMSOSchemaSiteServiceGraph() *schema.Resource {
 			   to verify it's value for nodetype 'other' and 'firewall'. */
 			_, siteServiceNodes := diff.GetChange("service_node")
 
+			// The service nodes are matched with the template service graph nodes by index.
+			if diff.NewValueKnown("service_node") && len(siteServiceNodes.([]interface{})) != len(templateServiceNodeList) {
+				return fmt.Errorf("The number of service_node blocks (%d) must match the number of service nodes of template service graph %s (%d), expected service nodes of type [%s].", len(siteServiceNodes.([]interface{})), graphName, len(templateServiceNodeList), strings.Join(templateServiceNodeList, ", "))
+			}
+
 			for i, val := range siteServiceNodes.([]interface{}) {
 				serviceNode := val.(map[string]interface{})
 				if templateServiceNodeList[i] == "other" && !valueInSliceofStrings(serviceNode["provider_connector_type"].(string), []string{"none", "redir"}) {
@@ -143,6 +158,24 @@ func resourceMSOSchemaSiteServiceGraph() *schema.Resource {
 					return fmt.Errorf("The expected value for service_node.%d.provider_connector_type have to be one of [none, redir, snat, dnat, snat_dnat] when template's service node type is firewall, got %s.", i, serviceNode["provider_connector_type"])
 				}
 			}
+
+			// Resolve the device_dn values against the L4-L7 devices known to the site when requested.
+			if diff.Get("validate_device_dn").(bool) && diff.NewValueKnown("site_id") && diff.NewValueKnown("service_node") {
+				tenantName, err := getTemplateTenantName(templateName.(string), cont, msoClient)
+				if err != nil {
+					return err
+				}
+				siteDeviceDns, err := getSiteServiceDeviceDns(msoClient, diff.Get("site_id").(string), tenantName)
+				if err != nil {
+					return err
+				}
+				for i, val := range siteServiceNodes.([]interface{}) {
+					deviceDn := val.(map[string]interface{})["device_dn"].(string)
+					if deviceDn != "" && !valueInSliceofStrings(deviceDn, siteDeviceDns) {
+						return fmt.Errorf("The device_dn %s of service_node.%d is not found in site %s for tenant %s, expected one of [%s].", deviceDn, i, diff.Get("site_id"), tenantName, strings.Join(siteDeviceDns, ", "))
+					}
+				}
+			}
 			return nil
 		},
 	}
@@ -158,7 +191,7 @@ func resourceMSOSchemaSiteServiceGraphImport(d *schema.ResourceData, m interface
 	templateName := get_attribute[4]
 	graphName := get_attribute[6]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +209,7 @@ func resourceMSOSchemaSiteServiceGraphImport(d *schema.ResourceData, m interface
 	d.Set("template_name", templateName)
 	d.Set("site_id", siteId)
 	d.Set("service_graph_name", graphName)
+	d.Set("validate_device_dn", false)
 
 	d.SetId(fmt.Sprintf("%s/templates/%s/serviceGraphs/%s", schemaId, templateName, graphName))
 	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
@@ -191,7 +225,7 @@ func resourceMSOSchemaSiteServiceGraphCreate(d *schema.ResourceData, m interface
 	siteId := d.Get("site_id").(string)
 	graphName := d.Get("service_graph_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -211,7 +245,7 @@ func resourceMSOSchemaSiteServiceGraphCreate(d *schema.ResourceData, m interface
 	}
 	serviceNodePath := fmt.Sprintf("/sites/%s-%s/serviceGraphs/%s/serviceNodes", siteId, templateName, graphName)
 	siteServiceGraphPayload := models.GetPatchPayloadList("add", serviceNodePath, siteServiceNodeList)
-	_, err = msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), siteServiceGraphPayload)
+	_, err = patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), siteServiceGraphPayload)
 	if err != nil {
 		return err
 	}
@@ -230,7 +264,7 @@ func resourceMSOSchemaSiteServiceGraphRead(d *schema.ResourceData, m interface{}
 	templateName := d.Get("template_name").(string)
 	siteId := d.Get("site_id").(string)
 	graphName := d.Get("service_graph_name").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -262,7 +296,7 @@ func resourceMSOSchemaSiteServiceGraphUpdate(d *schema.ResourceData, m interface
 	siteId := d.Get("site_id").(string)
 	graphName := d.Get("service_graph_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -281,7 +315,7 @@ func resourceMSOSchemaSiteServiceGraphUpdate(d *schema.ResourceData, m interface
 
 			serviceNodePath := fmt.Sprintf("/sites/%s-%s/serviceGraphs/%s/serviceNodes", siteId, templateName, graphName)
 			siteServiceGraphPayload := models.GetPatchPayloadList("replace", serviceNodePath, siteServiceNodeList)
-			_, err = msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), siteServiceGraphPayload)
+			_, err = patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), siteServiceGraphPayload)
 			if err != nil {
 				return err
 			}
@@ -294,13 +328,77 @@ func resourceMSOSchemaSiteServiceGraphUpdate(d *schema.ResourceData, m interface
 }
 
 func resourceMSOSchemaSiteServiceGraphDelete(d *schema.ResourceData, m interface{}) error {
-	log.Printf("[INFO]: Deletion of site Service Graph is not supported by the API.  Site Service Graph will be removed when site is disassociated from the template or when Service Graph is removed at the template level.")
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+	siteId := d.Get("site_id").(string)
+	graphName := d.Get("service_graph_name").(string)
+
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+
+	// The site service graph itself is removed when the site is disassociated from the template or when the service graph is removed at the template level.
+	// Only the device bindings of the service nodes are removed from the site service graph.
+	graphCont, _, err := getSiteServiceGraphCont(cont, schemaId, templateName, siteId, graphName)
+	if err != nil {
+		log.Printf("[DEBUG] %s: Site Service Graph not found, nothing to destroy: %s", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	serviceNodes, _ := graphCont.S("serviceNodes").Data().([]interface{})
+	for index, serviceNode := range serviceNodes {
+		if _, ok := serviceNode.(map[string]interface{})["device"]; ok {
+			devicePath := fmt.Sprintf("/sites/%s-%s/serviceGraphs/%s/serviceNodes/%d/device", siteId, templateName, graphName, index)
+			err := addPatchPayloadToContainer(payloadCon, "remove", devicePath, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if count, _ := payloadCon.ArrayCount(); count > 0 {
+		err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
 	return nil
 }
 
+// deviceDnRegex matches the DN of an on-premises or cloud L4-L7 device, ie. uni/tn-{tenant_name}/lDevVip-{device_name}.
+var deviceDnRegex = regexp.MustCompile(`^uni/tn-[^/]+/[^/]+-.+$`)
+
+// getSiteServiceDeviceDns returns the DNs of the L4-L7 devices of a tenant that are available in a site.
+func getSiteServiceDeviceDns(msoClient *client.Client, siteId, tenantName string) ([]string, error) {
+	devices, err := getSiteServiceDevices(msoClient, siteId, tenantName)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceDns := make([]string, 0, 1)
+	for _, device := range devices {
+		deviceDns = append(deviceDns, convertInterfaceToString(device.(map[string]interface{})["dn"]))
+	}
+	return deviceDns, nil
+}
+
 func createSiteServiceNodeList(msoClient *client.Client, siteServiceNodes interface{}, graphCont *container.Container) ([]interface{}, error) {
 	siteServiceNodeList := make([]interface{}, 0, 1)
-	for index, serviceNode := range graphCont.S("serviceNodes").Data().([]interface{}) {
+	templateServiceNodes := graphCont.S("serviceNodes").Data().([]interface{})
+	if len(siteServiceNodes.([]interface{})) != len(templateServiceNodes) {
+		return nil, fmt.Errorf("The number of service_node blocks (%d) must match the number of service nodes of the template service graph (%d).", len(siteServiceNodes.([]interface{})), len(templateServiceNodes))
+	}
+	for index, serviceNode := range templateServiceNodes {
 		siteServiceNodeMap := siteServiceNodes.([]interface{})[index].(map[string]interface{})
 
 		serviceNodeMap := map[string]interface{}{
@@ -106,7 +106,7 @@ func resourceMSOSchemaSiteServiceGraphNodeCreate(d *schema.ResourceData, m inter
 		nodeType = tempVar.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -205,7 +205,7 @@ func resourceMSOSchemaSiteServiceGraphNodeCreate(d *schema.ResourceData, m inter
 
 		}
 	}
-	_, err = msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
+	_, err = patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
 
 	if err != nil {
 		return err
@@ -234,7 +234,7 @@ func resourceMSOSchemaSiteServiceGraphNodeRead(d *schema.ResourceData, m interfa
 
 	nodeIdSt := d.Id()
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -393,7 +393,7 @@ func resourceMSOSchemaSiteServiceGraphNodeUpdate(d *schema.ResourceData, m inter
 
 				// ----> site payload creation ends
 
-				cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+				cont, err := getSchemaCont(msoClient, schemaId)
 				if err != nil {
 					return err
 				}
@@ -433,7 +433,7 @@ func resourceMSOSchemaSiteServiceGraphNodeUpdate(d *schema.ResourceData, m inter
 				sitePayload = append(sitePayload, models.NewTemplateServiceGraph("replace", sitePath, siteVarMap))
 
 			}
-			_, err := msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
+			_, err := patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
 
 			if err != nil {
 				return err
@@ -451,7 +451,7 @@ func resourceMSOSchemaSiteServiceGraphNodeDelete(d *schema.ResourceData, m inter
 	msoClient := m.(*client.Client)
 
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -514,7 +514,7 @@ func resourceMSOSchemaSiteServiceGraphNodeDelete(d *schema.ResourceData, m inter
 		}
 	}
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
+	response, err := patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), sitePayload...)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -60,7 +60,7 @@ func resourceMSOSchemaSiteVrfImport(d *schema.ResourceData, m interface{}) ([]*s
 
 	get_attribute := strings.Split(d.Id(), "/")
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -139,13 +139,13 @@ func resourceMSOSchemaSiteVrfCreate(d *schema.ResourceData, m interface{}) error
 	if versionInt != 1 {
 		path := fmt.Sprintf("/sites/%s-%s/vrfs/%s", siteId, templateName, vrfName)
 		vrfStruct := models.NewSchemaSiteVrf("replace", path, vrfRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
 	}
 
 	if versionInt == 1 || err != nil {
 		path := fmt.Sprintf("/sites/%s-%s/vrfs/-", siteId, templateName)
 		vrfStruct := models.NewSchemaSiteVrf("add", path, vrfRefMap)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
+		_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
 	}
 
 	if err != nil {
@@ -162,13 +162,13 @@ func resourceMSOSchemaSiteVrfRead(d *schema.ResourceData, m interface{}) error {
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 	stateSite := d.Get("site_id").(string)
 	found := false
@@ -183,7 +183,7 @@ func resourceMSOSchemaSiteVrfRead(d *schema.ResourceData, m interface{}) error {
 		if apiSite == stateSite {
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Vrf list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Vrf list"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -235,7 +235,7 @@ func resourceMSOSchemaSiteVrfDelete(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s", siteId, templateName, vrfName)
 	vrfStruct := models.NewSchemaSiteVrf("remove", path, vrfRefMap)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -159,7 +159,7 @@ func resourceMSOSchemaSiteVrfRegionImport(d *schema.ResourceData, m interface{})
 
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -378,7 +378,7 @@ func resourceMSOSchemaSiteVrfRegionCreate(d *schema.ResourceData, m interface{})
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/-", siteId, templateName, vrfName)
 	vrfRegionStruct := models.NewSchemaSiteVrfRegion("add", path, regionName, vrfName, vpnGateway, hubEnable, hubNetworkMap, cidrsList)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
 	if err != nil {
 		return err
 	}
@@ -464,7 +464,7 @@ func resourceMSOSchemaSiteVrfRegionUpdate(d *schema.ResourceData, m interface{})
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s", siteId, templateName, vrfName, regionName)
 	vrfRegionStruct := models.NewSchemaSiteVrfRegion("replace", path, regionName, vrfName, vpnGateway, hubEnable, hubNetworkMap, cidrsList)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
 	if err != nil {
 		return err
 	}
@@ -478,13 +478,13 @@ func resourceMSOSchemaSiteVrfRegionRead(d *schema.ResourceData, m interface{}) e
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -504,7 +504,7 @@ func resourceMSOSchemaSiteVrfRegionRead(d *schema.ResourceData, m interface{}) e
 		if apiSite == stateSite && apiTemplate == stateTemplate {
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Vrf list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Vrf list"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount && !found; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -521,7 +521,7 @@ func resourceMSOSchemaSiteVrfRegionRead(d *schema.ResourceData, m interface{}) e
 					d.Set("vrf_name", split[6])
 					regionCount, err := vrfCont.ArrayCount("regions")
 					if err != nil {
-						return fmt.Errorf("Unable to get Regions list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Regions list"), d.Id(), cont, d)
 					}
 					for k := 0; k < regionCount; k++ {
 						regionCont, err := vrfCont.ArrayElement(k, "regions")
@@ -637,7 +637,7 @@ func resourceMSOSchemaSiteVrfRegionDelete(d *schema.ResourceData, m interface{})
 	regionName := d.Get("region_name").(string)
 
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s", siteId, templateName, vrfName, regionName)
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
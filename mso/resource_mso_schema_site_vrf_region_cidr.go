@@ -79,7 +79,7 @@ func resourceMSOSchemaSiteVrfRegionCidrImport(d *schema.ResourceData, m interfac
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	get_attribute := strings.Split(d.Id(), "/")
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +177,7 @@ func resourceMSOSchemaSiteVrfRegionCidrCreate(d *schema.ResourceData, m interfac
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/-", siteId, templateName, vrfName, regionName)
 	VrfRegionCidrStruct := models.NewSchemaSiteVrfRegionCidr("add", path, ip, primary)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
 	if err != nil {
 		return err
 	}
@@ -191,13 +191,13 @@ func resourceMSOSchemaSiteVrfRegionCidrRead(d *schema.ResourceData, m interface{
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -216,7 +216,7 @@ func resourceMSOSchemaSiteVrfRegionCidrRead(d *schema.ResourceData, m interface{
 		if apiSite == stateSite {
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Vrf list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Vrf list"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -233,7 +233,7 @@ func resourceMSOSchemaSiteVrfRegionCidrRead(d *schema.ResourceData, m interface{
 					d.Set("vrf_name", split[6])
 					regionCount, err := vrfCont.ArrayCount("regions")
 					if err != nil {
-						return fmt.Errorf("Unable to get Regions list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Regions list"), d.Id(), cont, d)
 					}
 					for k := 0; k < regionCount; k++ {
 						regionCont, err := vrfCont.ArrayElement(k, "regions")
@@ -244,7 +244,7 @@ func resourceMSOSchemaSiteVrfRegionCidrRead(d *schema.ResourceData, m interface{
 						if apiRegion == stateRegion {
 							cidrCount, err := regionCont.ArrayCount("cidrs")
 							if err != nil {
-								return fmt.Errorf("Unable to get Cidr list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Cidr list"), d.Id(), cont, d)
 							}
 							for l := 0; l < cidrCount; l++ {
 								cidrCont, err := regionCont.ArrayElement(l, "cidrs")
@@ -289,7 +289,7 @@ func resourceMSOSchemaSiteVrfRegionCidrUpdate(d *schema.ResourceData, m interfac
 	primary := d.Get("primary").(bool)
 
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -305,7 +305,7 @@ func resourceMSOSchemaSiteVrfRegionCidrUpdate(d *schema.ResourceData, m interfac
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/%s", siteId, templateName, vrfName, regionName, indexs)
 	VrfRegionCidrStruct := models.NewSchemaSiteVrfRegionCidr("replace", path, ip, primary)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
 	if errs != nil {
 		return errs
 	}
@@ -325,7 +325,7 @@ func resourceMSOSchemaSiteVrfRegionCidrDelete(d *schema.ResourceData, m interfac
 	primary := d.Get("primary").(bool)
 
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -342,7 +342,7 @@ func resourceMSOSchemaSiteVrfRegionCidrDelete(d *schema.ResourceData, m interfac
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/%s", siteId, templateName, vrfName, regionName, indexs)
 	VrfRegionCidrStruct := models.NewSchemaSiteVrfRegionCidr("remove", path, ip, primary)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), VrfRegionCidrStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
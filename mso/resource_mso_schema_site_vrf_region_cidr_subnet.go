@@ -101,7 +101,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetImport(d *schema.ResourceData, m in
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +250,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetCreate(d *schema.ResourceData, m in
 		name = tempvar.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -318,7 +318,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetCreate(d *schema.ResourceData, m in
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/%v/subnets/-", siteId, templateName, vrfName, regionName, cindex)
 	vrfRegionStruct := models.NewSchemaSiteVrfRegionCidrSubnet("add", path, name, ip, zone, usage, subnetGroup)
 
-	_, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
+	_, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
 	if err1 != nil {
 		return err1
 	}
@@ -332,13 +332,13 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetRead(d *schema.ResourceData, m inte
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("sites")
 	if err != nil {
-		return fmt.Errorf("No Sites found")
+		return errorForObjectNotFound(fmt.Errorf("No Sites found"), d.Id(), cont, d)
 	}
 
 	stateSite := d.Get("site_id").(string)
@@ -359,7 +359,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetRead(d *schema.ResourceData, m inte
 			apiTemplate := models.StripQuotes(tempCont.S("templateName").String())
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Vrf list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Vrf list"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount && !found; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -372,7 +372,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetRead(d *schema.ResourceData, m inte
 				if apiVrf == stateVrf {
 					regionCount, err := vrfCont.ArrayCount("regions")
 					if err != nil {
-						return fmt.Errorf("Unable to get Regions list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Regions list"), d.Id(), cont, d)
 					}
 					for k := 0; k < regionCount && !found; k++ {
 						regionCont, err := vrfCont.ArrayElement(k, "regions")
@@ -383,7 +383,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetRead(d *schema.ResourceData, m inte
 						if apiRegion == stateRegion {
 							cidrCount, err := regionCont.ArrayCount("cidrs")
 							if err != nil {
-								return fmt.Errorf("Unable to get Cidr list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get Cidr list"), d.Id(), cont, d)
 							}
 							for l := 0; l < cidrCount && !found; l++ {
 								cidrCont, err := regionCont.ArrayElement(l, "cidrs")
@@ -395,7 +395,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetRead(d *schema.ResourceData, m inte
 								if apiCidr == stateCidr {
 									subnetCount, err := cidrCont.ArrayCount("subnets")
 									if err != nil {
-										return fmt.Errorf("Unable to get Subnet list")
+										return errorForObjectNotFound(fmt.Errorf("Unable to get Subnet list"), d.Id(), cont, d)
 									}
 									for m := 0; m < subnetCount; m++ {
 										subnetCont, err := cidrCont.ArrayElement(m, "subnets")
@@ -480,7 +480,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetUpdate(d *schema.ResourceData, m in
 		name = tempvar.(string)
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -562,7 +562,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetUpdate(d *schema.ResourceData, m in
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/%v/subnets/%v", siteId, templateName, vrfName, regionName, cindex, index)
 	vrfRegionStruct := models.NewSchemaSiteVrfRegionCidrSubnet("replace", path, name, ip, zone, usage, subnetGroup)
 
-	_, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
+	_, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
 	if err1 != nil {
 		return err1
 	}
@@ -581,7 +581,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetDelete(d *schema.ResourceData, m in
 	cidrIp := d.Get("cidr_ip").(string)
 	ip := d.Get("ip").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -667,7 +667,7 @@ func resourceMSOSchemaSiteVrfRegionCidrSubnetDelete(d *schema.ResourceData, m in
 
 	path := fmt.Sprintf("/sites/%s-%s/vrfs/%s/regions/%s/cidrs/%v/subnets/%v", siteId, templateName, vrfName, regionName, cindex, index)
 	vrfRegionStruct := models.NewSchemaSiteVrfRegionCidrSubnet("remove", path, "", ip, "", "", "")
-	response, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
+	response, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRegionStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err1 != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -180,7 +180,7 @@ func resourceMSOSchemaSiteVrfRouteLeakImport(d *schema.ResourceData, m interface
 	msoClient := m.(*client.Client)
 	splitImport := strings.Split(d.Id(), "/")
 	schemaId := splitImport[0]
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -201,7 +201,7 @@ func resourceMSOSchemaSiteVrfRouteLeakCreate(d *schema.ResourceData, m interface
 	vrfRouteLeakStruct := models.NewSchemaSiteVrfRouteLeak(
 		"add", path, d.Get("tenant_name").(string), getTargetVrfRef(d), includeAllSubnets, prefixSubnets, []string{siteId},
 	)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), vrfRouteLeakStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), vrfRouteLeakStruct)
 	if err != nil {
 		return err
 	}
@@ -216,7 +216,7 @@ func resourceMSOSchemaSiteVrfRouteLeakUpdate(d *schema.ResourceData, m interface
 	vrfRegionStruct := models.NewSchemaSiteVrfRouteLeak(
 		"replace", d.Id(), d.Get("tenant_name").(string), getTargetVrfRef(d), includeAllSubnets, prefixSubnets, []string{d.Get("site_id").(string)},
 	)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), vrfRegionStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), vrfRegionStruct)
 	if err != nil {
 		return err
 	}
@@ -228,7 +228,7 @@ func resourceMSOSchemaSiteVrfRouteLeakRead(d *schema.ResourceData, m interface{}
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
 	}
@@ -241,7 +241,7 @@ func resourceMSOSchemaSiteVrfRouteLeakDelete(d *schema.ResourceData, m interface
 	log.Printf("[DEBUG] %s: Beginning Delete", d.Id())
 	msoClient := m.(*client.Client)
 	if d.Id() != "" {
-		response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(d.Id()))
+		response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(d.Id()))
 		if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
 			return err
 		}
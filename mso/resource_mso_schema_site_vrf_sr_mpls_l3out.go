@@ -0,0 +1,222 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSchemaSiteVrfSrMplsL3out() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaSiteVrfSrMplsL3outCreate,
+		Update: resourceMSOSchemaSiteVrfSrMplsL3outUpdate,
+		Read:   resourceMSOSchemaSiteVrfSrMplsL3outRead,
+		Delete: resourceMSOSchemaSiteVrfSrMplsL3outDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSchemaSiteVrfSrMplsL3outImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"vrf_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"l3out_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"import_route_map": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"export_route_map": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		}),
+	}
+}
+
+// getSiteVrfSrMplsL3out returns the SR-MPLS L3Out attachment of a site VRF and its index, the index is -1 when it is not found.
+func getSiteVrfSrMplsL3out(schemaCont *container.Container, siteId, templateName, vrfName, l3outName string) (map[string]interface{}, int, error) {
+	siteCont, err := getSiteFromSiteIdAndTemplateFromContainer(schemaCont, siteId, templateName)
+	if err != nil {
+		return nil, -1, err
+	}
+	vrfCont, err := getSiteVrf(vrfName, siteCont)
+	if err != nil {
+		return nil, -1, err
+	}
+	if l3outs, ok := vrfCont.S("srMplsL3outs").Data().([]interface{}); ok {
+		for index, l3out := range l3outs {
+			l3outMap := l3out.(map[string]interface{})
+			if convertInterfaceToString(l3outMap["l3outName"]) == l3outName {
+				return l3outMap, index, nil
+			}
+		}
+	}
+	return nil, -1, nil
+}
+
+func getSiteVrfSrMplsL3outPath(d *schema.ResourceData, index string) string {
+	return fmt.Sprintf("/sites/%s-%s/vrfs/%s/srMplsL3outs/%s", d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), index)
+}
+
+func patchSiteVrfSrMplsL3out(msoClient *client.Client, d *schema.ResourceData, op, index string) error {
+	var value interface{}
+	if op != "remove" {
+		value = map[string]interface{}{
+			"l3outName":          d.Get("l3out_name").(string),
+			"importRouteMapName": d.Get("import_route_map").(string),
+			"exportRouteMapName": d.Get("export_route_map").(string),
+		}
+	}
+	payloadCon := container.New()
+	payloadCon.Array()
+	err := addPatchPayloadToContainer(payloadCon, op, getSiteVrfSrMplsL3outPath(d, index), value)
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), payloadCon)
+}
+
+func resourceMSOSchemaSiteVrfSrMplsL3outImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 10 {
+		return nil, fmt.Errorf("Invalid import ID %s, expected {schema_id}/site/{site_id}/template/{template_name}/vrf/{vrf_name}/srMplsL3out/{l3out_name}", d.Id())
+	}
+	d.Set("schema_id", get_attribute[0])
+	d.Set("site_id", get_attribute[2])
+	d.Set("template_name", get_attribute[4])
+	d.Set("vrf_name", get_attribute[6])
+	d.Set("l3out_name", get_attribute[8])
+
+	err := resourceMSOSchemaSiteVrfSrMplsL3outRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("SR-MPLS L3Out %s not found in the Site Vrf %s", get_attribute[8], get_attribute[6])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSchemaSiteVrfSrMplsL3outCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Site Vrf SR-MPLS L3Out: Beginning Create")
+	msoClient := m.(*client.Client)
+
+	err := patchSiteVrfSrMplsL3out(msoClient, d, "add", "-")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/site/%s/template/%s/vrf/%s/srMplsL3out/%s", d.Get("schema_id").(string), d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), d.Get("l3out_name").(string)))
+	log.Printf("[DEBUG] %s: Create finished successfully", d.Id())
+	return resourceMSOSchemaSiteVrfSrMplsL3outRead(d, m)
+}
+
+func resourceMSOSchemaSiteVrfSrMplsL3outUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return err
+	}
+	_, index, err := getSiteVrfSrMplsL3out(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), d.Get("l3out_name").(string))
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		return fmt.Errorf("SR-MPLS L3Out %s not found in the Site Vrf %s", d.Get("l3out_name").(string), d.Get("vrf_name").(string))
+	}
+
+	err = patchSiteVrfSrMplsL3out(msoClient, d, "replace", fmt.Sprintf("%d", index))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSchemaSiteVrfSrMplsL3outRead(d, m)
+}
+
+func resourceMSOSchemaSiteVrfSrMplsL3outRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
+	}
+	l3out, _, err := getSiteVrfSrMplsL3out(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), d.Get("l3out_name").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), nil, d)
+	}
+	if l3out == nil {
+		log.Printf("[WARN] SR-MPLS L3Out not found, removing from state: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s/site/%s/template/%s/vrf/%s/srMplsL3out/%s", d.Get("schema_id").(string), d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), d.Get("l3out_name").(string)))
+	d.Set("import_route_map", convertInterfaceToString(l3out["importRouteMapName"]))
+	d.Set("export_route_map", convertInterfaceToString(l3out["exportRouteMapName"]))
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSchemaSiteVrfSrMplsL3outDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Delete", d.Id())
+	msoClient := m.(*client.Client)
+
+	schemaCont, err := getSchemaCont(msoClient, d.Get("schema_id").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
+	}
+	_, index, err := getSiteVrfSrMplsL3out(schemaCont, d.Get("site_id").(string), d.Get("template_name").(string), d.Get("vrf_name").(string), d.Get("l3out_name").(string))
+	if err == nil && index != -1 {
+		err = patchSiteVrfSrMplsL3out(msoClient, d, "remove", fmt.Sprintf("%d", index))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Delete finished successfully")
+	d.SetId("")
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
 	"github.com/ciscoecosystem/mso-go-client/models"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -41,7 +42,6 @@ func resourceMSOSchemaTemplate() *schema.Resource {
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
 			"description": &schema.Schema{
@@ -62,6 +62,11 @@ func resourceMSOSchemaTemplate() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(getSchemaTemplateTypes(), false),
 			},
+			"undeploy_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		}),
 	}
 }
@@ -72,11 +77,12 @@ func resourceMSOSchemaTemplateImport(d *schema.ResourceData, m interface{}) ([]*
 	msoClient := m.(*client.Client)
 	name := get_attribute[2]
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
 	d.Set("schema_id", schemaId)
+	d.Set("undeploy_on_destroy", false)
 	data := cont.S("templates").Data().([]interface{})
 	var flag bool
 	var count int
@@ -114,9 +120,37 @@ func resourceMSOSchemaTemplateCreate(d *schema.ResourceData, m interface{}) erro
 	description := d.Get("description").(string)
 	templateType := getTemplateType(d.Get("template_type").(string))
 	templateSubType := getTemplateSubType(d.Get("template_type").(string))
+
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+	count, _ := cont.ArrayCount("templates")
+	for i := 0; i < count; i++ {
+		tempCont, err := cont.ArrayElement(i, "templates")
+		if err != nil {
+			return err
+		}
+		if models.StripQuotes(tempCont.S("name").String()) == name {
+			return fmt.Errorf("Template %s already exists in schema %s, import it with the id %s/template/%s to manage it", name, schemaId, schemaId, name)
+		}
+	}
+
 	schematemplate := models.NewSchemaTemplate("add", "/templates/-", tenantId, name, displayName, description, templateType, templateSubType)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schematemplate)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schematemplate)
+	if err != nil {
+		return err
+	}
+	err = waitForCreatedObject(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), func(cont *container.Container) bool {
+		templates, _ := cont.S("templates").Data().([]interface{})
+		for _, template := range templates {
+			if templateMap, ok := template.(map[string]interface{}); ok && templateMap["name"] == name {
+				return true
+			}
+		}
+		return false
+	})
 	if err != nil {
 		return err
 	}
@@ -133,18 +167,17 @@ func resourceMSOSchemaTemplateRead(d *schema.ResourceData, m interface{}) error
 	msoClient := m.(*client.Client)
 
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
-	stateTenantId := d.Get("tenant_id").(string)
-	stateTemplateName := d.Get("name").(string)
-	stateTemplateDisplayName := d.Get("display_name").(string)
+	// The template is matched by name only, so changes of the other attributes outside of Terraform are detected.
+	stateTemplateName := d.Id()
 
 	found := false
 
@@ -155,13 +188,12 @@ func resourceMSOSchemaTemplateRead(d *schema.ResourceData, m interface{}) error
 		}
 		apiTenantId := models.StripQuotes(tempCont.S("tenantId").String())
 		apiTemplateName := models.StripQuotes(tempCont.S("name").String())
-		apiTemplateDisplayName := models.StripQuotes(tempCont.S("displayName").String())
 
-		if apiTenantId == stateTenantId && apiTemplateName == stateTemplateName && apiTemplateDisplayName == stateTemplateDisplayName {
+		if apiTemplateName == stateTemplateName {
 			d.SetId(apiTemplateName)
 			d.Set("tenant_id", apiTenantId)
 			d.Set("name", apiTemplateName)
-			d.Set("name", apiTemplateName)
+			d.Set("display_name", models.StripQuotes(tempCont.S("displayName").String()))
 			d.Set("description", models.StripQuotes(tempCont.S("description").String()))
 			d.Set("template_type", getSchemaTemplateType(tempCont))
 			found = true
@@ -182,35 +214,56 @@ func resourceMSOSchemaTemplateRead(d *schema.ResourceData, m interface{}) error
 }
 
 func resourceMSOSchemaTemplateUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	name := d.Get("name").(string)
-
-	if d.HasChange("display_name") || d.HasChange("description") {
-		tenantId := d.Get("tenant_id").(string)
-		displayName := d.Get("display_name").(string)
-		description := d.Get("description").(string)
-		templateType := getTemplateType(d.Get("template_type").(string))
-		templateSubType := getTemplateSubType(d.Get("template_type").(string))
-		schematemplate := models.NewSchemaTemplate("replace", fmt.Sprintf("/templates/%s", name), tenantId, name, displayName, description, templateType, templateSubType)
-
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schematemplate)
+	oldName, newName := d.GetChange("name")
+
+	// The attributes are replaced separately, so the objects of the template are kept.
+	// The template is renamed last because the other paths use the current name.
+	payloadCon := container.New()
+	payloadCon.Array()
+	if d.HasChange("display_name") {
+		err := addPatchPayloadToContainer(payloadCon, "replace", fmt.Sprintf("/templates/%s/displayName", oldName), d.Get("display_name").(string))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("description") {
+		err := addPatchPayloadToContainer(payloadCon, "replace", fmt.Sprintf("/templates/%s/description", oldName), d.Get("description").(string))
 		if err != nil {
 			return err
 		}
+	}
+	if d.HasChange("name") {
+		err := addPatchPayloadToContainer(payloadCon, "replace", fmt.Sprintf("/templates/%s/name", oldName), newName.(string))
+		if err != nil {
+			return err
+		}
+	}
 
-		d.SetId(fmt.Sprintf("%v", name))
-		log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	if len(payloadCon.Data().([]interface{})) > 0 {
+		err := doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+		if err != nil {
+			return err
+		}
 	}
 
+	d.SetId(newName.(string))
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
 	return resourceMSOSchemaTemplateRead(d, m)
 }
 
 func resourceMSOSchemaTemplateDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
 	msoClient := m.(*client.Client)
+	err := undeployOrProtectTemplates(getStopContext(msoClient), msoClient, d.Get("schema_id").(string), []string{d.Get("name").(string)}, d.Get("undeploy_on_destroy").(bool))
+	if err != nil {
+		return err
+	}
+
 	path := fmt.Sprintf("/templates/%s", d.Get("name").(string))
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(path))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(path))
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -44,6 +44,10 @@ func resourceMSOSchemaTemplateAnp() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 
 			"display_name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -65,7 +69,7 @@ func resourceMSOSchemaTemplateAnpImport(d *schema.ResourceData, m interface{}) (
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -160,7 +164,7 @@ func resourceMSOSchemaTemplateAnpCreate(d *schema.ResourceData, m interface{}) e
 
 	schemaTemplateAnpApp := models.NewSchemaTemplateAnp("add", "/templates/"+templateName+"/anps/-", Name, displayName, description)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -202,7 +206,7 @@ func resourceMSOSchemaTemplateAnpUpdate(d *schema.ResourceData, m interface{}) e
 
 	schemaTemplateAnpApp := models.NewSchemaTemplateAnp("replace", "/templates/"+templateName+"/anps/"+Name, Name, displayName, description)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -219,14 +223,14 @@ func resourceMSOSchemaTemplateAnpRead(d *schema.ResourceData, m interface{}) err
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	templateName := d.Get("template").(string)
@@ -246,7 +250,7 @@ func resourceMSOSchemaTemplateAnpRead(d *schema.ResourceData, m interface{}) err
 			anpCount, err := tempCont.ArrayCount("anps")
 
 			if err != nil {
-				return fmt.Errorf("No Anp found")
+				return errorForObjectNotFound(fmt.Errorf("No Anp found"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -286,12 +290,15 @@ func resourceMSOSchemaTemplateAnpRead(d *schema.ResourceData, m interface{}) err
 
 func resourceMSOSchemaTemplateAnpDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
 	template := d.Get("template").(string)
 	name := d.Get("name").(string)
 	schemaTemplateAnpApp := models.NewSchemaTemplateAnp("remove", "/templates/"+template+"/anps/"+name, "", "", "")
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpApp)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
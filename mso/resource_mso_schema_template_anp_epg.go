@@ -51,6 +51,10 @@ func resourceMSOSchemaTemplateAnpEpg() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"bd_name": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -316,7 +320,7 @@ func resourceMSOSchemaTemplateAnpEpgImport(d *schema.ResourceData, m interface{}
 
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -476,7 +480,7 @@ func resourceMSOSchemaTemplateAnpEpgCreate(d *schema.ResourceData, m interface{}
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/-", templateName, anpName)
 	anpEpgStruct := models.NewTemplateAnpEpg("add", path, Name, displayName, intraEpg, epgType, description, uSegEpg, intersiteMulticasteSource, preferredGroup, proxyArp, vrfRefMap, bdRefMap, cloudServiceEpgConfig)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 
 	if err != nil {
 		return err
@@ -491,7 +495,7 @@ func resourceMSOSchemaTemplateAnpEpgRead(d *schema.ResourceData, m interface{})
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -503,8 +507,7 @@ func resourceMSOSchemaTemplateAnpEpgRead(d *schema.ResourceData, m interface{})
 	err = resourceMSOSchemaTemplateAnpEpgSetAttr(schemaId, stateTemplate, stateANP, stateEPG, cont, d)
 
 	if err != nil {
-		d.SetId("")
-		return err
+		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
@@ -596,7 +599,7 @@ func resourceMSOSchemaTemplateAnpEpgUpdate(d *schema.ResourceData, m interface{}
 
 	anpEpgStruct := models.NewTemplateAnpEpg("replace", getPathFromId(d.Id()), Name, displayName, intraEpg, epgType, description, uSegEpg, intersiteMulticasteSource, preferredGroup, proxyArp, vrfRefMap, bdRefMap, cloudServiceEpgConfig)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgStruct)
 
 	if err != nil {
 		return err
@@ -606,10 +609,13 @@ func resourceMSOSchemaTemplateAnpEpgUpdate(d *schema.ResourceData, m interface{}
 
 func resourceMSOSchemaTemplateAnpEpgDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
 	anpEpgRemovePatchPayload := models.GetRemovePatchPayload(getPathFromId(d.Id()))
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgRemovePatchPayload)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), anpEpgRemovePatchPayload)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -84,7 +84,7 @@ func resourceMSOTemplateAnpEpgContractImport(d *schema.ResourceData, m interface
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -207,7 +207,7 @@ func resourceMSOTemplateAnpEpgContractCreate(d *schema.ResourceData, m interface
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/contractRelationships/-", templateName, anpName, epgName)
 	bdStruct := models.NewTemplateAnpEpgContract("add", path, contractRefMap, relationship_type)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), bdStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), bdStruct)
 	if err != nil {
 		return err
 	}
@@ -221,13 +221,13 @@ func resourceMSOTemplateAnpEpgContractRead(d *schema.ResourceData, m interface{}
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -245,7 +245,7 @@ func resourceMSOTemplateAnpEpgContractRead(d *schema.ResourceData, m interface{}
 		if apiTemplate == stateTemplate {
 			anpCount, err := tempCont.ArrayCount("anps")
 			if err != nil {
-				return fmt.Errorf("Unable to get ANP list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get ANP list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -256,7 +256,7 @@ func resourceMSOTemplateAnpEpgContractRead(d *schema.ResourceData, m interface{}
 				if apiANP == stateANP {
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("Unable to get EPG list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get EPG list"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -267,7 +267,7 @@ func resourceMSOTemplateAnpEpgContractRead(d *schema.ResourceData, m interface{}
 						if apiEPG == stateEPG {
 							crefCount, err := epgCont.ArrayCount("contractRelationships")
 							if err != nil {
-								return fmt.Errorf("Unable to get the contract relationships list")
+								return errorForObjectNotFound(fmt.Errorf("Unable to get the contract relationships list"), d.Id(), cont, d)
 							}
 							for l := 0; l < crefCount; l++ {
 								crefCont, err := epgCont.ArrayElement(l, "contractRelationships")
@@ -337,7 +337,7 @@ func resourceMSOTemplateAnpEpgContractUpdate(d *schema.ResourceData, m interface
 	contractRefMap["contractName"] = contractName
 
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -346,14 +346,14 @@ func resourceMSOTemplateAnpEpgContractUpdate(d *schema.ResourceData, m interface
 		return err
 	}
 	if index == -1 {
-		fmt.Errorf("The given contract id is not found")
+		return fmt.Errorf("The given contract id is not found")
 	}
 	indexs := strconv.Itoa(index)
 
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/contractRelationships/%s", templateName, anpName, epgName, indexs)
 	crefStruct := models.NewTemplateAnpEpgContract("replace", path, contractRefMap, relationship_type)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), crefStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), crefStruct)
 	if errs != nil {
 		return errs
 	}
@@ -392,7 +392,7 @@ func resourceMSOTemplateAnpEpgContractDelete(d *schema.ResourceData, m interface
 	contractRefMap["contractName"] = contractName
 
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -409,7 +409,7 @@ func resourceMSOTemplateAnpEpgContractDelete(d *schema.ResourceData, m interface
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/contractRelationships/%s", templateName, anpName, epgName, indexs)
 	crefStruct := models.NewTemplateAnpEpgContract("remove", path, contractRefMap, relationship_type)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), crefStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), crefStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -108,7 +108,7 @@ func resourceMSOSchemaTemplateAnpEpgSelectorImport(d *schema.ResourceData, m int
 	anpName := get_attribute[4]
 	epgName := get_attribute[6]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return nil, err
 	}
@@ -255,7 +255,7 @@ func resourceMSOSchemaTemplateAnpEpgSelectorCreate(d *schema.ResourceData, m int
 
 	schematemplateanpepgselector := models.NewSchemaTemplateAnpEpgSelector("add", path, schematemplateanpepgselectorMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
 	if err != nil {
 		return err
 	}
@@ -308,7 +308,7 @@ func resourceMSOSchemaTemplateAnpEpgSelectorUpdate(d *schema.ResourceData, m int
 
 	schematemplateanpepgselector := models.NewSchemaTemplateAnpEpgSelector("replace", path, schematemplateanpepgselectorMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
 	if err != nil {
 		return err
 	}
@@ -327,14 +327,14 @@ func resourceMSOSchemaTemplateAnpEpgSelectorRead(d *schema.ResourceData, m inter
 	anpName := d.Get("anp_name").(string)
 	epgName := d.Get("epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, dn, cont, d)
 	}
 
 	tempCount, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	for i := 0; i < tempCount; i++ {
@@ -446,7 +446,7 @@ func resourceMSOSchemaTemplateAnpEpgSelectorDelete(d *schema.ResourceData, m int
 
 	schematemplateanpepgselector := models.NewSchemaTemplateAnpEpgSelector("remove", path, nil)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schematemplateanpepgselector)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
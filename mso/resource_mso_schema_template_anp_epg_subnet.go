@@ -101,7 +101,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetImport(d *schema.ResourceData, m inter
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -277,7 +277,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetCreate(d *schema.ResourceData, m inter
 
 	schemaTemplateAnpEpgSubnetApp := models.NewSchemaTemplateAnpEpgSubnet("add", fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/subnets/-", templateName, anpName, epgName), ip, description, scope, shared, noDefaultGateway, querier, primary)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -350,7 +350,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetUpdate(d *schema.ResourceData, m inter
 		noDefaultGateway = tempVar.(bool)
 	}
 
-	conts, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	conts, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -361,14 +361,14 @@ func resourceMSOSchemaTemplateAnpEpgSubnetUpdate(d *schema.ResourceData, m inter
 	}
 
 	if index == -1 {
-		fmt.Errorf("The given subnet ip is not found")
+		return fmt.Errorf("The given subnet ip is not found")
 	}
 
 	indexs := strconv.Itoa(index)
 
 	schemaTemplateAnpEpgSubnetApp := models.NewSchemaTemplateAnpEpgSubnet("replace", fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/subnets/%s", templateName, anpName, epgName, indexs), ip, description, scope, shared, noDefaultGateway, querier, primary)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -384,14 +384,14 @@ func resourceMSOSchemaTemplateAnpEpgSubnetRead(d *schema.ResourceData, m interfa
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	templateName := d.Get("template").(string)
@@ -413,7 +413,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetRead(d *schema.ResourceData, m interfa
 			anpCount, err := tempCont.ArrayCount("anps")
 
 			if err != nil {
-				return fmt.Errorf("No Anp found")
+				return errorForObjectNotFound(fmt.Errorf("No Anp found"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount && !found; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -426,7 +426,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetRead(d *schema.ResourceData, m interfa
 					d.Set("anp_name", currentAnpName)
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("No Epg found")
+						return errorForObjectNotFound(fmt.Errorf("No Epg found"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount && !found; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -438,7 +438,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetRead(d *schema.ResourceData, m interfa
 							d.Set("epg_name", currentEpgName)
 							subnetCount, err := epgCont.ArrayCount("subnets")
 							if err != nil {
-								return fmt.Errorf("No Subnets found")
+								return errorForObjectNotFound(fmt.Errorf("No Subnets found"), d.Id(), cont, d)
 							}
 							for s := 0; s < subnetCount; s++ {
 								subnetCont, err := epgCont.ArrayElement(s, "subnets")
@@ -509,7 +509,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetDelete(d *schema.ResourceData, m inter
 	anpName := d.Get("anp_name").(string)
 	epgName := d.Get("epg_name").(string)
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -523,7 +523,7 @@ func resourceMSOSchemaTemplateAnpEpgSubnetDelete(d *schema.ResourceData, m inter
 	}
 	indexs := strconv.Itoa(index)
 	schemaTemplateAnpEpgSubnetApp := models.GetRemovePatchPayload(fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/subnets/%s", template, anpName, epgName, indexs))
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateAnpEpgSubnetApp)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
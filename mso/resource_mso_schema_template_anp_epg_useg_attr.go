@@ -124,7 +124,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrImport(d *schema.ResourceData, m int
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -327,7 +327,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrCreate(d *schema.ResourceData, m int
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/uSegAttrs/-", templateName, anpName, epgName)
 	usegAttrApp := models.NewSchemaTemplateAnpEpgUsegAttr("add", path, usegAttrMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -418,7 +418,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrUpdate(d *schema.ResourceData, m int
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/uSegAttrs/%s", templateName, anpName, epgName, name)
 	usegAttrApp := models.NewSchemaTemplateAnpEpgUsegAttr("replace", path, usegAttrMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -434,14 +434,14 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrRead(d *schema.ResourceData, m inter
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	templateName := d.Get("template_name").(string)
@@ -463,7 +463,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrRead(d *schema.ResourceData, m inter
 			anpCount, err := tempCont.ArrayCount("anps")
 
 			if err != nil {
-				return fmt.Errorf("No Anp found")
+				return errorForObjectNotFound(fmt.Errorf("No Anp found"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "anps")
@@ -476,7 +476,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrRead(d *schema.ResourceData, m inter
 					d.Set("anp_name", currentAnpName)
 					epgCount, err := anpCont.ArrayCount("epgs")
 					if err != nil {
-						return fmt.Errorf("No Epg found")
+						return errorForObjectNotFound(fmt.Errorf("No Epg found"), d.Id(), cont, d)
 					}
 					for k := 0; k < epgCount; k++ {
 						epgCont, err := anpCont.ArrayElement(k, "epgs")
@@ -488,7 +488,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrRead(d *schema.ResourceData, m inter
 							d.Set("epg_name", currentEpgName)
 							usegCount, err := epgCont.ArrayCount("uSegAttrs")
 							if err != nil {
-								return fmt.Errorf("No usegAttrs found")
+								return errorForObjectNotFound(fmt.Errorf("No usegAttrs found"), d.Id(), cont, d)
 							}
 							for s := 0; s < usegCount; s++ {
 								usegCont, err := epgCont.ArrayElement(s, "uSegAttrs")
@@ -633,7 +633,7 @@ func resourceMSOSchemaTemplateAnpEpgUsegAttrDelete(d *schema.ResourceData, m int
 
 	path := fmt.Sprintf("/templates/%s/anps/%s/epgs/%s/uSegAttrs/%s", templateName, anpName, epgName, name)
 	usegAttrApp := models.NewSchemaTemplateAnpEpgUsegAttr("remove", path, usegAttrMap)
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), usegAttrApp)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -0,0 +1,98 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSchemaTemplateApproval() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaTemplateApprovalExecute,
+		Read:   resourceMSOSchemaTemplateApprovalRead,
+		Update: resourceMSOSchemaTemplateApprovalExecute,
+		Delete: resourceMSOSchemaTemplateApprovalDelete,
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"submit",
+					"approve",
+					"deny",
+				}, false),
+			},
+			"comment": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"approval_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// resourceMSOSchemaTemplateApprovalExecute sends the approval action of the template, it runs again when an argument changes.
+func resourceMSOSchemaTemplateApprovalExecute(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Template Approval Action", d.Id())
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+
+	err := checkTemplateApprovalSupport(msoClient)
+	if err != nil {
+		return err
+	}
+	err = sendTemplateApprovalAction(msoClient, schemaId, templateName, d.Get("action").(string), d.Get("comment").(string))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/templates/%s/approval", schemaId, templateName))
+	log.Printf("[DEBUG] %s: Successful Template Approval Action", d.Id())
+	return resourceMSOSchemaTemplateApprovalRead(d, m)
+}
+
+func resourceMSOSchemaTemplateApprovalRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	state, err := getTemplateApprovalState(msoClient, d.Get("schema_id").(string), d.Get("template_name").(string))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), nil, d)
+	}
+	d.Set("approval_state", state)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSchemaTemplateApprovalDelete(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
@@ -46,6 +46,10 @@ func resourceMSOTemplateBD() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"display_name": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
@@ -116,9 +120,11 @@ func resourceMSOTemplateBD() *schema.Resource {
 				Computed: true,
 			},
 			"virtual_mac_address": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsMACAddress,
+				DiffSuppressFunc: suppressMacAddressDiff,
 			},
 			"unicast_routing": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -210,7 +216,7 @@ func resourceMSOTemplateBDImport(d *schema.ResourceData, m interface{}) ([]*sche
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -485,7 +491,7 @@ func resourceMSOTemplateBDCreate(d *schema.ResourceData, m interface{}) error {
 	dhcpPolList := make([]interface{}, 0)
 	if dhcpPolicies, ok := d.GetOk("dhcp_policies"); ok {
 		if versionInt == -1 {
-			dhcpRefList, err := mapDHCPPoliciesRefByName(schemaID, templateName, dhcpPolicies, msoClient)
+			dhcpRefList, err := mapDHCPPoliciesRefByName(schemaID, templateName, dhcpPolicies.(*schema.Set).List(), msoClient)
 			if err != nil {
 				return err
 			}
@@ -519,7 +525,7 @@ func resourceMSOTemplateBDCreate(d *schema.ResourceData, m interface{}) error {
 	vrfRefMap["vrfName"] = vrfName
 	path := fmt.Sprintf("/templates/%s/bds/-", templateName)
 	bdStruct := models.NewTemplateBD("add", path, name, displayName, layer2_unknown_unicast, unknown_multicast_flooding, multi_destination_flooding, ipv6_unknown_multicast_flooding, virtual_mac_address, description, intersite_bum_traffic, optimize_wan_bandwidth, layer2_stretch, layer3_multicast, arp_flooding, unicast_routing, vrfRefMap, dhcpPolMap, dhcpPolList)
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), bdStruct)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), bdStruct)
 
 	if err != nil {
 		return err
@@ -535,13 +541,13 @@ func resourceMSOTemplateBDRead(d *schema.ResourceData, m interface{}) error {
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -562,7 +568,7 @@ func resourceMSOTemplateBDRead(d *schema.ResourceData, m interface{}) error {
 		if apiTemplate == stateTemplate {
 			bdCount, err := tempCont.ArrayCount("bds")
 			if err != nil {
-				return fmt.Errorf("Unable to get BD list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get BD list"), d.Id(), cont, d)
 			}
 			for j := 0; j < bdCount; j++ {
 				bdCont, err := tempCont.ArrayElement(j, "bds")
@@ -816,7 +822,7 @@ func resourceMSOTemplateBDUpdate(d *schema.ResourceData, m interface{}) error {
 	dhcpPolList := make([]interface{}, 0)
 	if dhcpPolicies, ok := d.GetOk("dhcp_policies"); ok {
 		if versionInt == -1 {
-			dhcpRefList, err := mapDHCPPoliciesRefByName(schemaID, templateName, dhcpPolicies, msoClient)
+			dhcpRefList, err := mapDHCPPoliciesRefByName(schemaID, templateName, dhcpPolicies.(*schema.Set).List(), msoClient)
 			if err != nil {
 				return err
 			}
@@ -966,13 +972,16 @@ func resourceMSOTemplateBDUpdate(d *schema.ResourceData, m interface{}) error {
 
 func resourceMSOTemplateBDDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Template BD: Beginning Delete")
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 
 	schemaID := d.Get("schema_id").(string)
 	name := d.Get("name").(string)
 	templateName := d.Get("template_name").(string)
 	patchPayload := models.GetRemovePatchPayload(fmt.Sprintf("/templates/%s/bds/%s", templateName, name))
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), patchPayload)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), patchPayload)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -1023,13 +1032,13 @@ func getDHCPPolicesNameByRef(dhcpCount int, schemaId, stateTemplate string, bdCo
 // Returns:
 // - []interface{}: It returns a list of interface{} containing the UUIDs of the DHCP policies,
 // - error: An error if the retrieval fails.
-func mapDHCPPoliciesRefByName(schemaID, templateName string, dhcpPolicies interface{}, msoClient *client.Client) ([]interface{}, error) {
+func mapDHCPPoliciesRefByName(schemaID, templateName string, dhcpPolicies []interface{}, msoClient *client.Client) ([]interface{}, error) {
 	tenantID, err := msoClient.GetTenantIDFromSchemaTemplate(schemaID, templateName)
 	if err != nil {
 		return nil, err
 	}
 	dhcpPolicyNameList := make([]interface{}, 0)
-	for _, dhcpPolicy := range dhcpPolicies.(*schema.Set).List() {
+	for _, dhcpPolicy := range dhcpPolicies {
 		policy := dhcpPolicy.(map[string]interface{})
 		dhcpPolicyNameMap := make(map[string]interface{})
 		dhcpPolicyNameMap["relayName"] = policy["name"]
@@ -101,7 +101,7 @@ func resourceMSOTemplateBDSubnetImport(d *schema.ResourceData, m interface{}) ([
 	import_attribute := regexp.MustCompile("(.*)/ip/(.*)")
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +233,7 @@ func resourceMSOTemplateBDSubnetCreate(d *schema.ResourceData, m interface{}) er
 	path := fmt.Sprintf("/templates/%s/bds/%s/subnets/-", templateName, bdName)
 	bdSubnetStruct := models.NewTemplateBDSubnet("add", path, IP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary, Virtual)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), bdSubnetStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), bdSubnetStruct)
 
 	if err != nil {
 		return err
@@ -248,14 +248,14 @@ func resourceMSOTemplateBDSubnetRead(d *schema.ResourceData, m interface{}) erro
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -272,7 +272,7 @@ func resourceMSOTemplateBDSubnetRead(d *schema.ResourceData, m interface{}) erro
 
 			bdCount, err := tempCont.ArrayCount("bds")
 			if err != nil {
-				return fmt.Errorf("Unable to get BD list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get BD list"), d.Id(), cont, d)
 			}
 			for j := 0; j < bdCount; j++ {
 				bdCont, err := tempCont.ArrayElement(j, "bds")
@@ -284,7 +284,7 @@ func resourceMSOTemplateBDSubnetRead(d *schema.ResourceData, m interface{}) erro
 
 					count1, err := bdCont.ArrayCount("subnets")
 					if err != nil {
-						return fmt.Errorf("Unable to get Subnet List")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Subnet List"), d.Id(), cont, d)
 					}
 					for k := 0; k < count1; k++ {
 						subnetsCont, err := bdCont.ArrayElement(k, "subnets")
@@ -370,7 +370,7 @@ func resourceMSOTemplateBDSubnetUpdate(d *schema.ResourceData, m interface{}) er
 	}
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -418,7 +418,7 @@ func resourceMSOTemplateBDSubnetUpdate(d *schema.ResourceData, m interface{}) er
 							index := k
 							path := fmt.Sprintf("/templates/%s/bds/%s/subnets/%v", apiTemplate, apiBD, index)
 							bdSubnetStruct := models.NewTemplateBDSubnet("replace", path, apiIP, Desc, Scope, Shared, NoDefaultGateway, Querier, Primary, Virtual)
-							_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), bdSubnetStruct)
+							_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), bdSubnetStruct)
 							if err != nil {
 								return err
 							}
@@ -441,7 +441,7 @@ func resourceMSOTemplateBDSubnetDelete(d *schema.ResourceData, m interface{}) er
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -487,7 +487,7 @@ func resourceMSOTemplateBDSubnetDelete(d *schema.ResourceData, m interface{}) er
 						if apiIP == stateIP {
 							index := k
 							path := fmt.Sprintf("/templates/%s/bds/%s/subnets/%v", apiTemplate, apiBD, index)
-							response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
+							response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
 
 							// Ignoring Error with code 141: Resource Not Found when deleting
 							if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
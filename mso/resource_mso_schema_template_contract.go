@@ -45,6 +45,10 @@ func resourceMSOTemplateContract() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"display_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -114,6 +118,11 @@ func resourceMSOTemplateContract() *schema.Resource {
 					"oneWay",
 				}, false),
 			},
+			"reverse_filter_ports": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"filter_relationship": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -181,6 +190,12 @@ func resourceMSOTemplateContract() *schema.Resource {
 								"default",
 							}, false),
 						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(0, 128),
+						},
 					},
 				},
 			},
@@ -228,6 +243,24 @@ func resourceMSOTemplateContract() *schema.Resource {
 				return fmt.Errorf("The filter_type cannot be changed. Change detected from '%s' to '%s'.", stateFilterType, configFilterType)
 			}
 
+			// A bothWay contract has a single filter chain, a oneWay contract has a filter chain per direction.
+			filterType := diff.Get("filter_type").(string)
+			if filterType == "oneWay" && diff.HasChange("reverse_filter_ports") && diff.Get("reverse_filter_ports").(bool) {
+				return fmt.Errorf("The reverse_filter_ports can only be enabled when the filter_type of the contract is 'bothWay'.")
+			}
+			for _, relationship := range diff.Get("filter_relationship").([]interface{}) {
+				relationshipMap, ok := relationship.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				relationshipFilterType := relationshipMap["filter_type"].(string)
+				if filterType == "oneWay" && relationshipFilterType == "bothWay" {
+					return fmt.Errorf("The filter_type of filter_relationship %s must be 'provider_to_consumer' or 'consumer_to_provider' when the filter_type of the contract is 'oneWay'.", relationshipMap["filter_name"])
+				} else if filterType == "bothWay" && relationshipFilterType != "bothWay" && relationshipFilterType != "" {
+					return fmt.Errorf("The filter_type of filter_relationship %s must be 'bothWay' when the filter_type of the contract is 'bothWay', use a 'oneWay' contract for the '%s' filter chain.", relationshipMap["filter_name"], relationshipFilterType)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -298,6 +331,10 @@ func getFilterRelationshipsFromConfig(schemaId, templateName string, filterRelat
 			relationshipMap["priorityOverride"] = relationshipConfigMap["priority"].(string)
 		}
 
+		if relationshipConfigMap["description"].(string) != "" {
+			relationshipMap["description"] = relationshipConfigMap["description"].(string)
+		}
+
 		if relationshipConfigMap["filter_type"].(string) == "bothWay" {
 			filterRelationships = append(filterRelationships, relationshipMap)
 		} else if relationshipConfigMap["filter_type"].(string) == "provider_to_consumer" {
@@ -311,6 +348,27 @@ func getFilterRelationshipsFromConfig(schemaId, templateName string, filterRelat
 
 }
 
+// checkFilterRelationshipDescriptionSupport returns an error when the NDO version does not support descriptions on filter relationships.
+func checkFilterRelationshipDescriptionSupport(msoClient *client.Client) error {
+	versionInt, err := msoClient.CompareVersion("4.0.0.0")
+	if err != nil {
+		return err
+	}
+	if versionInt == 1 {
+		return fmt.Errorf("The description of a filter relationship is only supported on NDO version 4.0 or higher.")
+	}
+	return nil
+}
+
+func hasFilterRelationshipDescription(filterRelationshipsConfig []interface{}) bool {
+	for _, relationshipConfig := range filterRelationshipsConfig {
+		if relationshipConfig.(map[string]interface{})["description"].(string) != "" {
+			return true
+		}
+	}
+	return false
+}
+
 func setFilterRelationshipList(relationships []interface{}, filterList []map[string]interface{}, filterType string) []map[string]interface{} {
 	for _, relationship := range relationships {
 		relationshipMap := relationship.(map[string]interface{})
@@ -331,6 +389,9 @@ func setFilterRelationshipList(relationships []interface{}, filterList []map[str
 		if val, ok := relationshipMap["priorityOverride"]; val != nil && ok {
 			filterMap["priority"] = val
 		}
+		if val, ok := relationshipMap["description"]; val != nil && ok {
+			filterMap["description"] = val
+		}
 		filterList = append(filterList, filterMap)
 	}
 	return filterList
@@ -362,6 +423,9 @@ func setContractFromSchema(d *schema.ResourceData, schemaCont *container.Contain
 					if val, ok := contractDetails["filterType"]; val != nil && ok {
 						d.Set("filter_type", val.(string))
 					}
+					if val, ok := contractDetails["reverseFilterPorts"]; val != nil && ok {
+						d.Set("reverse_filter_ports", val.(bool))
+					}
 					if val, ok := contractDetails["prio"]; val != nil && ok {
 						d.Set("priority", val.(string))
 					}
@@ -464,7 +528,7 @@ func resourceMSOTemplateContractImport(d *schema.ResourceData, m interface{}) ([
 	schemaId := splitImport[0]
 	templateName := splitImport[2]
 	contractName := splitImport[4]
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -492,6 +556,12 @@ func resourceMSOTemplateContractCreate(d *schema.ResourceData, m interface{}) er
 	// TODO remove when filter_relationships and directives are deprecated on next mayor version
 	deprecatedFilterRelationship := d.Get("filter_relationships").(map[string]interface{})
 	directives := d.Get("directives").([]interface{})
+	if hasFilterRelationshipDescription(filterRelationship) {
+		err := checkFilterRelationshipDescriptionSupport(msoClient)
+		if err != nil {
+			return err
+		}
+	}
 	var filterRelationships, filterRelationshipsProviderToConsumer, filterRelationshipsConsumerToProvider []interface{}
 	if len(deprecatedFilterRelationship) > 0 {
 		filterRelationships = getDeprecatedFilterRelationshipsFromConfig(schemaId, templateName, deprecatedFilterRelationship, directives)
@@ -506,7 +576,10 @@ func resourceMSOTemplateContractCreate(d *schema.ResourceData, m interface{}) er
 	}
 	path := createMSOTemplateContractPath(templateName, "-")
 	contractStruct := models.NewTemplateContract("add", path, contractName, displayName, scope, filterType, targetDscp, priority, description, filterRelationships, filterRelationshipsProviderToConsumer, filterRelationshipsConsumerToProvider)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), contractStruct)
+	if reverseFilterPorts, ok := d.GetOkExists("reverse_filter_ports"); ok {
+		contractStruct.Value["reverseFilterPorts"] = reverseFilterPorts.(bool)
+	}
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), contractStruct)
 	if err != nil {
 		return err
 	}
@@ -520,7 +593,7 @@ func resourceMSOTemplateContractRead(d *schema.ResourceData, m interface{}) erro
 	schemaId := d.Get("schema_id").(string)
 	templateName := d.Get("template_name").(string)
 	contractName := d.Get("contract_name").(string)
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
 	}
@@ -542,6 +615,12 @@ func resourceMSOTemplateContractUpdate(d *schema.ResourceData, m interface{}) er
 	targetDscp := d.Get("target_dscp").(string)
 	filterType := d.Get("filter_type").(string)
 	filterRelationship := d.Get("filter_relationship").([]interface{})
+	if hasFilterRelationshipDescription(filterRelationship) {
+		err := checkFilterRelationshipDescriptionSupport(msoClient)
+		if err != nil {
+			return err
+		}
+	}
 
 	// TODO remove when filter_relationships and directives are deprecated on next mayor version
 	directives := d.Get("directives").([]interface{})
@@ -560,9 +639,14 @@ func resourceMSOTemplateContractUpdate(d *schema.ResourceData, m interface{}) er
 		description = Description.(string)
 	}
 
+	// The contract is replaced as a whole with a single op, which also replaces all filter relationships of the contract.
+	// Relationships that are managed by mso_schema_template_contract_filter are removed when they are not configured in this resource.
 	path := createMSOTemplateContractPath(templateName, contractName)
 	contractStruct := models.NewTemplateContract("replace", path, contractName, displayName, scope, filterType, targetDscp, priority, description, filterRelationships, filterRelationshipsProviderToConsumer, filterRelationshipsConsumerToProvider)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), contractStruct)
+	if reverseFilterPorts, ok := d.GetOkExists("reverse_filter_ports"); ok {
+		contractStruct.Value["reverseFilterPorts"] = reverseFilterPorts.(bool)
+	}
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), contractStruct)
 	if err != nil {
 		return err
 	}
@@ -571,9 +655,12 @@ func resourceMSOTemplateContractUpdate(d *schema.ResourceData, m interface{}) er
 
 func resourceMSOTemplateContractDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Delete", d.Id())
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 	path := createMSOTemplateContractPath(d.Get("template_name").(string), d.Get("contract_name").(string))
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(path))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(path))
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
 		return err
 	}
@@ -108,6 +108,12 @@ func resourceMSOTemplateContractFilter() *schema.Resource {
 					"default",
 				}, false),
 			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
 		}),
 	}
 }
@@ -157,6 +163,7 @@ func setContractFilterFromSchema(d *schema.ResourceData, schemaCont *container.C
 									d.Set("directives", filterRelationshipMap["directives"])
 									d.Set("action", filterRelationshipMap["action"])
 									d.Set("priority", filterRelationshipMap["priority"])
+									d.Set("description", filterRelationshipMap["description"])
 									d.Set("filter_type", filterType)
 									d.Set("filter_schema_id", filterSchemaId)
 									d.Set("filter_template_name", filterTemplateName)
@@ -188,7 +195,7 @@ func resourceMSOTemplateContractFilterImport(d *schema.ResourceData, m interface
 	filterTemplateName := splitImport[7]
 	filterName := splitImport[8]
 
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -233,7 +240,14 @@ func resourceMSOTemplateContractFilterCreate(d *schema.ResourceData, m interface
 
 	path := createMSOTemplateContractFilterPath(templateName, contractName, getFilterRelationshipTypeMap()[filterType], "-")
 	filterStruct := models.NewTemplateContractFilterRelationShip("add", path, action, priority, "", filterRefMap, directives)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+	if description := d.Get("description").(string); description != "" {
+		err := checkFilterRelationshipDescriptionSupport(msoClient)
+		if err != nil {
+			return err
+		}
+		filterStruct.Value["description"] = description
+	}
+	err := patchContractFilter(msoClient, schemaId, filterStruct)
 	if err != nil {
 		return err
 	}
@@ -260,7 +274,7 @@ func resourceMSOTemplateContractFilterRead(d *schema.ResourceData, m interface{}
 		filterTemplateName = tempVar.(string)
 	}
 
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), schemaCont, d)
 	}
@@ -300,7 +314,14 @@ func resourceMSOTemplateContractFilterUpdate(d *schema.ResourceData, m interface
 
 	path := createMSOTemplateContractFilterPath(templateName, d.Get("contract_name").(string), getFilterRelationshipTypeMap()[d.Get("filter_type").(string)], filterName)
 	filterStruct := models.NewTemplateContractFilterRelationShip("replace", path, action, priority, "", filterRefMap, directives)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+	if description := d.Get("description").(string); description != "" {
+		err := checkFilterRelationshipDescriptionSupport(msoClient)
+		if err != nil {
+			return err
+		}
+		filterStruct.Value["description"] = description
+	}
+	err := patchContractFilter(msoClient, schemaId, filterStruct)
 	if err != nil {
 		return err
 	}
@@ -315,8 +336,8 @@ func resourceMSOTemplateContractFilterDelete(d *schema.ResourceData, m interface
 	templateName := d.Get("template_name").(string)
 	filterName := d.Get("filter_name").(string)
 	path := createMSOTemplateContractFilterPath(templateName, d.Get("contract_name").(string), getFilterRelationshipTypeMap()[d.Get("filter_type").(string)], filterName)
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)), models.GetRemovePatchPayload(path))
-	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
+	err := patchContractFilter(msoClient, d.Get("schema_id").(string), models.GetRemovePatchPayload(path))
+	if err != nil {
 		return err
 	}
 	d.SetId("")
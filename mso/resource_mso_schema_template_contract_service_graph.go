@@ -117,7 +117,7 @@ func resourceMSOSchemaTemplateContractServiceGraphImport(d *schema.ResourceData,
 	d.Set("contract_name", serviceGraphTokens[4])
 
 	msoClient := m.(*client.Client)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", serviceGraphTokens[0]))
+	cont, err := getSchemaCont(msoClient, serviceGraphTokens[0])
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +154,7 @@ func resourceMSOSchemaTemplateContractServiceGraphRead(d *schema.ResourceData, m
 	log.Printf("[DEBUG] Begining Read Template Contract Service Graph")
 	msoClient := m.(*client.Client)
 	schemaID := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -162,7 +162,7 @@ func resourceMSOSchemaTemplateContractServiceGraphRead(d *schema.ResourceData, m
 	err = setSchemaTemplateContractServiceGraphAttrs(cont, d)
 
 	if err != nil {
-		return err
+		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	log.Printf("[DEBUG] Completed Read Template Contract Service Graph")
 	return nil
@@ -177,7 +177,7 @@ func resourceMSOSchemaTemplateContractServiceGraphDelete(d *schema.ResourceData,
 	contractName := d.Get("contract_name").(string)
 
 	tempPath := fmt.Sprintf("/templates/%s/contracts/%s/serviceGraphRelationship", templateName, contractName)
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(tempPath))
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), models.GetRemovePatchPayload(tempPath))
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -394,7 +394,7 @@ func postSchemaTemplateContractServiceGraphConfig(ops string, d *schema.Resource
 		serviceGraphRef["templateName"] = templateName
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", serviceGraphRef["schemaId"].(string)))
+	cont, err := getSchemaCont(msoClient, serviceGraphRef["schemaId"].(string))
 	if err != nil {
 		return err
 	}
@@ -420,7 +420,7 @@ func postSchemaTemplateContractServiceGraphConfig(ops string, d *schema.Resource
 
 	contractServiceGraphPath := fmt.Sprintf("/templates/%s/contracts/%s/serviceGraphRelationship", templateName, contractName)
 	tempConGraph := models.NewTemplateContractServiceGraph(ops, contractServiceGraphPath, serviceGraphRef, contractServiceGraphNodes)
-	_, err = msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), tempConGraph)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), tempConGraph)
 
 	if err != nil {
 		return err
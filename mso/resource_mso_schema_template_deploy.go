@@ -1,8 +1,10 @@
 package mso
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/models"
@@ -19,6 +21,13 @@ func resourceMSOSchemaTemplateDeploy() *schema.Resource {
 
 		SchemaVersion: version,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: (map[string]*schema.Schema{
 			"schema_id": &schema.Schema{
 				Type:         schema.TypeString,
@@ -40,6 +49,12 @@ func resourceMSOSchemaTemplateDeploy() *schema.Resource {
 				Default:  "re-deploy",
 			},
 
+			"redeploy_triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"undeploy": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -79,7 +94,15 @@ func resourceMSOSchemaTemplateDeployCreate(d *schema.ResourceData, m interface{}
 	}
 	path := fmt.Sprintf("/api/v1/execute/schema/%s/template/%s%s", schemaID, templateName, queryString)
 	msoClient := m.(*client.Client)
-	_, err := msoClient.GetViaURL(path)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), timeout)
+	defer cancel()
+
+	_, _, err := doRequestWithContext(ctx, msoClient, "GET", path, nil)
 	if err != nil {
 		return err
 	}
@@ -91,7 +114,7 @@ func resourceMSOSchemaTemplateDeployCreate(d *schema.ResourceData, m interface{}
 func resourceMSOSchemaTemplateDeployRead(d *schema.ResourceData, m interface{}) error {
 	// We set this intentionally blank so that we execute this in every run.
 	d.Set("force_apply", "")
-	return nil
+	return readDeployedObject(d, m.(*client.Client), fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)))
 }
 
 func resourceMSOSchemaTemplateDeployDelete(d *schema.ResourceData, m interface{}) error {
@@ -99,7 +122,7 @@ func resourceMSOSchemaTemplateDeployDelete(d *schema.ResourceData, m interface{}
 	msoClient := m.(*client.Client)
 	templateName := d.Get("template_name").(string)
 	schemaID := d.Get("schema_id").(string)
-	schemaCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	schemaCont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -109,6 +132,9 @@ func resourceMSOSchemaTemplateDeployDelete(d *schema.ResourceData, m interface{}
 		return err
 	}
 
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
 	for i := 0; i < siteCount; i++ {
 		siteCont, err := schemaCont.ArrayElement(i, "sites")
 		if err != nil {
@@ -122,7 +148,7 @@ func resourceMSOSchemaTemplateDeployDelete(d *schema.ResourceData, m interface{}
 			log.Printf("[DEBUG] %s: Undeploying site: %s for Template: %s", d.Id(), currentSiteId, currentTemplateName)
 			queryString := fmt.Sprintf("?undeploy=%s", currentSiteId)
 			path := fmt.Sprintf("/api/v1/execute/schema/%s/template/%s%s", schemaID, templateName, queryString)
-			_, err := msoClient.GetViaURL(path)
+			_, _, err := doRequestWithContext(ctx, msoClient, "GET", path, nil)
 			if err != nil {
 				return err
 			}
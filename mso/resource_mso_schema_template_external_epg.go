@@ -45,6 +45,10 @@ func resourceMSOTemplateExtenalepg() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 1000),
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"display_name": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
@@ -148,7 +152,7 @@ func resourceMSOTemplateExtenalepgImport(d *schema.ResourceData, m interface{})
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -420,7 +424,7 @@ func resourceMSOTemplateExtenalepgCreate(d *schema.ResourceData, m interface{})
 		}
 
 		d.Partial(true)
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
+		_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
 		if err != nil {
 			return err
 		}
@@ -430,7 +434,7 @@ func resourceMSOTemplateExtenalepgCreate(d *schema.ResourceData, m interface{})
 		path := fmt.Sprintf("/templates/%s/externalEpgs/-", templateName)
 		externalepgStruct := models.NewTemplateExternalepg("add", path, externalEpgName, displayName, extEpgType, description, preferredGroup, vrfRefMap, l3outRefMap, anpRefMap, nil)
 
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+		_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 		if err != nil {
 			return err
 		}
@@ -445,13 +449,13 @@ func resourceMSOTemplateExtenalepgRead(d *schema.ResourceData, m interface{}) er
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -466,7 +470,7 @@ func resourceMSOTemplateExtenalepgRead(d *schema.ResourceData, m interface{}) er
 		if apiTemplate == stateTemplate {
 			externalepgCount, err := tempCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Externalepg list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Externalepg list"), d.Id(), cont, d)
 			}
 			for j := 0; j < externalepgCount; j++ {
 				externalepgCont, err := tempCont.ArrayElement(j, "externalEpgs")
@@ -711,7 +715,7 @@ func resourceMSOTemplateExtenalepgUpdate(d *schema.ResourceData, m interface{})
 			siteEpgMap["externalEpgRef"] = epgRefMap
 			siteEpgMap["l3outDn"] = "l3out"
 
-			cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+			cont, err := getSchemaCont(msoClient, schemaID)
 			if err != nil {
 				return err
 			}
@@ -734,7 +738,7 @@ func resourceMSOTemplateExtenalepgUpdate(d *schema.ResourceData, m interface{})
 		}
 
 		d.Partial(true)
-		_, err1 := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
+		_, err1 := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
 		if err1 != nil {
 			return err1
 		}
@@ -744,7 +748,7 @@ func resourceMSOTemplateExtenalepgUpdate(d *schema.ResourceData, m interface{})
 		path := fmt.Sprintf("/templates/%s/externalEpgs/%s", templateName, externalEpgName)
 		externalepgStruct := models.NewTemplateExternalepg("replace", path, externalEpgName, displayName, extEpgType, description, preferredGroup, vrfRefMap, l3outRefMap, anpRefMap, nil)
 
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+		_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 		if err != nil {
 			return err
 		}
@@ -754,6 +758,9 @@ func resourceMSOTemplateExtenalepgUpdate(d *schema.ResourceData, m interface{})
 
 func resourceMSOTemplateExtenalepgDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Template Externalepg: Beginning Update")
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 
 	schemaID := d.Get("schema_id").(string)
@@ -910,7 +917,7 @@ func resourceMSOTemplateExtenalepgDelete(d *schema.ResourceData, m interface{})
 			structList = append(structList, siteExternalepgStruct)
 		}
 
-		response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
+		response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), structList...)
 
 		// Ignoring Error with code 141: Resource Not Found when deleting
 		if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -921,7 +928,7 @@ func resourceMSOTemplateExtenalepgDelete(d *schema.ResourceData, m interface{})
 		path := fmt.Sprintf("/templates/%s/externalEpgs/%s", templateName, externalEpgName)
 		externalepgStruct := models.NewTemplateExternalepg("remove", path, externalEpgName, displayName, extEpgType, description, preferredGroup, vrfRefMap, l3outRefMap, anpRefMap, nil)
 
-		response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+		response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 
 		// Ignoring Error with code 141: Resource Not Found when deleting
 		if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -78,7 +78,7 @@ func resourceMSOTemplateExternalEpgContractImport(d *schema.ResourceData, m inte
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +181,7 @@ func resourceMSOTemplateExternalEpgContractCreate(d *schema.ResourceData, m inte
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/contractRelationships/-", templateName, epgName)
 	contractStruct := models.NewTemplateExternalEpgContract("add", path, relationshipType, contractRefMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
 
 	if err != nil {
 		return err
@@ -196,13 +196,13 @@ func resourceMSOTemplateExternalEpgContractRead(d *schema.ResourceData, m interf
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -220,7 +220,7 @@ func resourceMSOTemplateExternalEpgContractRead(d *schema.ResourceData, m interf
 			d.Set("template_name", apiTemplate)
 			epgCount, err := tempCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return fmt.Errorf("Unable to get External Epg list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get External Epg list"), d.Id(), cont, d)
 			}
 			for j := 0; j < epgCount; j++ {
 				epgCont, err := tempCont.ArrayElement(j, "externalEpgs")
@@ -232,7 +232,7 @@ func resourceMSOTemplateExternalEpgContractRead(d *schema.ResourceData, m interf
 					d.Set("external_epg_name", apiEpg)
 					contractCount, err := epgCont.ArrayCount("contractRelationships")
 					if err != nil {
-						return fmt.Errorf("Unable to get contract Relationships list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get contract Relationships list"), d.Id(), cont, d)
 					}
 					for k := 0; k < contractCount; k++ {
 						contractCont, err := epgCont.ArrayElement(k, "contractRelationships")
@@ -295,7 +295,7 @@ func resourceMSOTemplateExternalEpgContractUpdate(d *schema.ResourceData, m inte
 	contractRefMap["templateName"] = contract_template_name
 	contractRefMap["contractName"] = contractName
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -312,7 +312,7 @@ func resourceMSOTemplateExternalEpgContractUpdate(d *schema.ResourceData, m inte
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/contractRelationships/%s", templateName, epgName, indexs)
 	contractStruct := models.NewTemplateExternalEpgContract("replace", path, relationshipType, contractRefMap)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
 
 	if errs != nil {
 		return errs
@@ -329,7 +329,7 @@ func resourceMSOTemplateExternalEpgContractDelete(d *schema.ResourceData, m inte
 	templateName := d.Get("template_name").(string)
 	epgName := d.Get("external_epg_name").(string)
 	id := d.Id()
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -348,7 +348,7 @@ func resourceMSOTemplateExternalEpgContractDelete(d *schema.ResourceData, m inte
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/contractRelationships/%s", templateName, epgName, indexs)
 	contractStruct := models.NewTemplateExternalEpgContract("remove", path, "", nil)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -95,7 +95,7 @@ func resourceSchemaTemplateExternalEPGSelectorImport(d *schema.ResourceData, m i
 	externalEpgName := get_attribute[4]
 	name := get_attribute[6]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +220,7 @@ func resourceSchemaTemplateExternalEPGSelectorCreate(d *schema.ResourceData, m i
 
 	schemaTemplateExternalEPGSelector := models.NewSchemaTemplateExternalEPGSelector("add", path, schemaTemplateextrepgSelectorMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaID), schemaTemplateExternalEPGSelector)
+	_, err := patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaID), schemaTemplateExternalEPGSelector)
 	if err != nil {
 		return err
 	}
@@ -268,7 +268,7 @@ func resourceSchemaTemplateExternalEPGSelectorUpdate(d *schema.ResourceData, m i
 
 	schemaTemplateExternalEpgSelector := models.NewSchemaTemplateExternalEPGSelector("replace", path, schemaTemplateextrepgSelectorMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaTemplateExternalEpgSelector)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaTemplateExternalEpgSelector)
 	if err != nil {
 		return err
 	}
@@ -287,14 +287,14 @@ func resourceSchemaTemplateExternalEPGSelectorRead(d *schema.ResourceData, m int
 	template := d.Get("template_name").(string)
 	externalEpgName := d.Get("external_epg_name").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return errorForObjectNotFound(err, dn, cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No templates found")
+		return errorForObjectNotFound(fmt.Errorf("No templates found"), d.Id(), cont, d)
 	}
 
 	for i := 0; i < count; i++ {
@@ -307,7 +307,7 @@ func resourceSchemaTemplateExternalEPGSelectorRead(d *schema.ResourceData, m int
 		if tempName == template {
 			extrEpgCount, err := tempCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return fmt.Errorf("no externalEpgs found")
+				return errorForObjectNotFound(fmt.Errorf("no externalEpgs found"), d.Id(), cont, d)
 			}
 
 			for j := 0; j < extrEpgCount; j++ {
@@ -320,7 +320,7 @@ func resourceSchemaTemplateExternalEPGSelectorRead(d *schema.ResourceData, m int
 				if extrEpgName == externalEpgName {
 					selectorCount, err := extrEpgCont.ArrayCount("selectors")
 					if err != nil {
-						return fmt.Errorf("No selectors found")
+						return errorForObjectNotFound(fmt.Errorf("No selectors found"), d.Id(), cont, d)
 					}
 
 					for k := 0; k < selectorCount; k++ {
@@ -387,7 +387,7 @@ func resourceSchemaTemplateExternalEPGSelectorDelete(d *schema.ResourceData, m i
 
 	schemaTemplateExternalEpgSelector := models.NewSchemaTemplateExternalEPGSelector("remove", path, nil)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaTemplateExternalEpgSelector)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), schemaTemplateExternalEpgSelector)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
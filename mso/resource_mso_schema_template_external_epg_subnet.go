@@ -80,7 +80,7 @@ func resourceMSOTemplateExtenalepgSubnetImport(d *schema.ResourceData, m interfa
 	import_split := import_attribute.FindStringSubmatch(d.Id())
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +187,7 @@ func resourceMSOTemplateExtenalepgSubnetCreate(d *schema.ResourceData, m interfa
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/subnets/-", templateName, extenalepgName)
 	externalepgStruct := models.NewTemplateExternalEpgSubnet("add", path, IP, Name, Scope, Aggregate)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 
 	if err != nil {
 		return err
@@ -202,13 +202,13 @@ func resourceMSOTemplateExtenalepgSubnetRead(d *schema.ResourceData, m interface
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -225,7 +225,7 @@ func resourceMSOTemplateExtenalepgSubnetRead(d *schema.ResourceData, m interface
 		if apiTemplate == stateTemplate {
 			externalepgCount, err := tempCont.ArrayCount("externalEpgs")
 			if err != nil {
-				return fmt.Errorf("Unable to get Externalepg list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Externalepg list"), d.Id(), cont, d)
 			}
 			for j := 0; j < externalepgCount; j++ {
 				externalepgCont, err := tempCont.ArrayElement(j, "externalEpgs")
@@ -236,7 +236,7 @@ func resourceMSOTemplateExtenalepgSubnetRead(d *schema.ResourceData, m interface
 				if apiExternalepg == stateExternalepg {
 					subnetCount, err := externalepgCont.ArrayCount("subnets")
 					if err != nil {
-						return fmt.Errorf("Unable to get subnets list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get subnets list"), d.Id(), cont, d)
 					}
 					for k := 0; k < subnetCount; k++ {
 						subnetsCont, err := externalepgCont.ArrayElement(k, "subnets")
@@ -308,7 +308,7 @@ func resourceMSOTemplateExtenalepgSubnetUpdate(d *schema.ResourceData, m interfa
 		Aggregate = tempVar.([]interface{})
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -317,14 +317,14 @@ func resourceMSOTemplateExtenalepgSubnetUpdate(d *schema.ResourceData, m interfa
 		return err
 	}
 	if index == -1 {
-		fmt.Errorf("The given subnet ip is not found")
+		return fmt.Errorf("The given subnet ip is not found")
 	}
 	indexs := strconv.Itoa(index)
 
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/subnets/%s", templateName, extenalepgName, indexs)
 	externalepgStruct := models.NewTemplateExternalEpgSubnet("replace", path, IP, Name, Scope, Aggregate)
 
-	_, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+	_, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 	if errs != nil {
 		return errs
 	}
@@ -355,7 +355,7 @@ func resourceMSOTemplateExtenalepgSubnetDelete(d *schema.ResourceData, m interfa
 		Aggregate = tempVar.([]interface{})
 	}
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -372,7 +372,7 @@ func resourceMSOTemplateExtenalepgSubnetDelete(d *schema.ResourceData, m interfa
 	path := fmt.Sprintf("/templates/%s/externalEpgs/%s/subnets/%s", templateName, extenalepgName, indexs)
 	externalepgStruct := models.NewTemplateExternalEpgSubnet("remove", path, IP, Name, Scope, Aggregate)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), externalepgStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
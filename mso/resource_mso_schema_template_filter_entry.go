@@ -125,7 +125,7 @@ func resourceMSOSchemaTemplateFilterEntryImport(d *schema.ResourceData, m interf
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -295,7 +295,7 @@ func resourceMSOSchemaTemplateFilterEntryCreate(d *schema.ResourceData, m interf
 	entries = append(entries, entryMap)
 	foundEntry := false
 	foundFilter := false
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -350,7 +350,7 @@ func resourceMSOSchemaTemplateFilterEntryCreate(d *schema.ResourceData, m interf
 					if !foundEntry {
 						pathf := fmt.Sprintf("/templates/%s/filters/%s/entries/-", stateTemplate, filterName)
 						filterStruct := models.NewTemplateFilterEntry("add", pathf, entryName, entryDisplayName, entryDescription, etherType, arpFlag, ipProtocol, sourceFrom, sourceTo, destinationFrom, destinationTo, matchOnlyFragments, stateful, tcpSessionRules)
-						_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+						_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
 						if err != nil {
 							return err
 						}
@@ -362,7 +362,7 @@ func resourceMSOSchemaTemplateFilterEntryCreate(d *schema.ResourceData, m interf
 	if !foundFilter {
 		pathf := fmt.Sprintf("/templates/%s/filters/-", stateTemplate)
 		filterStruct := models.NewTemplateFilter("add", pathf, filterName, displayFilterName, entries)
-		_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+		_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
 		if err != nil {
 			return err
 		}
@@ -377,13 +377,13 @@ func resourceMSOSchemaTemplateFilterEntryRead(d *schema.ResourceData, m interfac
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -401,7 +401,7 @@ func resourceMSOSchemaTemplateFilterEntryRead(d *schema.ResourceData, m interfac
 			d.Set("template_name", apiTemplate)
 			anpCount, err := tempCont.ArrayCount("filters")
 			if err != nil {
-				return fmt.Errorf("Unable to get Filter list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get Filter list"), d.Id(), cont, d)
 			}
 			for j := 0; j < anpCount; j++ {
 				anpCont, err := tempCont.ArrayElement(j, "filters")
@@ -414,7 +414,7 @@ func resourceMSOSchemaTemplateFilterEntryRead(d *schema.ResourceData, m interfac
 					d.Set("display_name", models.StripQuotes(anpCont.S("displayName").String()))
 					entriesCount, err := anpCont.ArrayCount("entries")
 					if err != nil {
-						return fmt.Errorf("Unable to get Entry list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get Entry list"), d.Id(), cont, d)
 					}
 					for k := 0; k < entriesCount; k++ {
 						entriesCont, err := anpCont.ArrayElement(k, "entries")
@@ -528,7 +528,7 @@ func resourceMSOSchemaTemplateFilterEntryUpdate(d *schema.ResourceData, m interf
 
 	pathf := fmt.Sprintf("/templates/%s/filters/%s/entries/%s", stateTemplate, filterName, entryName)
 	filterStruct := models.NewTemplateFilterEntry("replace", pathf, entryName, entryDisplayName, entryDescription, etherType, arpFlag, ipProtocol, sourceFrom, sourceTo, destinationFrom, destinationTo, matchOnlyFragments, stateful, tcpSessionRules)
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
 	if err != nil {
 		return err
 	}
@@ -600,7 +600,7 @@ func resourceMSOSchemaTemplateFilterEntryDelete(d *schema.ResourceData, m interf
 		entryMap["tcpSessionRules"] = tcpSessionRules
 	}
 	entries = append(entries, entryMap)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -640,7 +640,7 @@ func resourceMSOSchemaTemplateFilterEntryDelete(d *schema.ResourceData, m interf
 					if entriesCount == 1 {
 						path := fmt.Sprintf("/templates/%s/filters/%s", apiTemplate, apiFilterName)
 						filterStruct := models.NewTemplateFilter("remove", path, apiFilterName, displayName, entries)
-						response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+						response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
 
 						// Ignoring Error with code 141: Resource Not Found when deleting
 						if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -649,7 +649,7 @@ func resourceMSOSchemaTemplateFilterEntryDelete(d *schema.ResourceData, m interf
 					} else {
 						pathf := fmt.Sprintf("/templates/%s/filters/%s/entries/%s", stateTemplate, filterName, entryName)
 						filterStruct := models.NewTemplateFilterEntry("remove", pathf, entryName, entryDisplayName, entryDescription, etherType, arpFlag, ipProtocol, sourceFrom, sourceTo, destinationFrom, destinationTo, matchOnlyFragments, stateful, tcpSessionRules)
-						response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
+						response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), filterStruct)
 
 						// Ignoring Error with code 141: Resource Not Found when deleting
 						if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
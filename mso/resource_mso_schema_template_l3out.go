@@ -79,7 +79,7 @@ func resourceMSOTemplateL3outImport(d *schema.ResourceData, m interface{}) ([]*s
 
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", get_attribute[0]))
+	cont, err := getSchemaCont(msoClient, get_attribute[0])
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +175,7 @@ func resourceMSOTemplateL3outCreate(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/templates/%s/intersiteL3outs/-", templateName)
 	l3outStruct := models.NewTemplateL3out("add", path, l3outName, displayName, description, vrfRefMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
 
 	if err != nil {
 		return err
@@ -190,13 +190,13 @@ func resourceMSOTemplateL3outRead(d *schema.ResourceData, m interface{}) error {
 
 	schemaId := d.Get("schema_id").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -211,7 +211,7 @@ func resourceMSOTemplateL3outRead(d *schema.ResourceData, m interface{}) error {
 		if apiTemplate == stateTemplate {
 			l3outCount, err := tempCont.ArrayCount("intersiteL3outs")
 			if err != nil {
-				return fmt.Errorf("Unable to get L3out list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get L3out list"), d.Id(), cont, d)
 			}
 			for j := 0; j < l3outCount; j++ {
 				l3outCont, err := tempCont.ArrayElement(j, "intersiteL3outs")
@@ -286,7 +286,7 @@ func resourceMSOTemplateL3outUpdate(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/templates/%s/intersiteL3outs/%s", templateName, l3outName)
 	l3outStruct := models.NewTemplateL3out("replace", path, l3outName, displayName, description, vrfRefMap)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
 
 	if err != nil {
 		return err
@@ -330,7 +330,7 @@ func resourceMSOTemplateL3outDelete(d *schema.ResourceData, m interface{}) error
 	path := fmt.Sprintf("/templates/%s/intersiteL3outs/%s", templateName, l3outName)
 	l3outStruct := models.NewTemplateL3out("remove", path, l3outName, displayName, description, vrfRefMap)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), l3outStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -0,0 +1,95 @@
+package mso
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSchemaTemplateReconcile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSchemaTemplateReconcileExecute,
+		Read:   resourceMSOSchemaTemplateReconcileRead,
+		Update: resourceMSOSchemaTemplateReconcileExecute,
+		Delete: resourceMSOSchemaTemplateReconcileDelete,
+
+		SchemaVersion: version,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			msoClient := v.(*client.Client)
+			if msoClient.GetPlatform() != "nd" {
+				return errors.New(`The 'mso_schema_template_reconcile' resource is only supported for nd based platforms, 'platform=nd' must be configured in the provider section of your configuration.`)
+			}
+			return nil
+		},
+
+		Schema: (map[string]*schema.Schema{
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// resourceMSOSchemaTemplateReconcileExecute redeploys the template to resolve the drift of its sites and waits until the redeploy completed on all sites.
+func resourceMSOSchemaTemplateReconcileExecute(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Template Reconciliation", d.Id())
+	msoClient := m.(*client.Client)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), timeout)
+	defer cancel()
+
+	taskId, err := startDeployTask(ctx, msoClient, schemaId, templateName, true)
+	if err != nil {
+		return err
+	}
+	d.Set("task_id", taskId)
+	err = verifyDeployTask(ctx, msoClient, taskId)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(schemaId + "/templates/" + templateName)
+	log.Printf("[DEBUG] %s: Successful Template Reconciliation", d.Id())
+	return resourceMSOSchemaTemplateReconcileRead(d, m)
+}
+
+func resourceMSOSchemaTemplateReconcileRead(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func resourceMSOSchemaTemplateReconcileDelete(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
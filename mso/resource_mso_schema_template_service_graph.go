@@ -96,7 +96,7 @@ func resourceMSOSchemaTemplateServiceGraphImport(d *schema.ResourceData, m inter
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +177,7 @@ func resourceMSOSchemaTemplateServiceGraphCreate(d *schema.ResourceData, m inter
 	templatePath := fmt.Sprintf("/templates/%s/serviceGraphs/-", templateName)
 	templatePatchStruct := models.NewTemplateServiceGraph("add", templatePath, templatePayload)
 
-	_, err = msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), templatePatchStruct)
+	_, err = patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), templatePatchStruct)
 
 	if err != nil {
 		return err
@@ -196,7 +196,7 @@ func resourceMSOSchemaTemplateServiceGraphRead(d *schema.ResourceData, m interfa
 	msoClient := m.(*client.Client)
 
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
@@ -274,7 +274,7 @@ func resourceMSOSchemaTemplateServiceGraphUpdate(d *schema.ResourceData, m inter
 
 		templatePath := fmt.Sprintf("/templates/%s/serviceGraphs/%s/description", templateName, graphName)
 		graphUpdate := models.NewTemplateServiceGraphUpdate("replace", templatePath, desc)
-		_, err := msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), graphUpdate)
+		_, err := patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), graphUpdate)
 		if err != nil {
 			return err
 		}
@@ -288,7 +288,7 @@ func resourceMSOSchemaTemplateServiceGraphUpdate(d *schema.ResourceData, m inter
 			return err
 		}
 		graphUpdate := models.NewTemplateServiceGraphUpdate("replace", templatePath, serviceNodes)
-		_, err = msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), graphUpdate)
+		_, err = patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), graphUpdate)
 		if err != nil {
 			return err
 		}
@@ -306,7 +306,7 @@ func resourceMSOSchemaTemplateServiceGraphDelete(d *schema.ResourceData, m inter
 	msoClient := m.(*client.Client)
 
 	schemaId := d.Get("schema_id").(string)
-	_, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	_, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return err
 	}
@@ -323,7 +323,7 @@ func resourceMSOSchemaTemplateServiceGraphDelete(d *schema.ResourceData, m inter
 
 	path := fmt.Sprintf("/templates/%s/serviceGraphs/%s", templateName, graphName)
 
-	response, err := msoClient.PatchbyID(fmt.Sprintf("/api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
+	response, err := patchbyID(msoClient, fmt.Sprintf("/api/v1/schemas/%s", schemaId), models.GetRemovePatchPayload(path))
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
 		return err
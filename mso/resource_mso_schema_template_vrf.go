@@ -42,6 +42,10 @@ func resourceMSOSchemaTemplateVrf() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"abandon_on_destroy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 
 			"display_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -87,16 +91,62 @@ func resourceMSOSchemaTemplateVrf() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"policy_control_enforcement_preference": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"enforced",
+					"unenforced",
+				}, false),
+			},
+
+			"policy_control_enforcement_direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ingress",
+					"egress",
+				}, false),
+			},
 		}),
 	}
 }
 
+// addVrfPolicyControlEnforcement adds the configured policy control enforcement settings to the VRF payload.
+// The settings are only supported on NDO version 4.0 or higher.
+func addVrfPolicyControlEnforcement(msoClient *client.Client, d *schema.ResourceData, vrfMap map[string]interface{}) error {
+	preference, preferenceOk := d.GetOk("policy_control_enforcement_preference")
+	direction, directionOk := d.GetOk("policy_control_enforcement_direction")
+	if !preferenceOk && !directionOk {
+		return nil
+	}
+
+	versionInt, err := msoClient.CompareVersion("4.0.0.0")
+	if err != nil {
+		return err
+	}
+	if versionInt == 1 {
+		return fmt.Errorf("policy_control_enforcement_preference and policy_control_enforcement_direction are only supported on NDO version 4.0 or higher.")
+	}
+
+	if preferenceOk {
+		vrfMap["pcEnfPref"] = preference.(string)
+	}
+	if directionOk {
+		vrfMap["pcEnfDir"] = direction.(string)
+	}
+	return nil
+}
+
 func resourceMSOSchemaTemplateVrfImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] Schema Template Vrf: Beginning Import")
 	msoClient := m.(*client.Client)
 	get_attribute := strings.Split(d.Id(), "/")
 	schemaId := get_attribute[0]
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -152,6 +202,12 @@ func resourceMSOSchemaTemplateVrfImport(d *schema.ResourceData, m interface{}) (
 						siteAwarePolicyEnforcementMode, _ := strconv.ParseBool(models.StripQuotes(vrfCont.S("siteAwarePolicyEnforcementMode").String()))
 						d.Set("site_aware_policy_enforcement", siteAwarePolicyEnforcementMode)
 					}
+					if vrfCont.Exists("pcEnfPref") {
+						d.Set("policy_control_enforcement_preference", models.StripQuotes(vrfCont.S("pcEnfPref").String()))
+					}
+					if vrfCont.Exists("pcEnfDir") {
+						d.Set("policy_control_enforcement_direction", models.StripQuotes(vrfCont.S("pcEnfDir").String()))
+					}
 					found = true
 					break
 				}
@@ -226,8 +282,12 @@ func resourceMSOSchemaTemplateVrfCreate(d *schema.ResourceData, m interface{}) e
 	}
 
 	schemaTemplateVrfApp := models.NewSchemaTemplateVrf("add", fmt.Sprintf("/templates/%s/vrfs/-", templateName), Name, displayName, ipDataPlaneLearning, description, l3m, vzany, preferredGroup, siteAwarePolicyEnforcementMode)
+	err := addVrfPolicyControlEnforcement(msoClient, d, schemaTemplateVrfApp.Value)
+	if err != nil {
+		return err
+	}
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateVrfApp)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateVrfApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -294,8 +354,12 @@ func resourceMSOSchemaTemplateVrfUpdate(d *schema.ResourceData, m interface{}) e
 	}
 
 	schemaTemplateVrfApp := models.NewSchemaTemplateVrf("replace", fmt.Sprintf("/templates/%s/vrfs/%s", templateName, Name), Name, displayName, ipDataPlaneLearning, description, l3m, vzany, preferredGroup, siteAwarePolicyEnforcementMode)
+	err := addVrfPolicyControlEnforcement(msoClient, d, schemaTemplateVrfApp.Value)
+	if err != nil {
+		return err
+	}
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateVrfApp)
+	_, err = patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), schemaTemplateVrfApp)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -311,14 +375,14 @@ func resourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) err
 	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 
 	templateName := d.Get("template").(string)
@@ -338,7 +402,7 @@ func resourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) err
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 
 			if err != nil {
-				return fmt.Errorf("No Vrf found")
+				return errorForObjectNotFound(fmt.Errorf("No Vrf found"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -377,6 +441,12 @@ func resourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) err
 						siteAwarePolicyEnforcementMode, _ := strconv.ParseBool(models.StripQuotes(vrfCont.S("siteAwarePolicyEnforcementMode").String()))
 						d.Set("site_aware_policy_enforcement", siteAwarePolicyEnforcementMode)
 					}
+					if vrfCont.Exists("pcEnfPref") {
+						d.Set("policy_control_enforcement_preference", models.StripQuotes(vrfCont.S("pcEnfPref").String()))
+					}
+					if vrfCont.Exists("pcEnfDir") {
+						d.Set("policy_control_enforcement_direction", models.StripQuotes(vrfCont.S("pcEnfDir").String()))
+					}
 					found = true
 					break
 				}
@@ -397,13 +467,16 @@ func resourceMSOSchemaTemplateVrfRead(d *schema.ResourceData, m interface{}) err
 
 func resourceMSOSchemaTemplateVrfDelete(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	if abandonOnDestroy(d) {
+		return nil
+	}
 	msoClient := m.(*client.Client)
 	schemaId := d.Get("schema_id").(string)
 	template := d.Get("template").(string)
 	name := d.Get("name").(string)
 
 	vrfRemovePatchPayload := models.GetRemovePatchPayload(fmt.Sprintf("/templates/%s/vrfs/%s", template, name))
-	response, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRemovePatchPayload)
+	response, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), vrfRemovePatchPayload)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if err != nil && !(response.Exists("code") && response.S("code").String() == "141") {
@@ -90,7 +90,7 @@ func resourceMSOTemplateVrfContractImport(d *schema.ResourceData, m interface{})
 	schemaId := get_attribute[0]
 	relationshipType := get_attribute[8]
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +200,7 @@ func resourceMSOTemplateVRFContractCreate(d *schema.ResourceData, m interface{})
 	path := fmt.Sprintf("/templates/%s/vrfs/%s/%s/-", templateName, vrfName, humanToApiType[relationshipType])
 	contractStruct := models.NewTemplateVRFContract("add", path, vrfConRef)
 
-	_, err := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
+	_, err := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
 
 	if err != nil {
 		return err
@@ -216,13 +216,13 @@ func resourceMSOTemplateVRFContractRead(d *schema.ResourceData, m interface{}) e
 	schemaId := d.Get("schema_id").(string)
 	relationshipType := d.Get("relationship_type").(string)
 
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	cont, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return errorForObjectNotFound(err, d.Id(), cont, d)
 	}
 	count, err := cont.ArrayCount("templates")
 	if err != nil {
-		return fmt.Errorf("No Template found")
+		return errorForObjectNotFound(fmt.Errorf("No Template found"), d.Id(), cont, d)
 	}
 	stateTemplate := d.Get("template_name").(string)
 	found := false
@@ -253,7 +253,7 @@ func resourceMSOTemplateVRFContractRead(d *schema.ResourceData, m interface{}) e
 			d.Set("template_name", apiTemplate)
 			vrfCount, err := tempCont.ArrayCount("vrfs")
 			if err != nil {
-				return fmt.Errorf("Unable to get VRF list")
+				return errorForObjectNotFound(fmt.Errorf("Unable to get VRF list"), d.Id(), cont, d)
 			}
 			for j := 0; j < vrfCount; j++ {
 				vrfCont, err := tempCont.ArrayElement(j, "vrfs")
@@ -266,7 +266,7 @@ func resourceMSOTemplateVRFContractRead(d *schema.ResourceData, m interface{}) e
 					log.Printf("uniiii %v", vrfCont)
 					contractCount, err := vrfCont.ArrayCount(humanToApiType[relationshipType])
 					if err != nil {
-						return fmt.Errorf("Unable to get contract Relationships list")
+						return errorForObjectNotFound(fmt.Errorf("Unable to get contract Relationships list"), d.Id(), cont, d)
 					}
 					for k := 0; k < contractCount; k++ {
 						contractCont, err := vrfCont.ArrayElement(k, humanToApiType[relationshipType])
@@ -323,7 +323,7 @@ func resourceMSOTemplateVRFContractDelete(d *schema.ResourceData, m interface{})
 	} else {
 		contract_template_name = templateName
 	}
-	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaID))
+	cont, err := getSchemaCont(msoClient, schemaID)
 	if err != nil {
 		return err
 	}
@@ -336,7 +336,7 @@ func resourceMSOTemplateVRFContractDelete(d *schema.ResourceData, m interface{})
 	path := fmt.Sprintf("/templates/%s/vrfs/%s/%s/%d", templateName, vrfName, humanToApiType[relationshipType], index)
 	contractStruct := models.NewTemplateVRFContract("remove", path, nil)
 
-	response, errs := msoClient.PatchbyID(fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
+	response, errs := patchbyID(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaID), contractStruct)
 
 	// Ignoring Error with code 141: Resource Not Found when deleting
 	if errs != nil && !(response.Exists("code") && response.S("code").String() == "141") {
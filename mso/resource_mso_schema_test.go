@@ -2,6 +2,7 @@ package mso
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -154,3 +155,28 @@ type SchemaTest struct {
 
 	TenantId string `json:",omitempty"`
 }
+
+func TestSortSchemaTemplatesByDependency(t *testing.T) {
+	templates := []interface{}{
+		map[string]interface{}{"name": "bd_template", "depends_on_templates": []interface{}{"vrf_template"}},
+		map[string]interface{}{"name": "epg_template", "depends_on_templates": []interface{}{"bd_template", "vrf_template"}},
+		map[string]interface{}{"name": "vrf_template"},
+		map[string]interface{}{"name": "contract_template"},
+	}
+	order := getSchemaTemplateOrder(templates)
+	expected := []string{"contract_template", "vrf_template", "bd_template", "epg_template"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected template order %v, got %v", expected, order)
+	}
+
+	templates = append(templates, map[string]interface{}{"name": "vrf_template_2", "depends_on_templates": []interface{}{"epg_template"}})
+	templates[2].(map[string]interface{})["depends_on_templates"] = []interface{}{"vrf_template_2"}
+	if err := validateSchemaTemplateDependencies(templates); err == nil {
+		t.Errorf("Expected an error for the dependency cycle")
+	}
+
+	templates = []interface{}{map[string]interface{}{"name": "bd_template", "depends_on_templates": []interface{}{"unknown_template"}}}
+	if err := validateSchemaTemplateDependencies(templates); err == nil {
+		t.Errorf("Expected an error for the dependency on an unknown template")
+	}
+}
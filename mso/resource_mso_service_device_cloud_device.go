@@ -0,0 +1,109 @@
+package mso
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var serviceDeviceCloudDevice = templatePolicyObject{
+	label:        "Cloud Service Device",
+	templateType: serviceDeviceTemplate,
+	objectKey:    "cloudDevices",
+	idSegment:    "cloudDevice",
+	payload:      getCloudServiceDevicePayload,
+	set:          setCloudServiceDeviceFromTemplate,
+}
+
+var cloudServiceDeviceTypeMap = map[string]string{
+	"aws_alb":                   "alb",
+	"aws_nlb":                   "nlb",
+	"azure_lb":                  "azureLB",
+	"azure_application_gateway": "applicationGateway",
+	"third_party_firewall":      "thirdPartyFirewall",
+	"third_party_load_balancer": "thirdPartyLB",
+}
+
+var cloudServiceDeviceTypeKeys = getMapKeys(cloudServiceDeviceTypeMap)
+
+func resourceMSOServiceDeviceCloudDevice() *schema.Resource {
+	resource := serviceDeviceCloudDevice.resource(map[string]*schema.Schema{
+		"device_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(cloudServiceDeviceTypeKeys, false),
+		},
+		"scheme": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"internal",
+				"internet",
+			}, false),
+		},
+		"subnets": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"interfaces": &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	})
+	resource.CustomizeDiff = func(diff *schema.ResourceDiff, v interface{}) error {
+		deviceType := diff.Get("device_type").(string)
+		thirdParty := strings.HasPrefix(deviceType, "third_party_")
+		if interfaces, ok := diff.GetOk("interfaces"); ok && interfaces.(*schema.Set).Len() > 0 && !thirdParty {
+			return fmt.Errorf(`"interfaces" can only be configured for third party devices`)
+		}
+		if _, ok := diff.GetOk("scheme"); ok && thirdParty {
+			return fmt.Errorf(`"scheme" can only be configured for cloud native load balancers`)
+		}
+		return nil
+	}
+	return resource
+}
+
+func getCloudServiceDevicePayload(d *schema.ResourceData) map[string]interface{} {
+	device := map[string]interface{}{
+		"deviceType": cloudServiceDeviceTypeMap[d.Get("device_type").(string)],
+		"subnets":    d.Get("subnets").(*schema.Set).List(),
+	}
+	if scheme, ok := d.GetOk("scheme"); ok {
+		device["scheme"] = scheme.(string)
+	}
+	if strings.HasPrefix(d.Get("device_type").(string), "third_party_") {
+		interfaces := make([]interface{}, 0, 1)
+		for _, name := range d.Get("interfaces").(*schema.Set).List() {
+			interfaces = append(interfaces, map[string]interface{}{"name": name})
+		}
+		device["interfaces"] = interfaces
+	}
+	return device
+}
+
+func setCloudServiceDeviceFromTemplate(d *schema.ResourceData, deviceCont *container.Container) {
+	d.Set("device_type", getKeyByValue(cloudServiceDeviceTypeMap, convertInterfaceToString(deviceCont.S("deviceType").Data())))
+	d.Set("scheme", convertInterfaceToString(deviceCont.S("scheme").Data()))
+
+	subnets := make([]interface{}, 0, 1)
+	if apiSubnets, ok := deviceCont.S("subnets").Data().([]interface{}); ok {
+		subnets = append(subnets, apiSubnets...)
+	}
+	d.Set("subnets", subnets)
+
+	interfaces := make([]interface{}, 0, 1)
+	if apiInterfaces, ok := deviceCont.S("interfaces").Data().([]interface{}); ok {
+		for _, apiInterface := range apiInterfaces {
+			interfaces = append(interfaces, convertInterfaceToString(apiInterface.(map[string]interface{})["name"]))
+		}
+	}
+	d.Set("interfaces", interfaces)
+}
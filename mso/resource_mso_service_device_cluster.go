@@ -0,0 +1,158 @@
+package mso
+
+import (
+	"fmt"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var serviceDeviceClusterTypeMap = map[string]string{
+	"firewall":      "firewall",
+	"load_balancer": "loadBalancer",
+	"other":         "other",
+}
+
+var serviceDeviceClusterConnectivityMap = map[string]string{
+	"one_arm":  "oneArm",
+	"two_arm":  "twoArm",
+	"advanced": "advanced",
+}
+
+var serviceDeviceCluster = templatePolicyObject{
+	label:        "Service Device Cluster",
+	templateType: serviceDeviceTemplate,
+	objectKey:    "devices",
+	idSegment:    "device",
+	payload:      getServiceDeviceClusterPayload,
+	set:          setServiceDeviceClusterFromTemplate,
+	computed:     setServiceDeviceClusterDn,
+}
+
+func resourceMSOServiceDeviceCluster() *schema.Resource {
+	return serviceDeviceCluster.resource(map[string]*schema.Schema{
+		"device_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(getMapKeys(serviceDeviceClusterTypeMap), false),
+		},
+		"device_mode": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "layer3",
+			ValidateFunc: validation.StringInSlice([]string{
+				"layer1",
+				"layer2",
+				"layer3",
+			}, false),
+		},
+		"connectivity_mode": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "one_arm",
+			ValidateFunc: validation.StringInSlice(getMapKeys(serviceDeviceClusterConnectivityMap), false),
+		},
+		"interfaces": &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"bd_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"external_epg_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"redirect": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"ipsla_monitoring_policy_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+				},
+			},
+		},
+		"device_dn": &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	})
+}
+
+func getServiceDeviceClusterPayload(d *schema.ResourceData) map[string]interface{} {
+	interfaces := make([]interface{}, 0)
+	for _, deviceInterface := range d.Get("interfaces").([]interface{}) {
+		interfaceMap := deviceInterface.(map[string]interface{})
+		clusterInterface := map[string]interface{}{
+			"name":     interfaceMap["name"],
+			"redirect": interfaceMap["redirect"],
+		}
+		if bd := interfaceMap["bd_uuid"].(string); bd != "" {
+			clusterInterface["interfaceType"] = "bd"
+			clusterInterface["bdRef"] = bd
+		} else if externalEpg := interfaceMap["external_epg_uuid"].(string); externalEpg != "" {
+			clusterInterface["interfaceType"] = "l3out"
+			clusterInterface["externalEpgRef"] = externalEpg
+		}
+		if ipsla := interfaceMap["ipsla_monitoring_policy_uuid"].(string); ipsla != "" {
+			clusterInterface["ipslaMonitoringRef"] = ipsla
+		}
+		interfaces = append(interfaces, clusterInterface)
+	}
+	return map[string]interface{}{
+		"deviceType":       serviceDeviceClusterTypeMap[d.Get("device_type").(string)],
+		"deviceMode":       d.Get("device_mode").(string),
+		"connectivityMode": serviceDeviceClusterConnectivityMap[d.Get("connectivity_mode").(string)],
+		"interfaces":       interfaces,
+	}
+}
+
+func setServiceDeviceClusterFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("device_type", getKeyByValue(serviceDeviceClusterTypeMap, convertInterfaceToString(policyCont.S("deviceType").Data())))
+	setTemplatePolicyString(d, policyCont, "device_mode", "deviceMode")
+	if policyCont.Exists("connectivityMode") {
+		d.Set("connectivity_mode", getKeyByValue(serviceDeviceClusterConnectivityMap, convertInterfaceToString(policyCont.S("connectivityMode").Data())))
+	}
+
+	interfaces := make([]interface{}, 0)
+	for _, clusterInterface := range getTemplatePolicyList(policyCont, "interfaces") {
+		interfaces = append(interfaces, map[string]interface{}{
+			"name":                         convertInterfaceToString(clusterInterface["name"]),
+			"bd_uuid":                      convertInterfaceToString(clusterInterface["bdRef"]),
+			"external_epg_uuid":            convertInterfaceToString(clusterInterface["externalEpgRef"]),
+			"redirect":                     clusterInterface["redirect"] == true,
+			"ipsla_monitoring_policy_uuid": convertInterfaceToString(clusterInterface["ipslaMonitoringRef"]),
+		})
+	}
+	d.Set("interfaces", interfaces)
+}
+
+// setServiceDeviceClusterDn sets the DN of the L4-L7 device of the cluster on the sites, uni/tn-{tenant_name}/lDevVip-{name}.
+// It can be used as device_dn of the service nodes of site service graphs.
+func setServiceDeviceClusterDn(d *schema.ResourceData, msoClient *client.Client, templateCont *container.Container) error {
+	tenantId := convertInterfaceToString(templateCont.S(serviceDeviceTemplate, "template", "tenantId").Data())
+	tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%s", tenantId))
+	if err != nil {
+		return err
+	}
+	d.Set("device_dn", fmt.Sprintf("uni/tn-%s/lDevVip-%s", models.StripQuotes(tenantCont.S("name").String()), d.Get("name").(string)))
+	return nil
+}
@@ -1,11 +1,13 @@
 package mso
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/container"
@@ -25,7 +27,21 @@ func resourceMSOSite() *schema.Resource {
 			State: resourceMSOSiteImport,
 		},
 
-		SchemaVersion: version,
+		SchemaVersion: 2,
+
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceMSOSiteV1().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceMSOSiteStateUpgradeV1,
+				Version: 1,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: (map[string]*schema.Schema{
 
@@ -53,9 +69,10 @@ func resourceMSOSite() *schema.Resource {
 			},
 
 			"labels": &schema.Schema{
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
 				Computed: true,
 			},
 
@@ -116,6 +133,89 @@ func resourceMSOSite() *schema.Resource {
 	}
 }
 
+// resourceMSOSiteV1 is the schema of the mso_site resource before labels was converted from a list to a set.
+func resourceMSOSiteV1() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"apic_site_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"location": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lat": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Computed: true,
+						},
+						"long": &schema.Schema{
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"urls": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"platform": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"login_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"maintenance_mode": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cloud_providers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMSOSiteStateUpgradeV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Site: Upgrading state from schema version 1")
+	return upgradeListToSetState(rawState, "labels"), nil
+}
+
 func resourceMSOSiteImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] Site: Beginning Import")
 	msoClient := m.(*client.Client)
@@ -250,6 +350,10 @@ func resourceMSOSiteCreate(d *schema.ResourceData, m interface{}) error {
 	var path string
 	var id string
 	apic_site_id := d.Get("apic_site_id").(string)
+
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
 	platform := msoClient.GetPlatform()
 	if platform == "nd" {
 		apiVersion = "v2"
@@ -265,15 +369,10 @@ func resourceMSOSiteCreate(d *schema.ResourceData, m interface{}) error {
 			common["siteId"] = apic_site_id
 			siteData["common"] = common
 			payload, err := container.Consume(siteData)
-			req, err := msoClient.MakeRestRequest("POST", path, payload, true)
-			if err != nil {
-				return err
-			}
-			res, _, err := msoClient.Do(req)
 			if err != nil {
 				return err
 			}
-			err = client.CheckForErrors(res, "POST")
+			res, _, err := doRequestWithContext(ctx, msoClient, "POST", path, payload)
 			if err != nil {
 				return err
 			}
@@ -294,7 +393,7 @@ func resourceMSOSiteCreate(d *schema.ResourceData, m interface{}) error {
 		}
 
 		if labels, ok := d.GetOk("labels"); ok {
-			siteAttr.Labels = labels.([]interface{})
+			siteAttr.Labels = labels.(*schema.Set).List()
 		}
 
 		if maintMode, ok := d.GetOk("maintenance_mode"); ok {
@@ -322,7 +421,11 @@ func resourceMSOSiteCreate(d *schema.ResourceData, m interface{}) error {
 			siteAttr.Url = urls.([]interface{})
 		}
 		siteApp := models.NewSite(siteAttr)
-		cont, err := msoClient.Save(path, siteApp)
+		payload, err := msoClient.PrepareModel(siteApp)
+		if err != nil {
+			return err
+		}
+		cont, _, err := doRequestWithContext(ctx, msoClient, "POST", path, payload)
 		if err != nil {
 			log.Println(err)
 			return err
@@ -369,7 +472,7 @@ func resourceMSOSiteUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 
 		if labels, ok := d.GetOk("labels"); ok {
-			siteAttr.Labels = labels.([]interface{})
+			siteAttr.Labels = labels.(*schema.Set).List()
 		}
 
 		if maintMode, ok := d.GetOk("maintenance_mode"); ok {
@@ -404,8 +507,15 @@ func resourceMSOSiteUpdate(d *schema.ResourceData, m interface{}) error {
 
 	siteAttr.Platform = d.Get("platform").(string)
 	siteApp := models.NewSite(siteAttr)
+	payload, err := msoClient.PrepareModel(siteApp)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
-	cont, err := msoClient.Put(fmt.Sprintf("%v/%s", path, d.Id()), siteApp)
+	cont, _, err := doRequestWithContext(ctx, msoClient, "PUT", fmt.Sprintf("%v/%s", path, d.Id()), payload)
 	if err != nil {
 		return err
 	}
@@ -549,10 +659,17 @@ func resourceMSOSiteDelete(d *schema.ResourceData, m interface{}) error {
 		apiVersion = "v1"
 		path = fmt.Sprintf("api/%v/sites/%v%s", apiVersion, dn, "?force=true")
 	}
-	err := msoClient.DeletebyId(path)
+
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	_, resp, err := doRequestWithContext(ctx, msoClient, "DELETE", path, nil)
 	if err != nil {
 		return err
 	}
+	if resp != nil && resp.StatusCode != 204 && resp.StatusCode != 200 {
+		return fmt.Errorf("Unable to delete the object")
+	}
 
 	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
 
@@ -0,0 +1,272 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const fabricConnectivityUrl = "api/v1/sites/fabric-connectivity"
+
+func resourceMSOSiteAwsHubNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSiteAwsHubNetworkCreate,
+		Read:   resourceMSOSiteAwsHubNetworkRead,
+		Update: resourceMSOSiteAwsHubNetworkUpdate,
+		Delete: resourceMSOSiteAwsHubNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSiteAwsHubNetworkImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"bgp_asn": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAwsTransitGatewayAsn,
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+			"tgw_connect": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		}),
+	}
+}
+
+// validateAwsTransitGatewayAsn validates that the ASN is in one of the private ASN ranges which AWS allows for transit gateways.
+func validateAwsTransitGatewayAsn(i interface{}, k string) ([]string, []error) {
+	asn := i.(int)
+	if (asn >= 64512 && asn <= 65534) || (asn >= 4200000000 && asn <= 4294967294) {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("expected %s to be in the range (64512 - 65534) or (4200000000 - 4294967294), got %d", k, asn)}
+}
+
+// getFabricConnectivitySite returns the site entry of the fabric connectivity configuration.
+func getFabricConnectivitySite(cont *container.Container, siteId string) (map[string]interface{}, error) {
+	sites, _ := cont.S("sites").Data().([]interface{})
+	for _, site := range sites {
+		if siteMap, ok := site.(map[string]interface{}); ok && siteMap["id"] == siteId {
+			return siteMap, nil
+		}
+	}
+	return nil, fmt.Errorf("Site %s not found in the fabric connectivity configuration", siteId)
+}
+
+// getSiteHubNetworks returns the hub networks of the site entry and the index of the hub network with the name, or -1 when it does not exist.
+func getSiteHubNetworks(site map[string]interface{}, name string) ([]interface{}, int) {
+	hubNetworks, _ := site["hubNetworks"].([]interface{})
+	for index, hubNetwork := range hubNetworks {
+		if hubNetworkMap, ok := hubNetwork.(map[string]interface{}); ok && hubNetworkMap["name"] == name {
+			return hubNetworks, index
+		}
+	}
+	return hubNetworks, -1
+}
+
+// updateSiteHubNetwork replaces, adds or removes the hub network of the site in the fabric connectivity configuration.
+// The configuration is replaced as a whole, a nil hubNetwork removes the hub network.
+func updateSiteHubNetwork(msoClient *client.Client, cloudProvider, siteId, name string, hubNetwork map[string]interface{}) error {
+	cont, err := msoClient.GetViaURL(fabricConnectivityUrl)
+	if err != nil {
+		return err
+	}
+	site, err := getFabricConnectivitySite(cont, siteId)
+	if err != nil {
+		return err
+	}
+	if provider, ok := site["cloudProvider"].(string); ok && provider != "" && provider != cloudProvider {
+		return fmt.Errorf("Site %s is a %s site, expected a %s site", siteId, provider, cloudProvider)
+	}
+
+	hubNetworks, index := getSiteHubNetworks(site, name)
+	if hubNetwork == nil {
+		if index == -1 {
+			return nil
+		}
+		hubNetworks = append(hubNetworks[:index], hubNetworks[index+1:]...)
+	} else if index == -1 {
+		hubNetworks = append(hubNetworks, hubNetwork)
+	} else {
+		hubNetworks[index] = hubNetwork
+	}
+	site["hubNetworks"] = hubNetworks
+
+	_, _, err = doRequestWithContext(getStopContext(msoClient), msoClient, "PUT", fabricConnectivityUrl, cont)
+	return err
+}
+
+// getHubNetworkRegions returns the regions payload of the hub network, sorted by name to keep the payload stable.
+func getHubNetworkRegions(d *schema.ResourceData) []interface{} {
+	regionNames := make([]string, 0)
+	for _, region := range d.Get("regions").(*schema.Set).List() {
+		regionNames = append(regionNames, region.(string))
+	}
+	sort.Strings(regionNames)
+	regions := make([]interface{}, 0)
+	for _, region := range regionNames {
+		regions = append(regions, map[string]interface{}{"name": region})
+	}
+	return regions
+}
+
+// readSiteHubNetwork returns the hub network of the site in the fabric connectivity configuration and sets the regions.
+// It returns nil and removes the resource from the state when the hub network does not exist.
+func readSiteHubNetwork(d *schema.ResourceData, msoClient *client.Client) (map[string]interface{}, error) {
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	cont, err := msoClient.GetViaURL(fabricConnectivityUrl)
+	if err != nil {
+		return nil, errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	site, err := getFabricConnectivitySite(cont, siteId)
+	if err != nil {
+		return nil, errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	hubNetworks, index := getSiteHubNetworks(site, name)
+	if index == -1 {
+		log.Printf("[WARN] Hub network not found, removing from state: %s", d.Id())
+		d.SetId("")
+		return nil, nil
+	}
+
+	hubNetwork := hubNetworks[index].(map[string]interface{})
+	regions := make([]interface{}, 0)
+	regionList, _ := hubNetwork["regions"].([]interface{})
+	for _, region := range regionList {
+		if regionMap, ok := region.(map[string]interface{}); ok {
+			regions = append(regions, convertInterfaceToString(regionMap["name"]))
+		}
+	}
+	d.SetId(fmt.Sprintf("%s/hubNetworks/%s", siteId, name))
+	d.Set("regions", regions)
+	return hubNetwork, nil
+}
+
+// importSiteHubNetwork sets the site_id and name of the hub network from the import ID {site_id}/hubNetworks/{name}.
+func importSiteHubNetwork(d *schema.ResourceData) error {
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 3 || get_attribute[1] != "hubNetworks" {
+		return fmt.Errorf("Invalid import ID %s, expected {site_id}/hubNetworks/{name}", d.Id())
+	}
+	d.Set("site_id", get_attribute[0])
+	d.Set("name", get_attribute[2])
+	return nil
+}
+
+func getAwsHubNetworkFromConfig(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       d.Get("name").(string),
+		"bgpAsn":     d.Get("bgp_asn").(int),
+		"tgwConnect": d.Get("tgw_connect").(bool),
+		"regions":    getHubNetworkRegions(d),
+	}
+}
+
+func resourceMSOSiteAwsHubNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	err := importSiteHubNetwork(d)
+	if err != nil {
+		return nil, err
+	}
+	err = resourceMSOSiteAwsHubNetworkRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Hub network %s not found on site %s", d.Get("name"), d.Get("site_id"))
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSiteAwsHubNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] AWS Hub Network: Beginning Creation")
+	msoClient := m.(*client.Client)
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	err := updateSiteHubNetwork(msoClient, "aws", siteId, name, getAwsHubNetworkFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/hubNetworks/%s", siteId, name))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSOSiteAwsHubNetworkRead(d, m)
+}
+
+func resourceMSOSiteAwsHubNetworkRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	hubNetwork, err := readSiteHubNetwork(d, msoClient)
+	if err != nil || hubNetwork == nil {
+		return err
+	}
+	d.Set("bgp_asn", convertInterfaceToInt(hubNetwork["bgpAsn"]))
+	d.Set("tgw_connect", hubNetwork["tgwConnect"] == true)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSiteAwsHubNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteHubNetwork(msoClient, "aws", d.Get("site_id").(string), d.Get("name").(string), getAwsHubNetworkFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSiteAwsHubNetworkRead(d, m)
+}
+
+func resourceMSOSiteAwsHubNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteHubNetwork(msoClient, "aws", d.Get("site_id").(string), d.Get("name").(string), nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
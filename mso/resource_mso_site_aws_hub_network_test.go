@@ -0,0 +1,87 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestValidateAwsTransitGatewayAsn(t *testing.T) {
+	tests := []struct {
+		asn   int
+		valid bool
+	}{
+		{64511, false},
+		{64512, true},
+		{65534, true},
+		{65535, false},
+		{4200000000, true},
+		{4294967294, true},
+		{4294967295, false},
+	}
+	for _, test := range tests {
+		_, errs := validateAwsTransitGatewayAsn(test.asn, "bgp_asn")
+		if (len(errs) == 0) != test.valid {
+			t.Errorf("validateAwsTransitGatewayAsn(%d) returned %v, expected valid %t", test.asn, errs, test.valid)
+		}
+	}
+}
+
+func TestMockNDOSiteAwsHubNetwork(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject(fabricConnectivityUrl, map[string]interface{}{
+		"sites": []interface{}{
+			map[string]interface{}{"id": "aws1", "cloudProvider": "aws"},
+			map[string]interface{}{"id": "azure1", "cloudProvider": "azure"},
+		},
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceMSOSiteAwsHubNetwork().Schema, map[string]interface{}{
+		"site_id": "aws1",
+		"name":    "hub1",
+		"bgp_asn": 64512,
+		"regions": []interface{}{"us-west-1", "us-east-1"},
+	})
+	err := resourceMSOSiteAwsHubNetworkCreate(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "aws1/hubNetworks/hub1" {
+		t.Errorf("Expected id aws1/hubNetworks/hub1, got %s", d.Id())
+	}
+
+	object, _ := server.Object(fabricConnectivityUrl)
+	site := object.(map[string]interface{})["sites"].([]interface{})[0].(map[string]interface{})
+	hubNetworks, _ := site["hubNetworks"].([]interface{})
+	if len(hubNetworks) != 1 {
+		t.Fatalf("Expected 1 hub network, got %v", site["hubNetworks"])
+	}
+	hubNetwork := hubNetworks[0].(map[string]interface{})
+	regions := hubNetwork["regions"].([]interface{})
+	if hubNetwork["bgpAsn"] != float64(64512) || len(regions) != 2 || regions[0].(map[string]interface{})["name"] != "us-east-1" {
+		t.Errorf("Unexpected hub network %v", hubNetwork)
+	}
+	if d.Get("regions").(*schema.Set).Len() != 2 {
+		t.Errorf("Expected 2 regions in the state, got %v", d.Get("regions"))
+	}
+
+	err = resourceMSOSiteAwsHubNetworkDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, _ = server.Object(fabricConnectivityUrl)
+	site = object.(map[string]interface{})["sites"].([]interface{})[0].(map[string]interface{})
+	if hubNetworks, _ := site["hubNetworks"].([]interface{}); len(hubNetworks) != 0 {
+		t.Errorf("Expected the hub network to be removed, got %v", hubNetworks)
+	}
+
+	azure := schema.TestResourceDataRaw(t, resourceMSOSiteAwsHubNetwork().Schema, map[string]interface{}{
+		"site_id": "azure1",
+		"name":    "hub1",
+		"bgp_asn": 64512,
+		"regions": []interface{}{"westus"},
+	})
+	if err := resourceMSOSiteAwsHubNetworkCreate(azure, msoClient); err == nil {
+		t.Error("Expected an error for an AWS hub network on an Azure site")
+	}
+}
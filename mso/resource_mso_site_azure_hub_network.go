@@ -0,0 +1,131 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSiteAzureHubNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSiteAzureHubNetworkCreate,
+		Read:   resourceMSOSiteAzureHubNetworkRead,
+		Update: resourceMSOSiteAzureHubNetworkUpdate,
+		Delete: resourceMSOSiteAzureHubNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSiteAzureHubNetworkImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"regions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		}),
+	}
+}
+
+func getAzureHubNetworkFromConfig(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"regions": getHubNetworkRegions(d),
+	}
+}
+
+func resourceMSOSiteAzureHubNetworkImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	err := importSiteHubNetwork(d)
+	if err != nil {
+		return nil, err
+	}
+	err = resourceMSOSiteAzureHubNetworkRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Hub network %s not found on site %s", d.Get("name"), d.Get("site_id"))
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSiteAzureHubNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Azure Hub Network: Beginning Creation")
+	msoClient := m.(*client.Client)
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	err := updateSiteHubNetwork(msoClient, "azure", siteId, name, getAzureHubNetworkFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/hubNetworks/%s", siteId, name))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSOSiteAzureHubNetworkRead(d, m)
+}
+
+func resourceMSOSiteAzureHubNetworkRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	hubNetwork, err := readSiteHubNetwork(d, msoClient)
+	if err != nil || hubNetwork == nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSiteAzureHubNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteHubNetwork(msoClient, "azure", d.Get("site_id").(string), d.Get("name").(string), getAzureHubNetworkFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSiteAzureHubNetworkRead(d, m)
+}
+
+func resourceMSOSiteAzureHubNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteHubNetwork(msoClient, "azure", d.Get("site_id").(string), d.Get("name").(string), nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
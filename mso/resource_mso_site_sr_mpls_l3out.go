@@ -0,0 +1,385 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSiteSrMplsL3out() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSiteSrMplsL3outCreate,
+		Read:   resourceMSOSiteSrMplsL3outRead,
+		Update: resourceMSOSiteSrMplsL3outUpdate,
+		Delete: resourceMSOSiteSrMplsL3outDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOSiteSrMplsL3outImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"site_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"l3_domain": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"node": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_id": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"node_id": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"router_id": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"bgp_evpn_loopback": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"mpls_transport_loopback": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"segment_id": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(16000, 471804),
+						},
+					},
+				},
+			},
+			"interface": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_id": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"path": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"ip_address": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"mtu": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      9000,
+							ValidateFunc: validation.IntBetween(576, 9216),
+						},
+					},
+				},
+			},
+			"bgp_peer": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"remote_asn": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 4294967295),
+						},
+						"ttl": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+						"password": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// getSiteSrMplsL3outs returns the SR-MPLS L3Outs of the site entry and the index of the L3Out with the name, or -1 when it does not exist.
+func getSiteSrMplsL3outs(site map[string]interface{}, name string) ([]interface{}, int) {
+	l3outs, _ := site["srMplsL3outs"].([]interface{})
+	for index, l3out := range l3outs {
+		if l3outMap, ok := l3out.(map[string]interface{}); ok && l3outMap["name"] == name {
+			return l3outs, index
+		}
+	}
+	return l3outs, -1
+}
+
+// updateSiteSrMplsL3out replaces, adds or removes the SR-MPLS L3Out of an on-premises site in the fabric connectivity configuration.
+// The configuration is replaced as a whole, a nil l3out removes the L3Out.
+func updateSiteSrMplsL3out(msoClient *client.Client, siteId, name string, l3out map[string]interface{}) error {
+	cont, err := msoClient.GetViaURL(fabricConnectivityUrl)
+	if err != nil {
+		return err
+	}
+	site, err := getFabricConnectivitySite(cont, siteId)
+	if err != nil {
+		return err
+	}
+	if provider, ok := site["cloudProvider"].(string); ok && provider != "" {
+		return fmt.Errorf("Site %s is a %s site, SR-MPLS L3Outs are only supported on on-premises sites", siteId, provider)
+	}
+
+	l3outs, index := getSiteSrMplsL3outs(site, name)
+	if l3out == nil {
+		if index == -1 {
+			return nil
+		}
+		l3outs = append(l3outs[:index], l3outs[index+1:]...)
+	} else if index == -1 {
+		l3outs = append(l3outs, l3out)
+	} else {
+		l3outs[index] = l3out
+	}
+	site["srMplsL3outs"] = l3outs
+
+	_, _, err = doRequestWithContext(getStopContext(msoClient), msoClient, "PUT", fabricConnectivityUrl, cont)
+	return err
+}
+
+func getSiteSrMplsL3outFromConfig(d *schema.ResourceData) map[string]interface{} {
+	nodes := make([]interface{}, 0)
+	for _, node := range d.Get("node").([]interface{}) {
+		nodeMap := node.(map[string]interface{})
+		nodes = append(nodes, map[string]interface{}{
+			"podId":                 nodeMap["pod_id"].(string),
+			"nodeId":                nodeMap["node_id"].(string),
+			"routerId":              nodeMap["router_id"].(string),
+			"bgpEvpnLoopback":       nodeMap["bgp_evpn_loopback"].(string),
+			"mplsTransportLoopback": nodeMap["mpls_transport_loopback"].(string),
+			"segmentId":             nodeMap["segment_id"].(int),
+		})
+	}
+	interfaces := make([]interface{}, 0)
+	for _, srMplsInterface := range d.Get("interface").([]interface{}) {
+		interfaceMap := srMplsInterface.(map[string]interface{})
+		interfaces = append(interfaces, map[string]interface{}{
+			"nodeId":  interfaceMap["node_id"].(string),
+			"path":    interfaceMap["path"].(string),
+			"address": interfaceMap["ip_address"].(string),
+			"mtu":     interfaceMap["mtu"].(int),
+		})
+	}
+	bgpPeers := make([]interface{}, 0)
+	for _, bgpPeer := range d.Get("bgp_peer").([]interface{}) {
+		bgpPeerMap := bgpPeer.(map[string]interface{})
+		peer := map[string]interface{}{
+			"peerAddress": bgpPeerMap["address"].(string),
+			"remoteAsn":   bgpPeerMap["remote_asn"].(int),
+			"ttl":         bgpPeerMap["ttl"].(int),
+		}
+		if password := bgpPeerMap["password"].(string); password != "" {
+			peer["password"] = password
+		}
+		bgpPeers = append(bgpPeers, peer)
+	}
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"l3domain":    d.Get("l3_domain").(string),
+		"nodes":       nodes,
+		"interfaces":  interfaces,
+		"bgpPeers":    bgpPeers,
+	}
+}
+
+// setSiteSrMplsL3outPeers sets the BGP peers of the L3Out, the passwords are not returned by NDO so they are kept from the configuration.
+func setSiteSrMplsL3outPeers(d *schema.ResourceData, l3out map[string]interface{}) {
+	passwords := make(map[string]string)
+	for _, bgpPeer := range d.Get("bgp_peer").([]interface{}) {
+		bgpPeerMap := bgpPeer.(map[string]interface{})
+		passwords[bgpPeerMap["address"].(string)] = bgpPeerMap["password"].(string)
+	}
+	bgpPeers := make([]interface{}, 0)
+	peerList, _ := l3out["bgpPeers"].([]interface{})
+	for _, bgpPeer := range peerList {
+		if bgpPeerMap, ok := bgpPeer.(map[string]interface{}); ok {
+			address := convertInterfaceToString(bgpPeerMap["peerAddress"])
+			bgpPeers = append(bgpPeers, map[string]interface{}{
+				"address":    address,
+				"remote_asn": convertInterfaceToInt(bgpPeerMap["remoteAsn"]),
+				"ttl":        convertInterfaceToInt(bgpPeerMap["ttl"]),
+				"password":   passwords[address],
+			})
+		}
+	}
+	d.Set("bgp_peer", bgpPeers)
+}
+
+func resourceMSOSiteSrMplsL3outImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 3 || get_attribute[1] != "srMplsL3outs" {
+		return nil, fmt.Errorf("Invalid import ID %s, expected {site_id}/srMplsL3outs/{name}", d.Id())
+	}
+	d.Set("site_id", get_attribute[0])
+	d.Set("name", get_attribute[2])
+	err := resourceMSOSiteSrMplsL3outRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("SR-MPLS L3Out %s not found on site %s", get_attribute[2], get_attribute[0])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOSiteSrMplsL3outCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Site SR-MPLS L3Out: Beginning Creation")
+	msoClient := m.(*client.Client)
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	err := updateSiteSrMplsL3out(msoClient, siteId, name, getSiteSrMplsL3outFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/srMplsL3outs/%s", siteId, name))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSOSiteSrMplsL3outRead(d, m)
+}
+
+func resourceMSOSiteSrMplsL3outRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+	siteId := d.Get("site_id").(string)
+	name := d.Get("name").(string)
+
+	cont, err := msoClient.GetViaURL(fabricConnectivityUrl)
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	site, err := getFabricConnectivitySite(cont, siteId)
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	l3outs, index := getSiteSrMplsL3outs(site, name)
+	if index == -1 {
+		log.Printf("[WARN] SR-MPLS L3Out not found, removing from state: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+	l3out := l3outs[index].(map[string]interface{})
+
+	d.SetId(fmt.Sprintf("%s/srMplsL3outs/%s", siteId, name))
+	d.Set("description", convertInterfaceToString(l3out["description"]))
+	d.Set("l3_domain", convertInterfaceToString(l3out["l3domain"]))
+
+	nodes := make([]interface{}, 0)
+	nodeList, _ := l3out["nodes"].([]interface{})
+	for _, node := range nodeList {
+		if nodeMap, ok := node.(map[string]interface{}); ok {
+			nodes = append(nodes, map[string]interface{}{
+				"pod_id":                  convertInterfaceToString(nodeMap["podId"]),
+				"node_id":                 convertInterfaceToString(nodeMap["nodeId"]),
+				"router_id":               convertInterfaceToString(nodeMap["routerId"]),
+				"bgp_evpn_loopback":       convertInterfaceToString(nodeMap["bgpEvpnLoopback"]),
+				"mpls_transport_loopback": convertInterfaceToString(nodeMap["mplsTransportLoopback"]),
+				"segment_id":              convertInterfaceToInt(nodeMap["segmentId"]),
+			})
+		}
+	}
+	d.Set("node", nodes)
+
+	interfaces := make([]interface{}, 0)
+	interfaceList, _ := l3out["interfaces"].([]interface{})
+	for _, srMplsInterface := range interfaceList {
+		if interfaceMap, ok := srMplsInterface.(map[string]interface{}); ok {
+			interfaces = append(interfaces, map[string]interface{}{
+				"node_id":    convertInterfaceToString(interfaceMap["nodeId"]),
+				"path":       convertInterfaceToString(interfaceMap["path"]),
+				"ip_address": convertInterfaceToString(interfaceMap["address"]),
+				"mtu":        convertInterfaceToInt(interfaceMap["mtu"]),
+			})
+		}
+	}
+	d.Set("interface", interfaces)
+	setSiteSrMplsL3outPeers(d, l3out)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSiteSrMplsL3outUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteSrMplsL3out(msoClient, d.Get("site_id").(string), d.Get("name").(string), getSiteSrMplsL3outFromConfig(d))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOSiteSrMplsL3outRead(d, m)
+}
+
+func resourceMSOSiteSrMplsL3outDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := updateSiteSrMplsL3out(msoClient, d.Get("site_id").(string), d.Get("name").(string), nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,74 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestMockNDOSiteSrMplsL3out(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject(fabricConnectivityUrl, map[string]interface{}{
+		"sites": []interface{}{
+			map[string]interface{}{"id": "site1"},
+			map[string]interface{}{"id": "aws1", "cloudProvider": "aws"},
+		},
+	})
+
+	config := map[string]interface{}{
+		"site_id":   "site1",
+		"name":      "infra1",
+		"l3_domain": "domain1",
+		"node": []interface{}{map[string]interface{}{
+			"pod_id":                  "1",
+			"node_id":                 "101",
+			"router_id":               "10.0.0.101",
+			"bgp_evpn_loopback":       "10.1.0.101",
+			"mpls_transport_loopback": "10.2.0.101",
+			"segment_id":              16101,
+		}},
+		"bgp_peer": []interface{}{map[string]interface{}{
+			"address":    "10.3.0.1",
+			"remote_asn": 65001,
+			"password":   "secret",
+		}},
+	}
+	d := schema.TestResourceDataRaw(t, resourceMSOSiteSrMplsL3out().Schema, config)
+	err := resourceMSOSiteSrMplsL3outCreate(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "site1/srMplsL3outs/infra1" {
+		t.Errorf("Expected id site1/srMplsL3outs/infra1, got %s", d.Id())
+	}
+
+	object, _ := server.Object(fabricConnectivityUrl)
+	site := object.(map[string]interface{})["sites"].([]interface{})[0].(map[string]interface{})
+	l3outs, _ := site["srMplsL3outs"].([]interface{})
+	if len(l3outs) != 1 {
+		t.Fatalf("Expected 1 SR-MPLS L3Out, got %v", site["srMplsL3outs"])
+	}
+	node := l3outs[0].(map[string]interface{})["nodes"].([]interface{})[0].(map[string]interface{})
+	if node["segmentId"] != float64(16101) || node["routerId"] != "10.0.0.101" {
+		t.Errorf("Unexpected node %v", node)
+	}
+	if password := d.Get("bgp_peer.0.password"); password != "secret" {
+		t.Errorf("Expected the password of the peer to be kept, got %v", password)
+	}
+
+	err = resourceMSOSiteSrMplsL3outDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, _ = server.Object(fabricConnectivityUrl)
+	site = object.(map[string]interface{})["sites"].([]interface{})[0].(map[string]interface{})
+	if l3outs, _ := site["srMplsL3outs"].([]interface{}); len(l3outs) != 0 {
+		t.Errorf("Expected the SR-MPLS L3Out to be removed, got %v", l3outs)
+	}
+
+	config["site_id"] = "aws1"
+	cloud := schema.TestResourceDataRaw(t, resourceMSOSiteSrMplsL3out().Schema, config)
+	if err := resourceMSOSiteSrMplsL3outCreate(cloud, msoClient); err == nil {
+		t.Error("Expected an error for an SR-MPLS L3Out on a cloud site")
+	}
+}
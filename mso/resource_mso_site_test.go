@@ -2,6 +2,7 @@ package mso
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -55,6 +56,26 @@ func TestAccMsoSite_Update(t *testing.T) {
 	})
 }
 
+func TestMsoSiteStateUpgradeV1(t *testing.T) {
+	rawState := map[string]interface{}{
+		"name":   "site1",
+		"labels": []interface{}{"label1", "label2", "label1"},
+	}
+	expected := map[string]interface{}{
+		"name":   "site1",
+		"labels": []interface{}{"label1", "label2"},
+	}
+
+	actual, err := resourceMSOSiteStateUpgradeV1(rawState, nil)
+	if err != nil {
+		t.Fatalf("error upgrading state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
+
 func testAccCheckMsoSiteConfig_basic(name string) string {
 	return fmt.Sprintf(`
 	resource "mso_site" "site1" {
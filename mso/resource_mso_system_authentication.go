@@ -0,0 +1,197 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const authSettingsUrl = "api/v1/auth/settings"
+
+func resourceMSOSystemAuthentication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSystemAuthenticationCreate,
+		Update: resourceMSOSystemAuthenticationUpdate,
+		Read:   resourceMSOSystemAuthenticationRead,
+		Delete: resourceMSOSystemAuthenticationDelete,
+
+		// Import is not defined because the create function can behave as an import when no config is provided
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"default_login_domain": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"fallback_to_local": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"remote_user_default_roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"roleid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"access_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "readOnly",
+							ValidateFunc: validation.StringInSlice([]string{
+								"readOnly",
+								"readWrite",
+							}, false),
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+// getLoginDomainName returns the name of the login domain with domainId, the local domain has no id and is returned as Local.
+func getLoginDomainName(msoClient *client.Client, domainId string) (string, error) {
+	if domainId == "" {
+		return "Local", nil
+	}
+	domainsCont, err := msoClient.GetViaURL("api/v1/auth/login-domains")
+	if err != nil {
+		return "", err
+	}
+	count, _ := domainsCont.ArrayCount("domains")
+	for i := 0; i < count; i++ {
+		domainCont, err := domainsCont.ArrayElement(i, "domains")
+		if err != nil {
+			return "", err
+		}
+		if models.StripQuotes(domainCont.S("id").String()) == domainId {
+			return models.StripQuotes(domainCont.S("name").String()), nil
+		}
+	}
+	return "", fmt.Errorf("Unable to find the login domain with id %s", domainId)
+}
+
+func setSystemAuthentication(d *schema.ResourceData, msoClient *client.Client) error {
+	settingsCont, err := msoClient.GetViaURL(authSettingsUrl)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("default_login_domain") {
+		domainId := ""
+		if domain := d.Get("default_login_domain").(string); domain != "Local" {
+			domainId, err = msoClient.GetDomainId(domain)
+			if err != nil {
+				return err
+			}
+		}
+		settingsCont.Set(domainId, "defaultLoginDomain")
+	}
+
+	if d.HasChange("fallback_to_local") {
+		settingsCont.Set(d.Get("fallback_to_local").(bool), "fallbackToLocal")
+	}
+
+	if d.HasChange("remote_user_default_roles") {
+		roles := make([]interface{}, 0)
+		for _, role := range d.Get("remote_user_default_roles").(*schema.Set).List() {
+			roleMap := role.(map[string]interface{})
+			roles = append(roles, map[string]interface{}{
+				"roleId":     roleMap["roleid"],
+				"accessType": roleMap["access_type"],
+			})
+		}
+		settingsCont.Set(roles, "remoteUserDefaultRoles")
+	}
+
+	_, _, err = doRequestWithContext(getStopContext(msoClient), msoClient, "PUT", authSettingsUrl, settingsCont)
+	return err
+}
+
+func resourceMSOSystemAuthenticationCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] System Authentication: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	_, domainOk := d.GetOk("default_login_domain")
+	_, fallbackOk := d.GetOkExists("fallback_to_local")
+	_, rolesOk := d.GetOk("remote_user_default_roles")
+	if domainOk || fallbackOk || rolesOk {
+		err := setSystemAuthentication(d, msoClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("authentication")
+	log.Printf("[DEBUG] %s: System Authentication Creation finished successfully", d.Id())
+
+	return resourceMSOSystemAuthenticationRead(d, m)
+}
+
+func resourceMSOSystemAuthenticationUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	err := setSystemAuthentication(d, m.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: System Authentication Update finished successfully", d.Id())
+	return resourceMSOSystemAuthenticationRead(d, m)
+}
+
+func resourceMSOSystemAuthenticationRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	settingsCont, err := msoClient.GetViaURL(authSettingsUrl)
+	if err != nil {
+		return err
+	}
+
+	domainName, err := getLoginDomainName(msoClient, convertInterfaceToString(settingsCont.S("defaultLoginDomain").Data()))
+	if err != nil {
+		return err
+	}
+	d.Set("default_login_domain", domainName)
+
+	fallbackToLocal, _ := settingsCont.S("fallbackToLocal").Data().(bool)
+	d.Set("fallback_to_local", fallbackToLocal)
+
+	roles := make([]interface{}, 0)
+	count, _ := settingsCont.ArrayCount("remoteUserDefaultRoles")
+	for i := 0; i < count; i++ {
+		roleCont, err := settingsCont.ArrayElement(i, "remoteUserDefaultRoles")
+		if err != nil {
+			return err
+		}
+		roles = append(roles, map[string]interface{}{
+			"roleid":      models.StripQuotes(roleCont.S("roleId").String()),
+			"access_type": models.StripQuotes(roleCont.S("accessType").String()),
+		})
+	}
+	d.Set("remote_user_default_roles", roles)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSystemAuthenticationDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	d.SetId("")
+	log.Printf("[DEBUG] Destroy finished successfully")
+	return nil
+}
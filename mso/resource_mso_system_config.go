@@ -187,7 +187,7 @@ func patchSystemConfig(d *schema.ResourceData, msoClient *client.Client, systemC
 	}
 
 	if len(patchPayloads) > 0 {
-		_, err := msoClient.PatchbyID(fmt.Sprintf("%s/%s", systemConfigUrl, systemConfigId), patchPayloads...)
+		_, err := patchbyID(msoClient, fmt.Sprintf("%s/%s", systemConfigUrl, systemConfigId), patchPayloads...)
 		if err != nil {
 			log.Println(err)
 			return err
@@ -0,0 +1,125 @@
+package mso
+
+import (
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSystemDns() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSystemDnsCreate,
+		Update: resourceMSOSystemDnsUpdate,
+		Read:   resourceMSOSystemDnsRead,
+		Delete: resourceMSOSystemDnsDelete,
+
+		// Import is not defined because the create function can behave as an import when no config is provided
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"name_servers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			"search_domains": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+		}),
+	}
+}
+
+func setSystemDns(d *schema.ResourceData, msoClient *client.Client) error {
+	return updateNDClusterConfig(msoClient, func(specCont *container.Container) error {
+		for attribute, key := range map[string]string{"name_servers": "nameServers", "search_domains": "searchDomains"} {
+			if !d.HasChange(attribute) {
+				continue
+			}
+			_, err := specCont.Set(d.Get(attribute).([]interface{}), key)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func resourceMSOSystemDnsCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] System DNS: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	_, nameServersOk := d.GetOk("name_servers")
+	_, searchDomainsOk := d.GetOk("search_domains")
+	if nameServersOk || searchDomainsOk {
+		err := setSystemDns(d, msoClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("dns")
+	log.Printf("[DEBUG] %s: System DNS Creation finished successfully", d.Id())
+
+	return resourceMSOSystemDnsRead(d, m)
+}
+
+func resourceMSOSystemDnsUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	if d.HasChange("name_servers") || d.HasChange("search_domains") {
+		err := setSystemDns(d, m.(*client.Client))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: System DNS Update finished successfully", d.Id())
+	return resourceMSOSystemDnsRead(d, m)
+}
+
+func resourceMSOSystemDnsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	configCont, err := getNDClusterConfig(m.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	for attribute, key := range map[string]string{"name_servers": "nameServers", "search_domains": "searchDomains"} {
+		values := make([]string, 0)
+		count, _ := configCont.ArrayCount("spec", key)
+		for i := 0; i < count; i++ {
+			valueCont, err := configCont.ArrayElement(i, "spec", key)
+			if err != nil {
+				return err
+			}
+			values = append(values, models.StripQuotes(valueCont.String()))
+		}
+		d.Set(attribute, values)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSystemDnsDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	d.SetId("")
+	log.Printf("[DEBUG] Destroy finished successfully")
+	return nil
+}
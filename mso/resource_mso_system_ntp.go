@@ -0,0 +1,126 @@
+package mso
+
+import (
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSystemNtp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSystemNtpCreate,
+		Update: resourceMSOSystemNtpUpdate,
+		Read:   resourceMSOSystemNtpRead,
+		Delete: resourceMSOSystemNtpDelete,
+
+		// Import is not defined because the create function can behave as an import when no config is provided
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"prefer": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func setSystemNtpServers(d *schema.ResourceData, msoClient *client.Client) error {
+	serverList := make([]interface{}, 0)
+	for _, server := range d.Get("server").([]interface{}) {
+		serverMap := server.(map[string]interface{})
+		serverList = append(serverList, map[string]interface{}{
+			"host":   serverMap["host"],
+			"prefer": serverMap["prefer"],
+		})
+	}
+	return updateNDClusterConfig(msoClient, func(specCont *container.Container) error {
+		_, err := specCont.Set(serverList, "ntpServers")
+		return err
+	})
+}
+
+func resourceMSOSystemNtpCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] System NTP: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	if _, ok := d.GetOk("server"); ok {
+		err := setSystemNtpServers(d, msoClient)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("ntp")
+	log.Printf("[DEBUG] %s: System NTP Creation finished successfully", d.Id())
+
+	return resourceMSOSystemNtpRead(d, m)
+}
+
+func resourceMSOSystemNtpUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	if d.HasChange("server") {
+		err := setSystemNtpServers(d, m.(*client.Client))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: System NTP Update finished successfully", d.Id())
+	return resourceMSOSystemNtpRead(d, m)
+}
+
+func resourceMSOSystemNtpRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	configCont, err := getNDClusterConfig(m.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	serverList := make([]interface{}, 0)
+	serverCount, _ := configCont.ArrayCount("spec", "ntpServers")
+	for i := 0; i < serverCount; i++ {
+		serverCont, err := configCont.ArrayElement(i, "spec", "ntpServers")
+		if err != nil {
+			return err
+		}
+		prefer, _ := serverCont.S("prefer").Data().(bool)
+		serverList = append(serverList, map[string]interface{}{
+			"host":   models.StripQuotes(serverCont.S("host").String()),
+			"prefer": prefer,
+		})
+	}
+	d.Set("server", serverList)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSystemNtpDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	d.SetId("")
+	log.Printf("[DEBUG] Destroy finished successfully")
+	return nil
+}
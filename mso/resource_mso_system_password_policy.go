@@ -0,0 +1,159 @@
+package mso
+
+import (
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const passwordPolicyUrl = "api/v1/auth/password-policy"
+
+// passwordPolicyAttributes maps the attributes of the resource to the keys of the password policy payload.
+var passwordPolicyAttributes = map[string]string{
+	"min_length":                "minLength",
+	"require_uppercase":         "requireUpperCase",
+	"require_lowercase":         "requireLowerCase",
+	"require_digit":             "requireDigit",
+	"require_special_character": "requireSpecialCharacter",
+	"history_count":             "historyCount",
+	"expiration_days":           "expirationDays",
+	"lockout_attempts":          "lockoutAttempts",
+	"lockout_duration":          "lockoutDuration",
+}
+
+func resourceMSOSystemPasswordPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSystemPasswordPolicyCreate,
+		Update: resourceMSOSystemPasswordPolicyUpdate,
+		Read:   resourceMSOSystemPasswordPolicyRead,
+		Delete: resourceMSOSystemPasswordPolicyDelete,
+
+		// Import is not defined because the create function can behave as an import when no config is provided
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"min_length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(8, 64),
+			},
+			"require_uppercase": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"require_lowercase": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"require_digit": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"require_special_character": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"history_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 15),
+			},
+			"expiration_days": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 3650),
+			},
+			"lockout_attempts": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"lockout_duration": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1440),
+			},
+		}),
+	}
+}
+
+// setSystemPasswordPolicy replaces the password policy with the current policy updated with the changed attributes.
+func setSystemPasswordPolicy(d *schema.ResourceData, msoClient *client.Client) error {
+	policyCont, err := msoClient.GetViaURL(passwordPolicyUrl)
+	if err != nil {
+		return err
+	}
+	for attribute, key := range passwordPolicyAttributes {
+		if d.HasChange(attribute) {
+			policyCont.Set(d.Get(attribute), key)
+		}
+	}
+	_, _, err = doRequestWithContext(getStopContext(msoClient), msoClient, "PUT", passwordPolicyUrl, policyCont)
+	return err
+}
+
+func resourceMSOSystemPasswordPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] System Password Policy: Beginning Creation")
+
+	err := setSystemPasswordPolicy(d, m.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("password_policy")
+	log.Printf("[DEBUG] %s: System Password Policy Creation finished successfully", d.Id())
+
+	return resourceMSOSystemPasswordPolicyRead(d, m)
+}
+
+func resourceMSOSystemPasswordPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	err := setSystemPasswordPolicy(d, m.(*client.Client))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: System Password Policy Update finished successfully", d.Id())
+	return resourceMSOSystemPasswordPolicyRead(d, m)
+}
+
+func resourceMSOSystemPasswordPolicyRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	policyCont, err := m.(*client.Client).GetViaURL(passwordPolicyUrl)
+	if err != nil {
+		return err
+	}
+
+	for attribute, key := range passwordPolicyAttributes {
+		value := policyCont.S(key).Data()
+		if _, ok := value.(bool); ok {
+			d.Set(attribute, value)
+		} else {
+			d.Set(attribute, convertInterfaceToInt(value))
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSystemPasswordPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	d.SetId("")
+	log.Printf("[DEBUG] Destroy finished successfully")
+	return nil
+}
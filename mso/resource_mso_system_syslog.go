@@ -0,0 +1,173 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOSystemSyslog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOSystemSyslogCreate,
+		Update: resourceMSOSystemSyslogUpdate,
+		Read:   resourceMSOSystemSyslogRead,
+		Delete: resourceMSOSystemSyslogDelete,
+
+		// Import is not defined because the create function can behave as an import when no config is provided
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"server": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      514,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"protocol": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "udp",
+							ValidateFunc: validation.StringInSlice([]string{
+								"udp",
+								"tcp",
+							}, false),
+						},
+						"facility": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "local7",
+							ValidateFunc: validation.StringInSlice([]string{
+								"local0",
+								"local1",
+								"local2",
+								"local3",
+								"local4",
+								"local5",
+								"local6",
+								"local7",
+							}, false),
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func patchSystemSyslog(msoClient *client.Client, systemConfigId string, servers []interface{}) error {
+	serverList := make([]interface{}, 0, len(servers))
+	for _, server := range servers {
+		serverMap := server.(map[string]interface{})
+		serverList = append(serverList, map[string]interface{}{
+			"host":     serverMap["host"],
+			"port":     serverMap["port"],
+			"protocol": serverMap["protocol"],
+			"facility": serverMap["facility"],
+		})
+	}
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	// The add operation replaces the syslog configuration when it already exists.
+	err := addPatchPayloadToContainer(payloadCon, "add", "/syslogConfig", map[string]interface{}{"servers": serverList})
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("%s/%s", systemConfigUrl, systemConfigId), payloadCon)
+}
+
+func resourceMSOSystemSyslogCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] System Syslog: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	con, err := msoClient.GetViaURL(systemConfigUrl)
+	if err != nil {
+		return err
+	}
+	systemConfigId := models.StripQuotes(con.Search("systemConfigs").Search("id").String())
+
+	if servers, ok := d.GetOk("server"); ok {
+		err = patchSystemSyslog(msoClient, systemConfigId, servers.([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(systemConfigId)
+	log.Printf("[DEBUG] %s: System Syslog Creation finished successfully", d.Id())
+
+	return resourceMSOSystemSyslogRead(d, m)
+}
+
+func resourceMSOSystemSyslogUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	if d.HasChange("server") {
+		err := patchSystemSyslog(m.(*client.Client), d.Id(), d.Get("server").([]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: System Syslog Update finished successfully", d.Id())
+	return resourceMSOSystemSyslogRead(d, m)
+}
+
+func resourceMSOSystemSyslogRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	con, err := msoClient.GetViaURL(systemConfigUrl)
+	if err != nil {
+		return err
+	}
+
+	serverList := make([]interface{}, 0)
+	serverCount, _ := con.ArrayCount("systemConfigs", "syslogConfig", "servers")
+	for i := 0; i < serverCount; i++ {
+		serverCont, err := con.ArrayElement(i, "systemConfigs", "syslogConfig", "servers")
+		if err != nil {
+			return err
+		}
+		serverList = append(serverList, map[string]interface{}{
+			"host":     models.StripQuotes(serverCont.S("host").String()),
+			"port":     convertInterfaceToInt(serverCont.S("port").Data()),
+			"protocol": models.StripQuotes(serverCont.S("protocol").String()),
+			"facility": models.StripQuotes(serverCont.S("facility").String()),
+		})
+	}
+	d.Set("server", serverList)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOSystemSyslogDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+
+	err := patchSystemSyslog(m.(*client.Client), d.Id(), make([]interface{}, 0))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
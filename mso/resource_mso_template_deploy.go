@@ -0,0 +1,252 @@
+package mso
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOTemplateDeploy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOTemplateDeployExecute,
+		Read:   resourceMSOTemplateDeployRead,
+		Update: resourceMSOTemplateDeployExecute,
+		Delete: resourceMSOTemplateDeployDelete,
+
+		SchemaVersion: version,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceMSOTemplateDeployCustomizeDiff,
+
+		Schema: (map[string]*schema.Schema{
+			"template_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_id", "schema_id"},
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"schema_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"template_name"},
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"template_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"schema_id"},
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"re_deploy": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"redeploy_on_change": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"redeploy_triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"task_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}),
+	}
+}
+
+// getDeployTemplateContent returns the content of the template which is deployed, the template object of the templates API or the template in the schema.
+// The content is nil without an error when the template or its schema does not exist.
+func getDeployTemplateContent(msoClient *client.Client, templateId, schemaId, templateName string) (interface{}, error) {
+	if templateId != "" {
+		cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+		if err != nil {
+			if isObjectNotFound(err, cont) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		content, _ := cont.Data().(map[string]interface{})
+		// The update version changes on every change, also when the content does not.
+		delete(content, "_updateVersion")
+		return content, nil
+	}
+
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		if isObjectNotFound(err, cont) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	templates, _ := cont.S("templates").Data().([]interface{})
+	for _, template := range templates {
+		if templateMap, ok := template.(map[string]interface{}); ok && templateMap["name"] == templateName {
+			return templateMap, nil
+		}
+	}
+	return nil, nil
+}
+
+// getTemplateContentHash returns the SHA-256 hash of the JSON of the template content, of which the keys are sorted by encoding/json.
+func getTemplateContentHash(content interface{}) (string, error) {
+	contentJson, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(contentJson)), nil
+}
+
+// resourceMSOTemplateDeployCustomizeDiff plans a deployment when the content of the deployed template changed since the last deployment.
+// Changes made in the same apply as the deployment are only detected by the next plan, use redeploy_triggers for those.
+// A template which no longer exists, like when it is removed out-of-band and the plan is not refreshed, also plans a deployment.
+func resourceMSOTemplateDeployCustomizeDiff(diff *schema.ResourceDiff, m interface{}) error {
+	if diff.Id() == "" || !diff.Get("redeploy_on_change").(bool) {
+		return nil
+	}
+	content, err := getDeployTemplateContent(m.(*client.Client), diff.Get("template_id").(string), diff.Get("schema_id").(string), diff.Get("template_name").(string))
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		log.Printf("[WARN] %s: Template of the deployment not found", diff.Id())
+		return diff.SetNewComputed("content_hash")
+	}
+	contentHash, err := getTemplateContentHash(content)
+	if err != nil {
+		return err
+	}
+	if contentHash != diff.Get("content_hash").(string) {
+		log.Printf("[DEBUG] %s: Template content changed since the last deployment", diff.Id())
+		return diff.SetNewComputed("content_hash")
+	}
+	return nil
+}
+
+// startTemplateDeployTask starts the deploy task of a template of the templates API, it returns the id of the task.
+func startTemplateDeployTask(ctx context.Context, msoClient *client.Client, templateId string, redeploy bool) (string, error) {
+	payload, err := container.Consume(map[string]interface{}{
+		"templateId": templateId,
+		"isRedeploy": redeploy,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	taskCont, resp, err := doRequestWithContext(ctx, msoClient, "POST", "api/v1/task", payload)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != 202 {
+		return "", fmt.Errorf("Unable to deploy template %s, status code %d", templateId, resp.StatusCode)
+	}
+	return models.StripQuotes(taskCont.S("id").String()), nil
+}
+
+func resourceMSOTemplateDeployExecute(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Template Deploy", d.Id())
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+	schemaId := d.Get("schema_id").(string)
+	templateName := d.Get("template_name").(string)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), timeout)
+	defer cancel()
+
+	// The hash is taken before the deployment, so changes made while the deployment runs are deployed by the next apply.
+	content, err := getDeployTemplateContent(msoClient, templateId, schemaId, templateName)
+	if err != nil {
+		return err
+	}
+	if content == nil {
+		if templateId != "" {
+			return fmt.Errorf("Template %s not found", templateId)
+		}
+		return fmt.Errorf("Template %s not found in schema %s", templateName, schemaId)
+	}
+	contentHash, err := getTemplateContentHash(content)
+	if err != nil {
+		return err
+	}
+
+	var taskId string
+	if templateId != "" {
+		taskId, err = startTemplateDeployTask(ctx, msoClient, templateId, d.Get("re_deploy").(bool))
+	} else {
+		taskId, err = startDeployTask(ctx, msoClient, schemaId, templateName, d.Get("re_deploy").(bool))
+	}
+	if err != nil {
+		return err
+	}
+	err = verifyDeployTask(ctx, msoClient, taskId)
+	if err != nil {
+		return err
+	}
+
+	if templateId != "" {
+		d.SetId(templateId)
+	} else {
+		d.SetId(fmt.Sprintf("%s/templates/%s", schemaId, templateName))
+	}
+	d.Set("content_hash", contentHash)
+	d.Set("task_id", taskId)
+	log.Printf("[DEBUG] %s: Template Deploy finished successfully", d.Id())
+	return resourceMSOTemplateDeployRead(d, m)
+}
+
+// resourceMSOTemplateDeployRead removes the deployment from the state when the template no longer exists.
+// The content hash is kept, because it is the hash of the deployed content.
+func resourceMSOTemplateDeployRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	path := fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string))
+	if templateId := d.Get("template_id").(string); templateId != "" {
+		path = fmt.Sprintf("api/v1/templates/%s", templateId)
+	}
+	err := readDeployedObject(d, msoClient, path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+// resourceMSOTemplateDeployDelete only removes the deployment from the state, the template stays deployed on the sites.
+func resourceMSOTemplateDeployDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
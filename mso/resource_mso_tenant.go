@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/container"
@@ -68,135 +69,7 @@ func resourceMSOTenant() *schema.Resource {
 			"site_associations": &schema.Schema{
 				Type: schema.TypeList,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"site_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"security_domains": {
-							Type:     schema.TypeList,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Optional: true,
-						},
-						"vendor": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"aws",
-								"azure",
-								"gcp",
-							}, false),
-						},
-						"gcp_project_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"gcp_access_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"unmanaged",
-								"managed",
-							}, false),
-						},
-						"gcp_name": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"gcp_key_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"gcp_private_key": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"gcp_client_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"gcp_email": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"aws_account_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: StringLenValidator(12),
-						},
-						"is_aws_account_trusted": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
-						},
-						"aws_access_key_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: StringLenValidator(20),
-						},
-						"aws_secret_key": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: StringLenValidator(40),
-						},
-						"azure_subscription_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"azure_access_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"credentials",
-								"managed",
-								"shared",
-							}, false),
-						},
-						"azure_shared_account_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"azure_application_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"azure_client_secret": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-						"azure_active_directory_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringLenBetween(1, 1000),
-						},
-					},
+					Schema: tenantSiteAssociationSchema(),
 				},
 				Optional: true,
 				Computed: true,
@@ -205,6 +78,142 @@ func resourceMSOTenant() *schema.Resource {
 	}
 }
 
+// tenantSiteAssociationSchema returns the schema of a site association with its cloud account settings.
+func tenantSiteAssociationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"site_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"security_domains": {
+			Type:     schema.TypeList,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+		"vendor": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"aws",
+				"azure",
+				"gcp",
+			}, false),
+		},
+		"gcp_project_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"gcp_access_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"unmanaged",
+				"managed",
+			}, false),
+		},
+		"gcp_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"gcp_key_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"gcp_private_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"gcp_client_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"gcp_email": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"aws_account_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: StringLenValidator(12),
+		},
+		"is_aws_account_trusted": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"aws_access_key_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: StringLenValidator(20),
+		},
+		"aws_secret_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			ValidateFunc: StringLenValidator(40),
+		},
+		"azure_subscription_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"azure_access_type": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"credentials",
+				"managed",
+				"shared",
+			}, false),
+		},
+		"azure_shared_account_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"azure_application_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"azure_client_secret": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+		"azure_active_directory_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		},
+	}
+}
+
 func StringLenValidator(lengt int) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (warnings []string, errors []error) {
 		v, ok := i.(string)
@@ -309,6 +318,73 @@ func setGcpAccountDetails(mapSite, accountDetails map[string]interface{}, tenant
 	mapSite["gcpAccount"] = [...]interface{}{gcpAccountMap}
 }
 
+// tenantAssociationMutex serializes the updates of tenant associations, which replace the whole tenant.
+var tenantAssociationMutex sync.Mutex
+
+// updateTenantAssociations applies update to the current tenant and sends the result.
+// The tenant has no PATCH support, so concurrent updates of the same tenant would otherwise overwrite each other.
+func updateTenantAssociations(msoClient *client.Client, tenantId string, update func(tenantCont *container.Container) error) error {
+	tenantAssociationMutex.Lock()
+	defer tenantAssociationMutex.Unlock()
+
+	path := fmt.Sprintf("api/v1/tenants/%s", tenantId)
+	tenantCont, err := msoClient.GetViaURL(path)
+	if err != nil {
+		return err
+	}
+	err = update(tenantCont)
+	if err != nil {
+		return err
+	}
+	_, _, err = doRequestWithContext(getStopContext(msoClient), msoClient, "PUT", path, tenantCont)
+	return err
+}
+
+// getTenantAssociationIndex returns the index of the association with the value in idKey, or -1 when it is not found.
+func getTenantAssociationIndex(tenantCont *container.Container, listKey, idKey, id string) (int, error) {
+	count, _ := tenantCont.ArrayCount(listKey)
+	for i := 0; i < count; i++ {
+		associationCont, err := tenantCont.ArrayElement(i, listKey)
+		if err != nil {
+			return -1, err
+		}
+		if models.StripQuotes(associationCont.S(idKey).String()) == id {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// removeTenantAssociation removes the association with the value in idKey from the list, when it is found.
+func removeTenantAssociation(tenantCont *container.Container, listKey, idKey, id string) error {
+	index, err := getTenantAssociationIndex(tenantCont, listKey, idKey, id)
+	if err != nil || index == -1 {
+		return err
+	}
+	associations := tenantCont.S(listKey).Data().([]interface{})
+	_, err = tenantCont.Set(append(associations[:index:index], associations[index+1:]...), listKey)
+	return err
+}
+
+// readTenantSiteAssociation returns the attributes of a site association with its cloud account settings.
+func readTenantSiteAssociation(sitesCont *container.Container) map[string]interface{} {
+	mapSite := make(map[string]interface{})
+	mapSite["site_id"] = models.StripQuotes(sitesCont.S("siteId").String())
+	mapSite["security_domains"] = sitesCont.S("securityDomains").Data().([]interface{})
+
+	readGcpAccountDataFromSchema(sitesCont, mapSite)
+	readAwsAccountDataFromSchema(sitesCont, mapSite)
+	readAzureAccountDataFromSchema(sitesCont, mapSite)
+
+	if sitesCont.Exists("cloudAccount") && sitesCont.S("cloudAccount").String() != "{}" {
+		splitStr := strings.Split(sitesCont.S("cloudAccount").String(), "/")
+		if len(splitStr) > 2 {
+			setCloudAccountInfo(splitStr[2], mapSite)
+		}
+	}
+	return mapSite
+}
+
 func resourceMSOTenantImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	log.Printf("[DEBUG] Tenant: Beginning Import")
 	msoClient := m.(*client.Client)
@@ -320,6 +396,8 @@ func resourceMSOTenantImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	d.Set("name", models.StripQuotes(con.S("name").String()))
 	d.Set("display_name", models.StripQuotes(con.S("displayName").String()))
 	d.Set("description", models.StripQuotes(con.S("description").String()))
+	// The option is not stored in MSO, set the default to avoid a diff after the import.
+	d.Set("orchestrator_only", false)
 	count1, _ := con.ArrayCount("siteAssociations")
 	site_associations := make([]interface{}, 0)
 	for i := 0; i < count1; i++ {
@@ -327,20 +405,7 @@ func resourceMSOTenantImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 		if err != nil {
 			return nil, fmt.Errorf("Unable to parse the site associations list")
 		}
-		mapSite := make(map[string]interface{})
-		mapSite["site_id"] = models.StripQuotes(sitesCont.S("siteId").String())
-		mapSite["security_domains"] = sitesCont.S("securityDomains").Data().([]interface{})
-
-		readGcpAccountDataFromSchema(sitesCont, mapSite)
-		readAwsAccountDataFromSchema(sitesCont, mapSite)
-		readAzureAccountDataFromSchema(sitesCont, mapSite)
-
-		if sitesCont.Exists("cloudAccount") && sitesCont.S("cloudAccount").String() != "{}" {
-			splitStr := strings.Split(sitesCont.S("cloudAccount").String(), "/")
-			if len(splitStr) > 2 {
-				setCloudAccountInfo(splitStr[2], mapSite)
-			}
-		}
+		mapSite := readTenantSiteAssociation(sitesCont)
 
 		site_associations = append(site_associations, mapSite)
 	}
@@ -364,171 +429,187 @@ func resourceMSOTenantImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceMSOTenantCreate(d *schema.ResourceData, m interface{}) error {
-	log.Printf("[DEBUG] Tenant: Beginning Creation")
-	msoClient := m.(*client.Client)
-	tenantAttr := models.TenantAttributes{}
-
-	if name, ok := d.GetOk("name"); ok {
-		tenantAttr.Name = name.(string)
+// getTenantSiteAssociations returns the site associations payload with the cloud account settings of each site.
+func getTenantSiteAssociations(d *schema.ResourceData, msoClient *client.Client, tenantName string, new bool) ([]interface{}, error) {
+	site_associations := make([]interface{}, 0, 1)
+	if val, ok := d.GetOk("site_associations"); ok {
+		for _, val := range val.([]interface{}) {
+			mapSite, err := getTenantSiteAssociation(val.(map[string]interface{}), msoClient, tenantName, new)
+			if err != nil {
+				return nil, err
+			}
+			site_associations = append(site_associations, mapSite)
+		}
 	}
+	return site_associations, nil
+}
 
-	if display_name, ok := d.GetOk("display_name"); ok {
-		tenantAttr.DisplayName = display_name.(string)
+// getTenantSiteAssociation returns the payload of a single site association from its attributes.
+func getTenantSiteAssociation(inner map[string]interface{}, msoClient *client.Client, tenantName string, new bool) (map[string]interface{}, error) {
+	mapSite := make(map[string]interface{})
+	if inner["site_id"] != "" {
+		mapSite["siteId"] = fmt.Sprintf("%v", inner["site_id"])
 	}
+	if inner["vendor"] != "" {
+		if inner["vendor"] == "gcp" {
 
-	if description, ok := d.GetOk("description"); ok {
-		tenantAttr.Description = description.(string)
-	}
+			if inner["gcp_project_id"] == "" {
+				return nil, fmt.Errorf("gcp_project_id is required with vendor = gcp")
+			}
 
-	site_associations := make([]interface{}, 0, 1)
-	if val, ok := d.GetOk("site_associations"); ok {
-		siteList := val.([]interface{})
-		for _, val := range siteList {
+			setGcpAccountDetails(mapSite, inner, tenantName, new)
 
-			mapSite := make(map[string]interface{})
-			inner := val.(map[string]interface{})
-			if inner["site_id"] != "" {
-				mapSite["siteId"] = fmt.Sprintf("%v", inner["site_id"])
+		} else if inner["vendor"] == "aws" {
+
+			awsAccountMap := make(map[string]interface{})
+
+			if inner["aws_account_id"] != "" {
+				awsAccountMap["accountId"] = inner["aws_account_id"]
+				mapSite["cloudAccount"] = inner["aws_account_id"]
+			} else {
+				return nil, fmt.Errorf("aws_account_id is required with vendor = aws")
 			}
-			if inner["vendor"] != "" {
-				if inner["vendor"] == "gcp" {
 
-					if inner["gcp_project_id"] == "" {
-						return fmt.Errorf("gcp_project_id is required with vendor = gcp")
-					}
+			trusted := inner["is_aws_account_trusted"]
+			awsAccountMap["isTrusted"] = trusted.(bool)
+			awsAccountMap["vendor"] = "aws"
 
-					setGcpAccountDetails(mapSite, inner, tenantAttr.Name, true)
+			if !(trusted.(bool)) {
+				if inner["aws_access_key_id"] != "" {
+					awsAccountMap["accessKeyId"] = inner["aws_access_key_id"]
+				} else {
+					return nil, fmt.Errorf("aws_access_key_id is required if the AWS account is not trusted.")
+				}
+
+				if inner["aws_secret_key"] != "" {
+					awsAccountMap["secretKey"] = inner["aws_secret_key"]
+				} else {
+					return nil, fmt.Errorf("aws_secret_key is required if the AWS account is not trusted.")
+				}
+			}
 
-				} else if inner["vendor"] == "aws" {
+			mapSite["awsAccount"] = [...]interface{}{awsAccountMap}
 
-					awsAccountMap := make(map[string]interface{})
+		} else if inner["vendor"] == "azure" {
+			azureAccountMap := make(map[string]interface{})
+			var subscriptionId string
 
-					if inner["aws_account_id"] != "" {
-						awsAccountMap["accountId"] = inner["aws_account_id"]
-						mapSite["cloudAccount"] = inner["aws_account_id"]
-					} else {
-						return fmt.Errorf("aws_account_id is required with vendor = aws")
-					}
+			azureAccessType := inner["azure_access_type"].(string)
+			applicationId := inner["azure_application_id"].(string)
+			clientSecret := inner["azure_client_secret"].(string)
+			activeDirectoryId := inner["azure_active_directory_id"].(string)
+			sharedAccID := inner["azure_shared_account_id"].(string)
 
-					trusted := inner["is_aws_account_trusted"]
-					awsAccountMap["isTrusted"] = trusted.(bool)
-					awsAccountMap["vendor"] = "aws"
-
-					if !(trusted.(bool)) {
-						if inner["aws_access_key_id"] != "" {
-							awsAccountMap["accessKeyId"] = inner["aws_access_key_id"]
-						} else {
-							return fmt.Errorf("aws_access_key_id is required if the AWS account is not trusted.")
-						}
-
-						if inner["aws_secret_key"] != "" {
-							awsAccountMap["secretKey"] = inner["aws_secret_key"]
-						} else {
-							return fmt.Errorf("aws_secret_key is required if the AWS account is not trusted.")
-						}
-					}
+			if azureAccessType == "" {
+				azureAccessType = "managed"
+			}
+			cloudSubMap := make(map[string]interface{})
+			if azureAccessType == "managed" {
+				if inner["azure_subscription_id"] != "" {
+					subscriptionId = inner["azure_subscription_id"].(string)
+					mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantName, subscriptionId)
+				} else {
+					return nil, fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
+				}
 
-					mapSite["awsAccount"] = [...]interface{}{awsAccountMap}
+				cloudSubMap["cloudSubscriptionId"] = subscriptionId
+				azureAccountMap["cloudSubscription"] = cloudSubMap
 
-				} else if inner["vendor"] == "azure" {
-					azureAccountMap := make(map[string]interface{})
-					var subscriptionId string
+				azureAccountMap["securityDomains"] = make([]interface{}, 0)
+				azureAccountMap["vendor"] = "azure"
+				azureAccountMap["accessType"] = azureAccessType
 
-					azureAccessType := inner["azure_access_type"].(string)
-					applicationId := inner["azure_application_id"].(string)
-					clientSecret := inner["azure_client_secret"].(string)
-					activeDirectoryId := inner["azure_active_directory_id"].(string)
-					sharedAccID := inner["azure_shared_account_id"].(string)
+				mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
 
-					if azureAccessType == "" {
-						azureAccessType = "managed"
-					}
-					cloudSubMap := make(map[string]interface{})
-					if azureAccessType == "managed" {
-						if inner["azure_subscription_id"] != "" {
-							subscriptionId = inner["azure_subscription_id"].(string)
-							mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantAttr.Name, subscriptionId)
-						} else {
-							return fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
-						}
-
-						cloudSubMap["cloudSubscriptionId"] = subscriptionId
-						azureAccountMap["cloudSubscription"] = cloudSubMap
-
-						azureAccountMap["securityDomains"] = make([]interface{}, 0)
-						azureAccountMap["vendor"] = "azure"
-						azureAccountMap["accessType"] = azureAccessType
-
-						mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
-
-					} else if azureAccessType == "credentials" {
-						if applicationId == "" || clientSecret == "" || activeDirectoryId == "" {
-							return fmt.Errorf("azure_application_id, azure_client_secret and azure_active_directory_id are required with azure_access_type = credentials")
-						}
-
-						if inner["azure_subscription_id"] != "" {
-							subscriptionId = inner["azure_subscription_id"].(string)
-							mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantAttr.Name, subscriptionId)
-						} else {
-							return fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
-						}
-
-						cloudSubMap["cloudSubscriptionId"] = subscriptionId
-						cloudSubMap["cloudApplicationId"] = applicationId
-						azureAccountMap["cloudSubscription"] = cloudSubMap
-						cloudApplicationMap := make(map[string]interface{})
-
-						cloudApplicationMap["cloudApplicationId"] = applicationId
-						cloudApplicationMap["secretKey"] = clientSecret
-						cloudApplicationMap["cloudActiveDirectoryId"] = activeDirectoryId
-						cloudApplicationMap["cloudCredentialName"] = "cApicApp"
-
-						azureAccountMap["cloudApplication"] = [...]interface{}{cloudApplicationMap}
-
-						activeDirectoryMap := make(map[string]interface{})
-						activeDirectoryMap["cloudActiveDirectoryId"] = activeDirectoryId
-						activeDirectoryMap["cloudActiveDirectoryName"] = "CiscoINSBUAd"
-
-						azureAccountMap["cloudActiveDirectory"] = [...]interface{}{activeDirectoryMap}
-
-						azureAccountMap["securityDomains"] = make([]interface{}, 0)
-						azureAccountMap["vendor"] = "azure"
-						azureAccountMap["accessType"] = azureAccessType
-
-						mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
-
-					} else if azureAccessType == "shared" {
-						if sharedAccID == "" || inner["site_id"] == "" {
-							return fmt.Errorf("azure_shared_account_id and site_id are required with azure_access_type = shared")
-						}
-						durl := fmt.Sprintf("api/v1/sites/%s/aci/cloud-accounts", inner["site_id"].(string))
-						cont, err := msoClient.GetViaURL(durl)
-						if err != nil {
-							return err
-						}
-
-						count, err := cont.ArrayCount("cloudAccounts")
-						if err != nil {
-							return err
-						}
-
-						for i := 0; i < count; i++ {
-							dn := models.StripQuotes(cont.S("cloudAccounts").Index(i).S("id").String())
-							if dn == sharedAccID {
-								mapSite["cloudAccount"] = models.StripQuotes(cont.S("cloudAccounts").Index(i).S("dn").String())
-								break
-							}
-						}
+			} else if azureAccessType == "credentials" {
+				if applicationId == "" || clientSecret == "" || activeDirectoryId == "" {
+					return nil, fmt.Errorf("azure_application_id, azure_client_secret and azure_active_directory_id are required with azure_access_type = credentials")
+				}
+
+				if inner["azure_subscription_id"] != "" {
+					subscriptionId = inner["azure_subscription_id"].(string)
+					mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantName, subscriptionId)
+				} else {
+					return nil, fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
+				}
+
+				cloudSubMap["cloudSubscriptionId"] = subscriptionId
+				cloudSubMap["cloudApplicationId"] = applicationId
+				azureAccountMap["cloudSubscription"] = cloudSubMap
+				cloudApplicationMap := make(map[string]interface{})
+
+				cloudApplicationMap["cloudApplicationId"] = applicationId
+				cloudApplicationMap["secretKey"] = clientSecret
+				cloudApplicationMap["cloudActiveDirectoryId"] = activeDirectoryId
+				cloudApplicationMap["cloudCredentialName"] = "cApicApp"
+
+				azureAccountMap["cloudApplication"] = [...]interface{}{cloudApplicationMap}
+
+				activeDirectoryMap := make(map[string]interface{})
+				activeDirectoryMap["cloudActiveDirectoryId"] = activeDirectoryId
+				activeDirectoryMap["cloudActiveDirectoryName"] = "CiscoINSBUAd"
+
+				azureAccountMap["cloudActiveDirectory"] = [...]interface{}{activeDirectoryMap}
+
+				azureAccountMap["securityDomains"] = make([]interface{}, 0)
+				azureAccountMap["vendor"] = "azure"
+				azureAccountMap["accessType"] = azureAccessType
+
+				mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
+
+			} else if azureAccessType == "shared" {
+				if sharedAccID == "" || inner["site_id"] == "" {
+					return nil, fmt.Errorf("azure_shared_account_id and site_id are required with azure_access_type = shared")
+				}
+				durl := fmt.Sprintf("api/v1/sites/%s/aci/cloud-accounts", inner["site_id"].(string))
+				cont, err := msoClient.GetViaURL(durl)
+				if err != nil {
+					return nil, err
+				}
+
+				count, err := cont.ArrayCount("cloudAccounts")
+				if err != nil {
+					return nil, err
+				}
+
+				for i := 0; i < count; i++ {
+					dn := models.StripQuotes(cont.S("cloudAccounts").Index(i).S("id").String())
+					if dn == sharedAccID {
+						mapSite["cloudAccount"] = models.StripQuotes(cont.S("cloudAccounts").Index(i).S("dn").String())
+						break
 					}
 				}
+				if mapSite["cloudAccount"] == nil {
+					return nil, fmt.Errorf("azure_shared_account_id %s is not a cloud account of site %s", sharedAccID, inner["site_id"].(string))
+				}
 			}
-
-			mapSite["securityDomains"] = make([]interface{}, 0)
-			site_associations = append(site_associations, mapSite)
 		}
 	}
-	log.Println("check .... : ", site_associations)
+	mapSite["securityDomains"] = inner["security_domains"].([]interface{})
+	return mapSite, nil
+}
+
+func resourceMSOTenantCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Tenant: Beginning Creation")
+	msoClient := m.(*client.Client)
+	tenantAttr := models.TenantAttributes{}
+
+	if name, ok := d.GetOk("name"); ok {
+		tenantAttr.Name = name.(string)
+	}
+
+	if display_name, ok := d.GetOk("display_name"); ok {
+		tenantAttr.DisplayName = display_name.(string)
+	}
+
+	if description, ok := d.GetOk("description"); ok {
+		tenantAttr.Description = description.(string)
+	}
+
+	site_associations, err := getTenantSiteAssociations(d, msoClient, tenantAttr.Name, true)
+	if err != nil {
+		return err
+	}
 	tenantAttr.Sites = site_associations
 
 	user_associations := make([]interface{}, 0, 1)
@@ -566,6 +647,12 @@ func resourceMSOTenantUpdate(d *schema.ResourceData, m interface{}) error {
 
 	msoClient := m.(*client.Client)
 
+	// The orchestrator_only option is only used on destroy, so changing it does not require an update of the tenant.
+	if !d.HasChanges("name", "display_name", "description", "site_associations", "user_associations") {
+		log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+		return resourceMSOTenantRead(d, m)
+	}
+
 	tenantAttr := models.TenantAttributes{}
 
 	if name, ok := d.GetOk("name"); ok {
@@ -580,151 +667,9 @@ func resourceMSOTenantUpdate(d *schema.ResourceData, m interface{}) error {
 		tenantAttr.Description = description.(string)
 	}
 
-	site_associations := make([]interface{}, 0, 1)
-	if val, ok := d.GetOk("site_associations"); ok {
-		siteList := val.([]interface{})
-		for _, val := range siteList {
-
-			mapSite := make(map[string]interface{})
-			inner := val.(map[string]interface{})
-			if inner["site_id"] != "" {
-				mapSite["siteId"] = fmt.Sprintf("%v", inner["site_id"])
-			}
-			if inner["vendor"] != "" {
-				if inner["vendor"] == "gcp" {
-
-					if inner["gcp_project_id"] == "" {
-						return fmt.Errorf("gcp_project_id is required with vendor = gcp")
-					}
-
-					setGcpAccountDetails(mapSite, inner, tenantAttr.Name, false)
-
-				} else if inner["vendor"] == "aws" {
-
-					awsAccountMap := make(map[string]interface{})
-
-					if inner["aws_account_id"] != "" {
-						awsAccountMap["accountId"] = inner["aws_account_id"]
-						mapSite["cloudAccount"] = inner["aws_account_id"]
-					} else {
-						return fmt.Errorf("aws_account_id is required with vendor = aws")
-					}
-
-					trusted := inner["is_aws_account_trusted"]
-					awsAccountMap["isTrusted"] = trusted.(bool)
-					awsAccountMap["vendor"] = "aws"
-
-					if !(trusted.(bool)) {
-						if inner["aws_access_key_id"] != "" {
-							awsAccountMap["accessKeyId"] = inner["aws_access_key_id"]
-						} else {
-							return fmt.Errorf("aws_access_key_id is required if the AWS account is not trusted.")
-						}
-
-						if inner["aws_secret_key"] != "" {
-							awsAccountMap["secretKey"] = inner["aws_secret_key"]
-						} else {
-							return fmt.Errorf("aws_secret_key is required if the AWS account is not trusted.")
-						}
-					}
-
-					mapSite["awsAccount"] = [...]interface{}{awsAccountMap}
-
-				} else if inner["vendor"] == "azure" {
-					azureAccountMap := make(map[string]interface{})
-					var subscriptionId string
-
-					azureAccessType := inner["azure_access_type"].(string)
-					applicationId := inner["azure_application_id"].(string)
-					clientSecret := inner["azure_client_secret"].(string)
-					activeDirectoryId := inner["azure_active_directory_id"].(string)
-					sharedAccID := inner["azure_shared_account_id"].(string)
-
-					if azureAccessType == "" {
-						azureAccessType = "managed"
-					}
-					cloudSubMap := make(map[string]interface{})
-					if azureAccessType == "managed" {
-						if inner["azure_subscription_id"] != "" {
-							subscriptionId = inner["azure_subscription_id"].(string)
-							mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantAttr.Name, subscriptionId)
-						} else {
-							return fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
-						}
-
-						cloudSubMap["cloudSubscriptionId"] = subscriptionId
-						azureAccountMap["cloudSubscription"] = cloudSubMap
-
-						azureAccountMap["securityDomains"] = make([]interface{}, 0)
-						azureAccountMap["vendor"] = "azure"
-						azureAccountMap["accessType"] = azureAccessType
-
-						mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
-
-					} else if azureAccessType == "credentials" {
-						if applicationId == "" || clientSecret == "" || activeDirectoryId == "" {
-							return fmt.Errorf("azure_application_id, azure_client_secret and azure_active_directory_id are required with azure_access_type = credentials")
-						}
-
-						if inner["azure_subscription_id"] != "" {
-							subscriptionId = inner["azure_subscription_id"].(string)
-							mapSite["cloudAccount"] = fmt.Sprintf("uni/tn-%s/act-[%s]-vendor-azure", tenantAttr.Name, subscriptionId)
-						} else {
-							return fmt.Errorf("azure_subscription_id is required when vendor = azure and azure_access_type = managed or credentials")
-						}
-
-						cloudSubMap["cloudSubscriptionId"] = subscriptionId
-						cloudSubMap["cloudApplicationId"] = applicationId
-						azureAccountMap["cloudSubscription"] = cloudSubMap
-						cloudApplicationMap := make(map[string]interface{})
-
-						cloudApplicationMap["cloudApplicationId"] = applicationId
-						cloudApplicationMap["secretKey"] = clientSecret
-						cloudApplicationMap["cloudActiveDirectoryId"] = activeDirectoryId
-						cloudApplicationMap["cloudCredentialName"] = "cApicApp"
-
-						azureAccountMap["cloudApplication"] = [...]interface{}{cloudApplicationMap}
-
-						activeDirectoryMap := make(map[string]interface{})
-						activeDirectoryMap["cloudActiveDirectoryId"] = activeDirectoryId
-						activeDirectoryMap["cloudActiveDirectoryName"] = "CiscoINSBUAd"
-
-						azureAccountMap["cloudActiveDirectory"] = [...]interface{}{activeDirectoryMap}
-
-						azureAccountMap["securityDomains"] = make([]interface{}, 0)
-						azureAccountMap["vendor"] = "azure"
-						azureAccountMap["accessType"] = azureAccessType
-
-						mapSite["azureAccount"] = [...]interface{}{azureAccountMap}
-
-					} else if azureAccessType == "shared" {
-						if sharedAccID == "" || inner["site_id"] == "" {
-							return fmt.Errorf("azure_shared_account_id and site_id are required with azure_access_type = shared")
-						}
-						durl := fmt.Sprintf("api/v1/sites/%s/aci/cloud-accounts", inner["site_id"].(string))
-						cont, err := msoClient.GetViaURL(durl)
-						if err != nil {
-							return err
-						}
-
-						count, err := cont.ArrayCount("cloudAccounts")
-						if err != nil {
-							return err
-						}
-
-						for i := 0; i < count; i++ {
-							dn := models.StripQuotes(cont.S("cloudAccounts").Index(i).S("id").String())
-							if dn == sharedAccID {
-								mapSite["cloudAccount"] = models.StripQuotes(cont.S("cloudAccounts").Index(i).S("dn").String())
-								break
-							}
-						}
-					}
-				}
-			}
-			mapSite["securityDomains"] = make([]interface{}, 0)
-			site_associations = append(site_associations, mapSite)
-		}
+	site_associations, err := getTenantSiteAssociations(d, msoClient, tenantAttr.Name, false)
+	if err != nil {
+		return err
 	}
 	tenantAttr.Sites = site_associations
 
@@ -780,6 +725,16 @@ func resourceMSOTenantRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("display_name", models.StripQuotes(con.S("displayName").String()))
 	d.Set("description", models.StripQuotes(con.S("description").String()))
 
+	// The secrets of the cloud accounts are not always returned, the configured secrets are kept in that case.
+	stateSites := make(map[string]map[string]interface{})
+	if stateSiteAssociations, ok := d.Get("site_associations").([]interface{}); ok {
+		for _, stateSite := range stateSiteAssociations {
+			if stateSiteMap, ok := stateSite.(map[string]interface{}); ok {
+				stateSites[convertInterfaceToString(stateSiteMap["site_id"])] = stateSiteMap
+			}
+		}
+	}
+
 	count1, _ := con.ArrayCount("siteAssociations")
 	site_associations := make([]interface{}, 0)
 	for i := 0; i < count1; i++ {
@@ -788,18 +743,13 @@ func resourceMSOTenantRead(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf("Unable to parse the site associations list")
 		}
 
-		mapSite := make(map[string]interface{})
-		mapSite["site_id"] = models.StripQuotes(sitesCont.S("siteId").String())
-		mapSite["security_domains"] = sitesCont.S("securityDomains").Data().([]interface{})
-
-		readGcpAccountDataFromSchema(sitesCont, mapSite)
-		readAwsAccountDataFromSchema(sitesCont, mapSite)
-		readAzureAccountDataFromSchema(sitesCont, mapSite)
+		mapSite := readTenantSiteAssociation(sitesCont)
 
-		if sitesCont.Exists("cloudAccount") && sitesCont.S("cloudAccount").String() != "{}" {
-			splitStr := strings.Split(sitesCont.S("cloudAccount").String(), "/")
-			if len(splitStr) > 2 {
-				setCloudAccountInfo(splitStr[2], mapSite)
+		if stateSite, ok := stateSites[mapSite["site_id"].(string)]; ok {
+			for _, secret := range []string{"aws_secret_key", "azure_client_secret", "gcp_private_key"} {
+				if convertInterfaceToString(mapSite[secret]) == "" {
+					mapSite[secret] = stateSite[secret]
+				}
 			}
 		}
 
@@ -0,0 +1,97 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var tenantPoliciesIgmpInterfacePolicy = templatePolicyObject{
+	label:        "IGMP Interface Policy",
+	templateType: tenantPolicyTemplate,
+	objectKey:    "igmpInterfacePolicies",
+	idSegment:    "igmpInterfacePolicy",
+	payload:      getIgmpInterfacePolicyPayload,
+	set:          setIgmpInterfacePolicyFromTemplate,
+}
+
+// igmpInterfacePolicyIntervals maps the interval attributes of the IGMP interface policy to the keys of the API and their range.
+var igmpInterfacePolicyIntervals = map[string]struct {
+	key          string
+	defaultValue int
+	min, max     int
+}{
+	"group_timeout":             {"groupTimeout", 260, 3, 65535},
+	"query_interval":            {"queryInterval", 125, 1, 18000},
+	"query_response_interval":   {"queryResponseInterval", 10, 1, 25},
+	"last_member_count":         {"lastMemberCount", 2, 1, 5},
+	"last_member_response_time": {"lastMemberResponseTime", 1, 1, 25},
+	"startup_query_count":       {"startupQueryCount", 2, 1, 10},
+	"startup_query_interval":    {"startupQueryInterval", 31, 1, 18000},
+	"querier_timeout":           {"querierTimeout", 255, 1, 65535},
+	"robustness_variable":       {"robustnessFactor", 2, 1, 7},
+}
+
+func resourceMSOTenantPoliciesIgmpInterfacePolicy() *schema.Resource {
+	policySchema := map[string]*schema.Schema{
+		"version": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "v2",
+			ValidateFunc: validation.StringInSlice([]string{
+				"v2",
+				"v3",
+			}, false),
+		},
+		"allow_v3_asm": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"fast_leave": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"report_link_local_groups": &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+	for attribute, interval := range igmpInterfacePolicyIntervals {
+		policySchema[attribute] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      interval.defaultValue,
+			ValidateFunc: validation.IntBetween(interval.min, interval.max),
+		}
+	}
+
+	return tenantPoliciesIgmpInterfacePolicy.resource(policySchema)
+}
+
+func getIgmpInterfacePolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	policy := map[string]interface{}{
+		"igmpVersion":                 d.Get("version").(string),
+		"enableV3ASM":                 d.Get("allow_v3_asm").(bool),
+		"enableFastLeaveControl":      d.Get("fast_leave").(bool),
+		"enableReportLinkLocalGroups": d.Get("report_link_local_groups").(bool),
+	}
+	for attribute, interval := range igmpInterfacePolicyIntervals {
+		policy[interval.key] = d.Get(attribute).(int)
+	}
+	return policy
+}
+
+func setIgmpInterfacePolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	d.Set("version", convertInterfaceToString(policyCont.S("igmpVersion").Data()))
+	d.Set("allow_v3_asm", policyCont.S("enableV3ASM").Data() == true)
+	d.Set("fast_leave", policyCont.S("enableFastLeaveControl").Data() == true)
+	d.Set("report_link_local_groups", policyCont.S("enableReportLinkLocalGroups").Data() == true)
+	for attribute, interval := range igmpInterfacePolicyIntervals {
+		if policyCont.Exists(interval.key) {
+			d.Set(attribute, convertInterfaceToInt(policyCont.S(interval.key).Data()))
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package mso
+
+import (
+	"fmt"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var tenantPoliciesIpslaTrackList = templatePolicyObject{
+	label:        "IPSLA Track List",
+	templateType: tenantPolicyTemplate,
+	objectKey:    "ipslaTrackLists",
+	idSegment:    "ipslaTrackList",
+	payload:      getIpslaTrackListPayload,
+	set:          setIpslaTrackListFromTemplate,
+}
+
+func resourceMSOTenantPoliciesIpslaTrackList() *schema.Resource {
+	resource := tenantPoliciesIpslaTrackList.resource(map[string]*schema.Schema{
+		"type": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "percentage",
+			ValidateFunc: validation.StringInSlice([]string{
+				"percentage",
+				"weight",
+			}, false),
+		},
+		"threshold_up": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"threshold_down": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+		"members": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"destination_ip": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"scope_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"bd",
+							"l3out",
+						}, false),
+					},
+					"scope_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"ipsla_monitoring_policy_uuid": &schema.Schema{
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"weight": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 255),
+					},
+				},
+			},
+		},
+	})
+	resource.CustomizeDiff = func(diff *schema.ResourceDiff, v interface{}) error {
+		if diff.Get("type").(string) == "percentage" {
+			if diff.Get("threshold_up").(int) > 100 || diff.Get("threshold_down").(int) > 100 {
+				return fmt.Errorf(`"threshold_up" and "threshold_down" must be between 0 and 100 when "type" is percentage`)
+			}
+		}
+		if diff.Get("threshold_down").(int) > diff.Get("threshold_up").(int) {
+			return fmt.Errorf(`"threshold_down" must be lower than or equal to "threshold_up"`)
+		}
+		return nil
+	}
+	return resource
+}
+
+func getIpslaTrackListPayload(d *schema.ResourceData) map[string]interface{} {
+	trackList := map[string]interface{}{
+		"type": d.Get("type").(string),
+	}
+	if d.Get("type").(string) == "percentage" {
+		trackList["percentageUp"] = d.Get("threshold_up").(int)
+		trackList["percentageDown"] = d.Get("threshold_down").(int)
+	} else {
+		trackList["weightUp"] = d.Get("threshold_up").(int)
+		trackList["weightDown"] = d.Get("threshold_down").(int)
+	}
+
+	members := make([]interface{}, 0, 1)
+	for _, member := range d.Get("members").([]interface{}) {
+		memberMap := member.(map[string]interface{})
+		members = append(members, map[string]interface{}{
+			"destIP":                   memberMap["destination_ip"],
+			"scopeType":                memberMap["scope_type"],
+			"scope":                    memberMap["scope_uuid"],
+			"ipslaMonitoringPolicyRef": memberMap["ipsla_monitoring_policy_uuid"],
+			"weight":                   memberMap["weight"],
+		})
+	}
+	trackList["trackListMembers"] = members
+	return trackList
+}
+
+func setIpslaTrackListFromTemplate(d *schema.ResourceData, trackListCont *container.Container) {
+	trackListType := convertInterfaceToString(trackListCont.S("type").Data())
+	d.Set("type", trackListType)
+	if trackListType == "weight" {
+		d.Set("threshold_up", convertInterfaceToInt(trackListCont.S("weightUp").Data()))
+		d.Set("threshold_down", convertInterfaceToInt(trackListCont.S("weightDown").Data()))
+	} else {
+		d.Set("threshold_up", convertInterfaceToInt(trackListCont.S("percentageUp").Data()))
+		d.Set("threshold_down", convertInterfaceToInt(trackListCont.S("percentageDown").Data()))
+	}
+
+	members := make([]interface{}, 0, 1)
+	if apiMembers, ok := trackListCont.S("trackListMembers").Data().([]interface{}); ok {
+		for _, member := range apiMembers {
+			memberMap := member.(map[string]interface{})
+			members = append(members, map[string]interface{}{
+				"destination_ip":               convertInterfaceToString(memberMap["destIP"]),
+				"scope_type":                   convertInterfaceToString(memberMap["scopeType"]),
+				"scope_uuid":                   convertInterfaceToString(memberMap["scope"]),
+				"ipsla_monitoring_policy_uuid": convertInterfaceToString(memberMap["ipslaMonitoringPolicyRef"]),
+				"weight":                       convertInterfaceToInt(memberMap["weight"]),
+			})
+		}
+	}
+	d.Set("members", members)
+}
@@ -0,0 +1,235 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var tenantPoliciesL3outInterfaceRoutingPolicy = templatePolicyObject{
+	label:        "L3Out Interface Routing Policy",
+	templateType: tenantPolicyTemplate,
+	objectKey:    "l3OutIntfPolGroups",
+	idSegment:    "l3OutIntfPolGroup",
+	payload:      getL3outInterfaceRoutingPolicyPayload,
+	set:          setL3outInterfaceRoutingPolicyFromTemplate,
+}
+
+func resourceMSOTenantPoliciesL3outInterfaceRoutingPolicy() *schema.Resource {
+	return tenantPoliciesL3outInterfaceRoutingPolicy.resource(map[string]*schema.Schema{
+		"bfd_settings": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"admin_state": templatePolicyAdminStateSchema(),
+					"detection_multiplier": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(1, 50),
+					},
+					"min_receive_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      50,
+						ValidateFunc: validation.IntBetween(50, 999),
+					},
+					"min_transmit_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      50,
+						ValidateFunc: validation.IntBetween(50, 999),
+					},
+					"echo_receive_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      50,
+						ValidateFunc: validation.IntBetween(50, 999),
+					},
+					"echo_admin_state": templatePolicyAdminStateSchema(),
+					"interface_control": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		"bfd_multi_hop_settings": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"admin_state": templatePolicyAdminStateSchema(),
+					"detection_multiplier": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(1, 50),
+					},
+					"min_receive_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      250,
+						ValidateFunc: validation.IntBetween(250, 999),
+					},
+					"min_transmit_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      250,
+						ValidateFunc: validation.IntBetween(250, 999),
+					},
+				},
+			},
+		},
+		"pim_settings": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"authentication_type": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "none",
+						ValidateFunc: validation.StringInSlice([]string{
+							"none",
+							"md5_hmac",
+						}, false),
+					},
+					"hello_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      30000,
+						ValidateFunc: validation.IntBetween(1, 18724286),
+					},
+					"join_prune_interval": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      60,
+						ValidateFunc: validation.IntBetween(60, 65520),
+					},
+					"designated_router_delay": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(1, 65535),
+					},
+					"designated_router_priority": &schema.Schema{
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 2147483647),
+					},
+					"multicast_domain_boundary": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"passive": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"strict_rfc_compliant": &schema.Schema{
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	})
+}
+
+func getL3outInterfaceRoutingPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	policy := make(map[string]interface{})
+	if bfd, ok := d.GetOk("bfd_settings"); ok {
+		bfdMap := bfd.([]interface{})[0].(map[string]interface{})
+		policy["bfdPol"] = map[string]interface{}{
+			"adminState":          bfdMap["admin_state"],
+			"detectionMultiplier": bfdMap["detection_multiplier"],
+			"minRxInterval":       bfdMap["min_receive_interval"],
+			"minTxInterval":       bfdMap["min_transmit_interval"],
+			"echoRxInterval":      bfdMap["echo_receive_interval"],
+			"echoAdminState":      bfdMap["echo_admin_state"],
+			"ifControl":           bfdMap["interface_control"],
+		}
+	}
+	if bfdMultiHop, ok := d.GetOk("bfd_multi_hop_settings"); ok {
+		bfdMultiHopMap := bfdMultiHop.([]interface{})[0].(map[string]interface{})
+		policy["bfdMultiHopPol"] = map[string]interface{}{
+			"adminState":          bfdMultiHopMap["admin_state"],
+			"detectionMultiplier": bfdMultiHopMap["detection_multiplier"],
+			"minRxInterval":       bfdMultiHopMap["min_receive_interval"],
+			"minTxInterval":       bfdMultiHopMap["min_transmit_interval"],
+		}
+	}
+	if pim, ok := d.GetOk("pim_settings"); ok {
+		pimMap := pim.([]interface{})[0].(map[string]interface{})
+		authenticationType := pimMap["authentication_type"].(string)
+		if authenticationType == "md5_hmac" {
+			authenticationType = "ah-md5"
+		}
+		policy["pimPol"] = map[string]interface{}{
+			"authType":       authenticationType,
+			"helloInterval":  pimMap["hello_interval"],
+			"jpInterval":     pimMap["join_prune_interval"],
+			"drDelay":        pimMap["designated_router_delay"],
+			"drPriority":     pimMap["designated_router_priority"],
+			"mcastDomBorder": pimMap["multicast_domain_boundary"],
+			"passive":        pimMap["passive"],
+			"strictRFC":      pimMap["strict_rfc_compliant"],
+		}
+	}
+	return policy
+}
+
+func setL3outInterfaceRoutingPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	bfdSettings := make([]interface{}, 0, 1)
+	if bfdMap, ok := policyCont.S("bfdPol").Data().(map[string]interface{}); ok {
+		bfdSettings = append(bfdSettings, map[string]interface{}{
+			"admin_state":           convertInterfaceToString(bfdMap["adminState"]),
+			"detection_multiplier":  convertInterfaceToInt(bfdMap["detectionMultiplier"]),
+			"min_receive_interval":  convertInterfaceToInt(bfdMap["minRxInterval"]),
+			"min_transmit_interval": convertInterfaceToInt(bfdMap["minTxInterval"]),
+			"echo_receive_interval": convertInterfaceToInt(bfdMap["echoRxInterval"]),
+			"echo_admin_state":      convertInterfaceToString(bfdMap["echoAdminState"]),
+			"interface_control":     bfdMap["ifControl"] == true,
+		})
+	}
+	d.Set("bfd_settings", bfdSettings)
+
+	bfdMultiHopSettings := make([]interface{}, 0, 1)
+	if bfdMultiHopMap, ok := policyCont.S("bfdMultiHopPol").Data().(map[string]interface{}); ok {
+		bfdMultiHopSettings = append(bfdMultiHopSettings, map[string]interface{}{
+			"admin_state":           convertInterfaceToString(bfdMultiHopMap["adminState"]),
+			"detection_multiplier":  convertInterfaceToInt(bfdMultiHopMap["detectionMultiplier"]),
+			"min_receive_interval":  convertInterfaceToInt(bfdMultiHopMap["minRxInterval"]),
+			"min_transmit_interval": convertInterfaceToInt(bfdMultiHopMap["minTxInterval"]),
+		})
+	}
+	d.Set("bfd_multi_hop_settings", bfdMultiHopSettings)
+
+	pimSettings := make([]interface{}, 0, 1)
+	if pimMap, ok := policyCont.S("pimPol").Data().(map[string]interface{}); ok {
+		authenticationType := convertInterfaceToString(pimMap["authType"])
+		if authenticationType == "ah-md5" {
+			authenticationType = "md5_hmac"
+		}
+		pimSettings = append(pimSettings, map[string]interface{}{
+			"authentication_type":        authenticationType,
+			"hello_interval":             convertInterfaceToInt(pimMap["helloInterval"]),
+			"join_prune_interval":        convertInterfaceToInt(pimMap["jpInterval"]),
+			"designated_router_delay":    convertInterfaceToInt(pimMap["drDelay"]),
+			"designated_router_priority": convertInterfaceToInt(pimMap["drPriority"]),
+			"multicast_domain_boundary":  pimMap["mcastDomBorder"] == true,
+			"passive":                    pimMap["passive"] == true,
+			"strict_rfc_compliant":       pimMap["strictRFC"] == true,
+		})
+	}
+	d.Set("pim_settings", pimSettings)
+}
@@ -0,0 +1,92 @@
+package mso
+
+import (
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var tenantPoliciesMulticastRouteMapPolicy = templatePolicyObject{
+	label:        "Multicast Route Map Policy",
+	templateType: tenantPolicyTemplate,
+	objectKey:    "mcastRouteMapPolicies",
+	idSegment:    "mcastRouteMapPolicy",
+	payload:      getMulticastRouteMapPolicyPayload,
+	set:          setMulticastRouteMapPolicyFromTemplate,
+}
+
+func resourceMSOTenantPoliciesMulticastRouteMapPolicy() *schema.Resource {
+	return tenantPoliciesMulticastRouteMapPolicy.resource(map[string]*schema.Schema{
+		"entries": &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"order": &schema.Schema{
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+					"group_ip": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"source_ip": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"rp_ip": &schema.Schema{
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(1, 1000),
+					},
+					"action": &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "permit",
+						ValidateFunc: validation.StringInSlice([]string{
+							"permit",
+							"deny",
+						}, false),
+					},
+				},
+			},
+		},
+	})
+}
+
+func getMulticastRouteMapPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	entries := make([]interface{}, 0, 1)
+	for _, entry := range d.Get("entries").([]interface{}) {
+		entryMap := entry.(map[string]interface{})
+		entries = append(entries, map[string]interface{}{
+			"order":  entryMap["order"],
+			"group":  entryMap["group_ip"],
+			"src":    entryMap["source_ip"],
+			"rp":     entryMap["rp_ip"],
+			"action": entryMap["action"],
+		})
+	}
+	return map[string]interface{}{
+		"mcastRtMapEntries": entries,
+	}
+}
+
+func setMulticastRouteMapPolicyFromTemplate(d *schema.ResourceData, policyCont *container.Container) {
+	entries := make([]interface{}, 0, 1)
+	if apiEntries, ok := policyCont.S("mcastRtMapEntries").Data().([]interface{}); ok {
+		for _, entry := range apiEntries {
+			entryMap := entry.(map[string]interface{})
+			entries = append(entries, map[string]interface{}{
+				"order":     convertInterfaceToInt(entryMap["order"]),
+				"group_ip":  convertInterfaceToString(entryMap["group"]),
+				"source_ip": convertInterfaceToString(entryMap["src"]),
+				"rp_ip":     convertInterfaceToString(entryMap["rp"]),
+				"action":    convertInterfaceToString(entryMap["action"]),
+			})
+		}
+	}
+	d.Set("entries", entries)
+}
@@ -0,0 +1,183 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOTenantSite() *schema.Resource {
+	siteSchema := tenantSiteAssociationSchema()
+	siteSchema["tenant_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 1000),
+	}
+	siteSchema["site_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 1000),
+	}
+
+	return &schema.Resource{
+		Create: resourceMSOTenantSiteCreate,
+		Update: resourceMSOTenantSiteUpdate,
+		Read:   resourceMSOTenantSiteRead,
+		Delete: resourceMSOTenantSiteDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOTenantSiteImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: siteSchema,
+	}
+}
+
+func resourceMSOTenantSiteImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 3 || get_attribute[1] != "site" {
+		return nil, fmt.Errorf("Invalid import id %s, the expected format is {tenant_id}/site/{site_id}", d.Id())
+	}
+	d.Set("tenant_id", get_attribute[0])
+	d.Set("site_id", get_attribute[2])
+
+	err := resourceMSOTenantSiteRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Site %s is not associated to tenant %s", get_attribute[2], get_attribute[0])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// setTenantSiteAssociation adds or replaces the site association of the tenant with the configured attributes.
+func setTenantSiteAssociation(d *schema.ResourceData, msoClient *client.Client, new bool) error {
+	tenantId := d.Get("tenant_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	inner := make(map[string]interface{})
+	for key := range tenantSiteAssociationSchema() {
+		inner[key] = d.Get(key)
+	}
+
+	return updateTenantAssociations(msoClient, tenantId, func(tenantCont *container.Container) error {
+		index, err := getTenantAssociationIndex(tenantCont, "siteAssociations", "siteId", siteId)
+		if err != nil {
+			return err
+		}
+		if new && index != -1 {
+			return fmt.Errorf("Site %s is already associated to tenant %s", siteId, tenantId)
+		}
+
+		mapSite, err := getTenantSiteAssociation(inner, msoClient, models.StripQuotes(tenantCont.S("name").String()), new)
+		if err != nil {
+			return err
+		}
+
+		if index != -1 {
+			_, err = tenantCont.S("siteAssociations").SetIndex(mapSite, index)
+			return err
+		}
+		if !tenantCont.Exists("siteAssociations") {
+			tenantCont.Array("siteAssociations")
+		}
+		return tenantCont.ArrayAppend(mapSite, "siteAssociations")
+	})
+}
+
+func resourceMSOTenantSiteCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Tenant Site: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	err := setTenantSiteAssociation(d, msoClient, true)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/site/%s", d.Get("tenant_id").(string), d.Get("site_id").(string)))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceMSOTenantSiteRead(d, m)
+}
+
+func resourceMSOTenantSiteUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := setTenantSiteAssociation(d, msoClient, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOTenantSiteRead(d, m)
+}
+
+func resourceMSOTenantSiteRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+	tenantId := d.Get("tenant_id").(string)
+	siteId := d.Get("site_id").(string)
+
+	tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%s", tenantId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), tenantCont, d)
+	}
+
+	index, err := getTenantAssociationIndex(tenantCont, "siteAssociations", "siteId", siteId)
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		d.SetId("")
+		return nil
+	}
+	sitesCont, err := tenantCont.ArrayElement(index, "siteAssociations")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/site/%s", tenantId, siteId))
+	d.Set("tenant_id", tenantId)
+	for key, value := range readTenantSiteAssociation(sitesCont) {
+		// The secrets of the cloud accounts are not always returned, the configured secrets are kept in that case.
+		if (key == "aws_secret_key" || key == "azure_client_secret" || key == "gcp_private_key") && convertInterfaceToString(value) == "" {
+			continue
+		}
+		d.Set(key, value)
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOTenantSiteDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+	siteId := d.Get("site_id").(string)
+
+	err := updateTenantAssociations(msoClient, d.Get("tenant_id").(string), func(tenantCont *container.Container) error {
+		return removeTenantAssociation(tenantCont, "siteAssociations", "siteId", siteId)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,137 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceMSOTenantUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMSOTenantUserCreate,
+		Read:   resourceMSOTenantUserRead,
+		Delete: resourceMSOTenantUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMSOTenantUserImport,
+		},
+
+		SchemaVersion: version,
+
+		Schema: (map[string]*schema.Schema{
+			"tenant_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+
+			"user_id": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		}),
+	}
+}
+
+func resourceMSOTenantUserImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 3 || get_attribute[1] != "user" {
+		return nil, fmt.Errorf("Invalid import id %s, the expected format is {tenant_id}/user/{user_id}", d.Id())
+	}
+	d.Set("tenant_id", get_attribute[0])
+	d.Set("user_id", get_attribute[2])
+
+	err := resourceMSOTenantUserRead(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("User %s is not associated to tenant %s", get_attribute[2], get_attribute[0])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceMSOTenantUserCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Tenant User: Beginning Creation")
+	msoClient := m.(*client.Client)
+	tenantId := d.Get("tenant_id").(string)
+	userId := d.Get("user_id").(string)
+
+	err := updateTenantAssociations(msoClient, tenantId, func(tenantCont *container.Container) error {
+		index, err := getTenantAssociationIndex(tenantCont, "userAssociations", "userId", userId)
+		if err != nil {
+			return err
+		}
+		if index != -1 {
+			return fmt.Errorf("User %s is already associated to tenant %s", userId, tenantId)
+		}
+		if !tenantCont.Exists("userAssociations") {
+			tenantCont.Array("userAssociations")
+		}
+		return tenantCont.ArrayAppend(map[string]interface{}{"userId": userId}, "userAssociations")
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/user/%s", tenantId, userId))
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+
+	return resourceMSOTenantUserRead(d, m)
+}
+
+func resourceMSOTenantUserRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+	tenantId := d.Get("tenant_id").(string)
+	userId := d.Get("user_id").(string)
+
+	tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%s", tenantId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), tenantCont, d)
+	}
+
+	index, err := getTenantAssociationIndex(tenantCont, "userAssociations", "userId", userId)
+	if err != nil {
+		return err
+	}
+	if index == -1 {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s/user/%s", tenantId, userId))
+	d.Set("tenant_id", tenantId)
+	d.Set("user_id", userId)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOTenantUserDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+	userId := d.Get("user_id").(string)
+
+	err := updateTenantAssociations(msoClient, d.Get("tenant_id").(string), func(tenantCont *container.Container) error {
+		return removeTenantAssociation(tenantCont, "userAssociations", "userId", userId)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,96 @@
+package mso
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccMSOTenantUser_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMSOTenantUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckMSOTenantUserConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMSOTenantUserExists("mso_tenant_user.tenant_user"),
+					resource.TestCheckResourceAttrPair("mso_tenant_user.tenant_user", "tenant_id", "mso_tenant.tenant1", "id"),
+					resource.TestCheckResourceAttrPair("mso_tenant_user.tenant_user", "user_id", "data.mso_user.admin", "id"),
+				),
+			},
+			{
+				ResourceName:      "mso_tenant_user.tenant_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMSOTenantUserConfig_basic() string {
+	return `
+	data "mso_user" "admin" {
+		username = "admin"
+	}
+
+	resource "mso_tenant" "tenant1" {
+		name         = "tenant_user_test"
+		display_name = "tenant_user_test"
+	}
+
+	resource "mso_tenant_user" "tenant_user" {
+		tenant_id = mso_tenant.tenant1.id
+		user_id   = data.mso_user.admin.id
+	}
+	`
+}
+
+func testAccCheckMSOTenantUserExists(tenantUserName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[tenantUserName]
+		if !ok {
+			return fmt.Errorf("Tenant User %s not found", tenantUserName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Tenant User id was set")
+		}
+
+		client := testAccProvider.Meta().(*client.Client)
+		cont, err := client.GetViaURL("api/v1/tenants/" + rs.Primary.Attributes["tenant_id"])
+		if err != nil {
+			return err
+		}
+		index, err := getTenantAssociationIndex(cont, "userAssociations", "userId", rs.Primary.Attributes["user_id"])
+		if err != nil {
+			return err
+		}
+		if index == -1 {
+			return fmt.Errorf("User %s is not associated to the tenant", rs.Primary.Attributes["user_id"])
+		}
+		return nil
+	}
+}
+
+func testAccCheckMSOTenantUserDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*client.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "mso_tenant_user" {
+			continue
+		}
+		cont, err := client.GetViaURL("api/v1/tenants/" + rs.Primary.Attributes["tenant_id"])
+		if err != nil {
+			continue
+		}
+		index, err := getTenantAssociationIndex(cont, "userAssociations", "userId", rs.Primary.Attributes["user_id"])
+		if err == nil && index != -1 {
+			return fmt.Errorf("Tenant User still exists")
+		}
+	}
+	return nil
+}
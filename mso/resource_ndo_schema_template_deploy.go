@@ -1,9 +1,11 @@
 package mso
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/container"
@@ -21,6 +23,12 @@ func resourceNDOSchemaTemplateDeploy() *schema.Resource {
 
 		SchemaVersion: version,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
 			// Plan time validation.
 			msoClient := v.(*client.Client)
@@ -54,6 +62,24 @@ func resourceNDOSchemaTemplateDeploy() *schema.Resource {
 				Optional: true,
 				Default:  "always-deploy",
 			},
+
+			"redeploy_triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"verify_deployment": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"wait_for_approval": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		}),
 	}
 }
@@ -62,29 +88,37 @@ func resourceNDOSchemaTemplateDeployExecute(d *schema.ResourceData, m interface{
 	log.Printf("[DEBUG] %s: Beginning Template Deploy Execution", d.Id())
 	templateName := d.Get("template_name").(string)
 	schemaId := d.Get("schema_id").(string)
-	path := "api/v1/task"
 
 	msoClient := m.(*client.Client)
 
-	schemaValidate := models.SchemValidate{SchmaId: d.Get("schema_id").(string)}
-	_, err := msoClient.ReadSchemaValidate(&schemaValidate)
-	if err != nil {
-		return err
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
 	}
-	payload, err := container.ParseJSON([]byte(fmt.Sprintf(`{"schemaId": "%s", "templateName": "%s", "isRedeploy": %v}`, schemaId, templateName, d.Get("re_deploy").(bool))))
-	if err != nil {
-		log.Printf("[DEBUG] Parse of JSON failed with err: %s.", err)
-		return err
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), timeout)
+	defer cancel()
+
+	if d.Get("wait_for_approval").(bool) {
+		err := checkTemplateApprovalSupport(msoClient)
+		if err != nil {
+			return err
+		}
+		err = waitForTemplateApproval(ctx, msoClient, schemaId, templateName)
+		if err != nil {
+			return err
+		}
 	}
-	req, err := msoClient.MakeRestRequest("POST", path, payload, true)
+
+	taskId, err := startDeployTask(ctx, msoClient, schemaId, templateName, d.Get("re_deploy").(bool))
 	if err != nil {
-		log.Printf("[DEBUG] MakeRestRequest failed with err: %s.", err)
 		return err
 	}
-	_, resp, err := msoClient.Do(req)
-	if err != nil || resp.StatusCode != 202 {
-		log.Printf("[DEBUG] Request failed with resp: %v. Err: %s.", resp, err)
-		return err
+
+	if d.Get("verify_deployment").(bool) {
+		err = verifyDeployTask(ctx, msoClient, taskId)
+		if err != nil {
+			return err
+		}
 	}
 
 	d.SetId(schemaId)
@@ -92,11 +126,47 @@ func resourceNDOSchemaTemplateDeployExecute(d *schema.ResourceData, m interface{
 	return resourceNDOSchemaTemplateDeployRead(d, m)
 }
 
+// startDeployTask validates the schema and starts the deploy task of the template, it returns the id of the task.
+func startDeployTask(ctx context.Context, msoClient *client.Client, schemaId, templateName string, redeploy bool) (string, error) {
+	schemaValidate := models.SchemValidate{SchmaId: schemaId}
+	_, err := msoClient.ReadSchemaValidate(&schemaValidate)
+	if err != nil {
+		return "", err
+	}
+	payload, err := container.ParseJSON([]byte(fmt.Sprintf(`{"schemaId": "%s", "templateName": "%s", "isRedeploy": %v}`, schemaId, templateName, redeploy)))
+	if err != nil {
+		log.Printf("[DEBUG] Parse of JSON failed with err: %s.", err)
+		return "", err
+	}
+
+	taskCont, resp, err := doRequestWithContext(ctx, msoClient, "POST", "api/v1/task", payload)
+	if err != nil {
+		log.Printf("[DEBUG] Request failed with resp: %v. Err: %s.", resp, err)
+		return "", err
+	}
+	if resp.StatusCode != 202 {
+		return "", fmt.Errorf("Unable to deploy template %s of schema %s, status code %d", templateName, schemaId, resp.StatusCode)
+	}
+	return models.StripQuotes(taskCont.S("id").String()), nil
+}
+
 func resourceNDOSchemaTemplateDeployRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("force_apply", "")
-	return nil
+	return readDeployedObject(d, m.(*client.Client), fmt.Sprintf("api/v1/schemas/%s", d.Get("schema_id").(string)))
 }
 
 func resourceNDOSchemaTemplateDeployDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
+
+// verifyDeployTask waits until the deploy task is no longer running and returns an error with the details of every site which did not deploy successfully.
+func verifyDeployTask(ctx context.Context, msoClient *client.Client, taskId string) error {
+	if taskId == "" || taskId == "{}" {
+		return fmt.Errorf("Unable to verify the deployment, the task id is not returned")
+	}
+	_, err := waitForTask(ctx, msoClient, taskId)
+	if err != nil && ctx.Err() == nil {
+		return fmt.Errorf("The deployment failed: %s", err)
+	}
+	return err
+}
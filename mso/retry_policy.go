@@ -0,0 +1,20 @@
+package mso
+
+import (
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+// The defaults of the retry settings match the retry policy of the client.
+const (
+	defaultMaxRetries         = 5
+	defaultBackoffMinDelay    = 2
+	defaultBackoffMaxDelay    = 60
+	defaultBackoffDelayFactor = 2.0
+)
+
+// configureRetryPolicy sets the retry policy on the client, the delays are in seconds.
+func configureRetryPolicy(msoClient *client.Client, maxRetries, minDelay, maxDelay int, delayFactor float64) error {
+	return msoClient.SetRetryPolicy(maxRetries, time.Duration(minDelay)*time.Second, time.Duration(maxDelay)*time.Second, delayFactor)
+}
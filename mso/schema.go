@@ -2,7 +2,11 @@ package mso
 
 import (
 	"fmt"
+	"log"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
 	"github.com/ciscoecosystem/mso-go-client/container"
@@ -10,10 +14,14 @@ import (
 )
 
 func getSiteFromSiteIdAndTemplate(schemaId, siteId, templateName string, msoClient *client.Client) (*container.Container, error) {
-	schemaObject, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/schemas/%s", schemaId))
+	schemaObject, err := getSchemaCont(msoClient, schemaId)
 	if err != nil {
 		return nil, err
 	}
+	return getSiteFromSiteIdAndTemplateFromContainer(schemaObject, siteId, templateName)
+}
+
+func getSiteFromSiteIdAndTemplateFromContainer(schemaObject *container.Container, siteId, templateName string) (*container.Container, error) {
 	siteCount, err := schemaObject.ArrayCount("sites")
 	if err != nil {
 		return nil, fmt.Errorf("No Sites found")
@@ -175,3 +183,283 @@ func getSiteVrfRegionCIDR(ip string, regionCont *container.Container) (*containe
 	}
 	return nil, fmt.Errorf("VRF Region CIDR %v is not found in Site.", ip)
 }
+
+func getTemplateTenantName(templateName string, schemaCont *container.Container, msoClient *client.Client) (string, error) {
+	templateCount, err := schemaCont.ArrayCount("templates")
+	if err != nil {
+		return "", fmt.Errorf("No Template found")
+	}
+	for i := 0; i < templateCount; i++ {
+		templateCont, err := schemaCont.ArrayElement(i, "templates")
+		if err != nil {
+			return "", err
+		}
+		if models.G(templateCont, "name") == templateName {
+			tenantCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/tenants/%s", models.G(templateCont, "tenantId")))
+			if err != nil {
+				return "", err
+			}
+			return models.G(tenantCont, "name"), nil
+		}
+	}
+	return "", fmt.Errorf("Template %v is not found in Schema.", templateName)
+}
+
+// staticPortVlan is the path and encap VLAN of a static port binding.
+type staticPortVlan struct {
+	path string
+	vlan int
+}
+
+func getStaticPortPath(pathType, pod, leaf, path, fex string) string {
+	if pathType == "port" && fex != "" {
+		return fmt.Sprintf("topology/%s/paths-%s/extpaths-%s/pathep-[%s]", pod, leaf, fex, path)
+	} else if pathType == "vpc" {
+		return fmt.Sprintf("topology/%s/protpaths-%s/pathep-[%s]", pod, leaf, path)
+	}
+	return fmt.Sprintf("topology/%s/paths-%s/pathep-[%s]", pod, leaf, path)
+}
+
+var (
+	staticPortPodRegex       = regexp.MustCompile(`^pod-\d+$`)
+	staticPortNodeRegex      = regexp.MustCompile(`^\d+$`)
+	staticPortVpcNodesRegex  = regexp.MustCompile(`^(\d+)-(\d+)$`)
+	staticPortInterfaceRegex = regexp.MustCompile(`^eth\d+/\d+(/\d+)?$`)
+)
+
+// validateStaticPortPath returns an error when the attributes of a static port cannot be combined into a valid path.
+// A vpc path requires the node pair of the vPC as leaf, for example 101-102, and a fex is only supported on port paths.
+func validateStaticPortPath(pathType, pod, leaf, path, fex string) error {
+	if !staticPortPodRegex.MatchString(pod) {
+		return fmt.Errorf("Invalid pod %s of static port %s, expected the format pod-{id}, for example pod-1", pod, path)
+	}
+	if pathType == "vpc" {
+		nodes := staticPortVpcNodesRegex.FindStringSubmatch(leaf)
+		if nodes == nil || nodes[1] == nodes[2] {
+			return fmt.Errorf("Invalid leaf %s of vpc static port %s, expected the two nodes of the vPC pair in the format {node1}-{node2}, for example 101-102", leaf, path)
+		}
+	} else if !staticPortNodeRegex.MatchString(leaf) {
+		return fmt.Errorf("Invalid leaf %s of %s static port %s, expected a single node id, for example 101", leaf, pathType, path)
+	}
+	if fex != "" {
+		if pathType != "port" {
+			return fmt.Errorf("The fex %s of static port %s is only supported with path_type port, not %s", fex, path, pathType)
+		}
+		fexId, err := strconv.Atoi(fex)
+		if err != nil || fexId < 101 || fexId > 199 {
+			return fmt.Errorf("Invalid fex %s of static port %s, expected a FEX id between 101 and 199", fex, path)
+		}
+	}
+	if pathType == "port" && !staticPortInterfaceRegex.MatchString(path) {
+		return fmt.Errorf("Invalid path %s of port static port, expected an interface in the format eth{module}/{port}, for example eth1/1", path)
+	}
+	return nil
+}
+
+var (
+	fexStaticPortPathRegex = regexp.MustCompile(`(topology\/(?P<podValue>.*)\/paths-(?P<leafValue>.*)\/extpaths-(?P<fexValue>.*)\/pathep-\[(?P<pathValue>.*)\])`)
+	vpcStaticPortPathRegex = regexp.MustCompile(`(topology\/(?P<podValue>.*)\/protpaths-(?P<leafValue>.*)\/pathep-\[(?P<pathValue>.*)\])`)
+	dpcStaticPortPathRegex = regexp.MustCompile(`(topology\/(?P<podValue>.*)\/paths-(?P<leafValue>.*)\/pathep-\[(?P<pathValue>.*)\])`)
+)
+
+// parseStaticPortPath is the reverse of getStaticPortPath, it returns the podValue, leafValue, fexValue and pathValue of a static port path.
+func parseStaticPortPath(pathValue string) map[string]string {
+	if fexStaticPortPathRegex.MatchString(pathValue) {
+		return getStaticPortPathValues(pathValue, fexStaticPortPathRegex)
+	} else if vpcStaticPortPathRegex.MatchString(pathValue) {
+		return getStaticPortPathValues(pathValue, vpcStaticPortPathRegex)
+	} else if dpcStaticPortPathRegex.MatchString(pathValue) {
+		return getStaticPortPathValues(pathValue, dpcStaticPortPathRegex)
+	}
+	return make(map[string]string)
+}
+
+// getDomainVlanRanges returns the VLAN ranges of the VLAN pool of a domain as returned by the site domains API.
+func getDomainVlanRanges(domain map[string]interface{}) [][2]int {
+	vlanRanges := make([][2]int, 0)
+	vlanPool, ok := domain["vlanPool"].(map[string]interface{})
+	if !ok {
+		return vlanRanges
+	}
+	encapBlocks, _ := vlanPool["encapBlocks"].([]interface{})
+	for _, encapBlock := range encapBlocks {
+		blockMap, ok := encapBlock.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		from, errFrom := strconv.Atoi(strings.TrimPrefix(convertInterfaceToString(blockMap["from"]), "vlan-"))
+		to, errTo := strconv.Atoi(strings.TrimPrefix(convertInterfaceToString(blockMap["to"]), "vlan-"))
+		if errFrom == nil && errTo == nil {
+			vlanRanges = append(vlanRanges, [2]int{from, to})
+		}
+	}
+	return vlanRanges
+}
+
+// staticPortVlanValidationResult returns the error of the static port VLAN validation, or only logs it when the severity is warning.
+// Warnings are logged, because the plugin SDK does not support warning diagnostics.
+func staticPortVlanValidationResult(err error, severity string) error {
+	if err != nil && severity == "warning" {
+		log.Printf("[WARN] %s", err)
+		return nil
+	}
+	return err
+}
+
+// plannedStaticPortKey is a path and VLAN of a site.
+type plannedStaticPortKey struct {
+	siteId string
+	staticPortVlan
+}
+
+// plannedStaticPorts holds the EPG of every static port which is validated during the plan, so a path and VLAN which is
+// planned for two EPGs is detected before either of them exists on NDO.
+// It is part of the settings of a provider configuration, so it only lives as long as the plan or apply which configured the provider.
+type plannedStaticPorts struct {
+	sync.Mutex
+	epgs map[plannedStaticPortKey]string
+}
+
+func newPlannedStaticPorts() *plannedStaticPorts {
+	return &plannedStaticPorts{epgs: make(map[plannedStaticPortKey]string)}
+}
+
+// planStaticPorts adds the static ports of an EPG to the planned static ports of the provider configuration of the client,
+// or returns an error when a path and VLAN is already planned for another EPG of the site.
+// Clients which are not configured by the provider do not plan static ports.
+func planStaticPorts(msoClient *client.Client, schemaId, siteId, templateName, anpName, epgName string, staticPorts []staticPortVlan) error {
+	planned := getProviderSettings(msoClient).plannedStaticPorts
+	if planned == nil {
+		return nil
+	}
+	epg := fmt.Sprintf("EPG %s of ANP %s in template %s of schema %s", epgName, anpName, templateName, schemaId)
+	planned.Lock()
+	defer planned.Unlock()
+	for _, staticPort := range staticPorts {
+		plannedEpg, ok := planned.epgs[plannedStaticPortKey{siteId, staticPort}]
+		if ok && plannedEpg != epg {
+			return fmt.Errorf("VLAN %d on path %s is also planned for %s.", staticPort.vlan, staticPort.path, plannedEpg)
+		}
+	}
+	for _, staticPort := range staticPorts {
+		planned.epgs[plannedStaticPortKey{siteId, staticPort}] = epg
+	}
+	return nil
+}
+
+// validateStaticPortVlans verifies that the VLANs of static ports fall inside the VLAN pools of the domains associated
+// with the site EPG and that the same path and VLAN is not planned for another EPG of the site by a static port resource
+// of the same configuration.
+// A path and VLAN which is used by another EPG of the site in the schema is only logged as a warning, because the plan may
+// remove that static port in the same apply, like when a static port is moved to another EPG.
+func validateStaticPortVlans(msoClient *client.Client, schemaId, siteId, templateName, anpName, epgName string, staticPorts []staticPortVlan) error {
+	seen := make(map[staticPortVlan]bool)
+	for _, staticPort := range staticPorts {
+		if seen[staticPort] {
+			return fmt.Errorf("VLAN %d is configured more than once on path %s.", staticPort.vlan, staticPort.path)
+		}
+		seen[staticPort] = true
+	}
+	err := planStaticPorts(msoClient, schemaId, siteId, templateName, anpName, epgName, staticPorts)
+	if err != nil {
+		return err
+	}
+
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return err
+	}
+	siteCount, err := schemaCont.ArrayCount("sites")
+	if err != nil {
+		return fmt.Errorf("No Sites found")
+	}
+
+	epgRef := regexp.MustCompile("/schemas/(.*)/templates/(.*)/anps/(.*)/epgs/(.*)")
+	domainDns := make([]string, 0)
+	for i := 0; i < siteCount; i++ {
+		siteCont, err := schemaCont.ArrayElement(i, "sites")
+		if err != nil {
+			return err
+		}
+		if models.G(siteCont, "siteId") != siteId {
+			continue
+		}
+		siteTemplate := models.G(siteCont, "templateName")
+		anpCount, _ := siteCont.ArrayCount("anps")
+		for j := 0; j < anpCount; j++ {
+			anpCont, err := siteCont.ArrayElement(j, "anps")
+			if err != nil {
+				return err
+			}
+			epgCount, _ := anpCont.ArrayCount("epgs")
+			for k := 0; k < epgCount; k++ {
+				epgCont, err := anpCont.ArrayElement(k, "epgs")
+				if err != nil {
+					return err
+				}
+				match := epgRef.FindStringSubmatch(models.G(epgCont, "epgRef"))
+				if match == nil {
+					continue
+				}
+				if siteTemplate == templateName && match[3] == anpName && match[4] == epgName {
+					domainCount, _ := epgCont.ArrayCount("domainAssociations")
+					for l := 0; l < domainCount; l++ {
+						domainCont, err := epgCont.ArrayElement(l, "domainAssociations")
+						if err != nil {
+							return err
+						}
+						domainDns = append(domainDns, models.G(domainCont, "dn"))
+					}
+					continue
+				}
+				portCount, _ := epgCont.ArrayCount("staticPorts")
+				for l := 0; l < portCount; l++ {
+					portCont, err := epgCont.ArrayElement(l, "staticPorts")
+					if err != nil {
+						return err
+					}
+					apiPort := staticPortVlan{path: models.G(portCont, "path"), vlan: convertInterfaceToInt(portCont.S("portEncapVlan").Data())}
+					if seen[apiPort] {
+						log.Printf("[WARN] VLAN %d on path %s is used by EPG %s of ANP %s in template %s, the apply fails unless the static port is removed from that EPG in the same apply.", apiPort.vlan, apiPort.path, match[4], match[3], siteTemplate)
+					}
+				}
+			}
+		}
+	}
+
+	if len(domainDns) == 0 {
+		return nil
+	}
+	domains, err := getSiteDomains(msoClient, siteId)
+	if err != nil {
+		return err
+	}
+	vlanRanges := make([][2]int, 0)
+	for _, domain := range domains {
+		domainMap, ok := domain.(map[string]interface{})
+		if ok && valueInSliceofStrings(convertInterfaceToString(domainMap["dn"]), domainDns) {
+			vlanRanges = append(vlanRanges, getDomainVlanRanges(domainMap)...)
+		}
+	}
+	// The VLAN pools are unknown when the domains do not expose them, the check is skipped in that case.
+	if len(vlanRanges) == 0 {
+		return nil
+	}
+	for _, staticPort := range staticPorts {
+		inRange := false
+		for _, vlanRange := range vlanRanges {
+			if staticPort.vlan >= vlanRange[0] && staticPort.vlan <= vlanRange[1] {
+				inRange = true
+				break
+			}
+		}
+		if !inRange {
+			ranges := make([]string, 0, len(vlanRanges))
+			for _, vlanRange := range vlanRanges {
+				ranges = append(ranges, fmt.Sprintf("%d-%d", vlanRange[0], vlanRange[1]))
+			}
+			return fmt.Errorf("VLAN %d on path %s is not in the VLAN pools of the domains [%s] associated with EPG %s, expected a VLAN in [%s].", staticPort.vlan, staticPort.path, strings.Join(domainDns, ", "), epgName, strings.Join(ranges, ", "))
+		}
+	}
+	return nil
+}
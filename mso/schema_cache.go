@@ -0,0 +1,87 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+)
+
+// schemaCacheEntry is a schema which is retrieved once and shared by the reads of all resources of the schema.
+// The done channel is closed when the retrieval is finished, concurrent reads wait for it instead of sending their own request.
+type schemaCacheEntry struct {
+	done chan struct{}
+	data []byte
+}
+
+// schemaCache holds the schemas per client, so every provider configuration has its own cache.
+// The schemas are only cached for the configurations with schema_cache, and a schema is removed on every write to it by the provider.
+var schemaCache = struct {
+	sync.Mutex
+	schemas map[*client.Client]map[string]*schemaCacheEntry
+}{schemas: make(map[*client.Client]map[string]*schemaCacheEntry)}
+
+// getSchemaCont returns the schema, from the cache when it is enabled and the schema was retrieved before.
+// Every call returns its own container, so the callers can modify it without changing the cached schema.
+// NDO has no endpoint which returns a single object of a schema, so the reads of the schema objects share the retrieval of the schema instead.
+func getSchemaCont(msoClient *client.Client, schemaId string) (*container.Container, error) {
+	path := fmt.Sprintf("api/v1/schemas/%s", schemaId)
+	if !getProviderSettings(msoClient).schemaCache {
+		return msoClient.GetViaURL(path)
+	}
+	schemaCache.Lock()
+	schemas, ok := schemaCache.schemas[msoClient]
+	if !ok {
+		schemas = make(map[string]*schemaCacheEntry)
+		schemaCache.schemas[msoClient] = schemas
+	}
+	entry, ok := schemas[schemaId]
+	if !ok {
+		entry = &schemaCacheEntry{done: make(chan struct{})}
+		schemas[schemaId] = entry
+	}
+	schemaCache.Unlock()
+
+	if ok {
+		<-entry.done
+		if entry.data != nil {
+			return container.ParseJSON(entry.data)
+		}
+		// The retrieval of the schema failed, the error is returned by a request of its own.
+		return msoClient.GetViaURL(path)
+	}
+
+	cont, err := msoClient.GetViaURL(path)
+	if err == nil {
+		entry.data = cont.Bytes()
+	} else {
+		invalidateSchemaCacheEntry(msoClient, schemaId, entry)
+	}
+	close(entry.done)
+	return cont, err
+}
+
+// invalidateSchemaCache removes the schema of the path from the cache of the client.
+// Paths outside of api/v1/schemas/{schema_id} do not change a cached schema and are ignored.
+func invalidateSchemaCache(msoClient *client.Client, path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 4 || segments[0] != "api" || segments[1] != "v1" || segments[2] != "schemas" {
+		return
+	}
+	schemaId := strings.SplitN(segments[3], "?", 2)[0]
+	log.Printf("[DEBUG] Removing schema %s from the schema cache", schemaId)
+	invalidateSchemaCacheEntry(msoClient, schemaId, nil)
+}
+
+// invalidateSchemaCacheEntry removes the schema from the cache of the client.
+// When entry is set, the schema is only removed when it is still cached with this entry.
+func invalidateSchemaCacheEntry(msoClient *client.Client, schemaId string, entry *schemaCacheEntry) {
+	schemaCache.Lock()
+	defer schemaCache.Unlock()
+	if schemas, ok := schemaCache.schemas[msoClient]; ok && (entry == nil || schemas[schemaId] == entry) {
+		delete(schemas, schemaId)
+	}
+}
@@ -0,0 +1,129 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// schemaReference describes the attributes of a reference to an object in the list of a schema template.
+// The reference attributes are nested in block when it is set.
+type schemaReference struct {
+	block       string
+	schemaIdKey string
+	templateKey string
+	nameKey     string
+	list        string
+}
+
+// schemaReferences are the references to objects in other schemas which are validated in validate_references mode, by resource.
+var schemaReferences = map[string][]schemaReference{
+	"mso_schema_template_bd": {
+		{schemaIdKey: "vrf_schema_id", templateKey: "vrf_template_name", nameKey: "vrf_name", list: "vrfs"},
+	},
+	"mso_schema_template_anp_epg": {
+		{schemaIdKey: "bd_schema_id", templateKey: "bd_template_name", nameKey: "bd_name", list: "bds"},
+		{schemaIdKey: "vrf_schema_id", templateKey: "vrf_template_name", nameKey: "vrf_name", list: "vrfs"},
+	},
+	"mso_schema_template_contract": {
+		{block: "filter_relationship", schemaIdKey: "filter_schema_id", templateKey: "filter_template_name", nameKey: "filter_name", list: "filters"},
+	},
+	"mso_schema_template_contract_filter": {
+		{schemaIdKey: "filter_schema_id", templateKey: "filter_template_name", nameKey: "filter_name", list: "filters"},
+	},
+}
+
+// validateReferencesResources adds the validation of the references to other schemas to the CustomizeDiff of the resources.
+func validateReferencesResources(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, references := range schemaReferences {
+		resource, ok := resources[name]
+		if !ok {
+			continue
+		}
+		resourceName, resourceReferences := name, references
+		customizeDiff := resource.CustomizeDiff
+		resource.CustomizeDiff = func(diff *schema.ResourceDiff, m interface{}) error {
+			if getProviderSettings(m).validateReferences {
+				err := checkSchemaReferences(diff, m.(*client.Client), resourceName, resourceReferences)
+				if err != nil {
+					return err
+				}
+			}
+			if customizeDiff != nil {
+				return customizeDiff(diff, m)
+			}
+			return nil
+		}
+	}
+	return resources
+}
+
+// checkSchemaReferences returns an error when a reference to an object in another schema does not resolve.
+// References within the schema of the resource are not validated, because their objects can be created in the same apply.
+func checkSchemaReferences(diff *schema.ResourceDiff, msoClient *client.Client, resourceName string, references []schemaReference) error {
+	if !diff.NewValueKnown("schema_id") {
+		return nil
+	}
+	schemaId := diff.Get("schema_id").(string)
+	for _, reference := range references {
+		if reference.block == "" {
+			if !diff.NewValueKnown(reference.schemaIdKey) || !diff.NewValueKnown(reference.templateKey) || !diff.NewValueKnown(reference.nameKey) {
+				continue
+			}
+			err := checkSchemaReference(msoClient, resourceName, schemaId, reference, map[string]interface{}{
+				reference.schemaIdKey: diff.Get(reference.schemaIdKey),
+				reference.templateKey: diff.Get(reference.templateKey),
+				reference.nameKey:     diff.Get(reference.nameKey),
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !diff.NewValueKnown(reference.block) {
+			continue
+		}
+		blocks, _ := diff.Get(reference.block).([]interface{})
+		for _, block := range blocks {
+			if blockMap, ok := block.(map[string]interface{}); ok {
+				err := checkSchemaReference(msoClient, resourceName, schemaId, reference, blockMap)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkSchemaReference(msoClient *client.Client, resourceName, schemaId string, reference schemaReference, values map[string]interface{}) error {
+	referenceSchemaId := convertInterfaceToString(values[reference.schemaIdKey])
+	templateName := convertInterfaceToString(values[reference.templateKey])
+	name := convertInterfaceToString(values[reference.nameKey])
+	if referenceSchemaId == "" || referenceSchemaId == schemaId || templateName == "" || name == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Validating reference of %s to %s %s in template %s of schema %s", resourceName, reference.list, name, templateName, referenceSchemaId)
+	cont, err := getSchemaCont(msoClient, referenceSchemaId)
+	if err != nil {
+		return fmt.Errorf("The schema %s referenced by %s in %s could not be read: %s", referenceSchemaId, reference.schemaIdKey, resourceName, err)
+	}
+	templates, _ := cont.S("templates").Data().([]interface{})
+	for _, template := range templates {
+		templateMap, ok := template.(map[string]interface{})
+		if !ok || templateMap["name"] != templateName {
+			continue
+		}
+		for _, objectName := range getTemplateObjectNames(templateMap, reference.list) {
+			if objectName == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("The %s %s referenced by %s in %s does not exist in template %s of schema %s", strings.TrimSuffix(reference.list, "s"), name, reference.nameKey, resourceName, templateName, referenceSchemaId)
+	}
+	return fmt.Errorf("The template %s referenced by %s in %s does not exist in schema %s", templateName, reference.templateKey, resourceName, referenceSchemaId)
+}
@@ -0,0 +1,27 @@
+package mso
+
+import "testing"
+
+func TestCheckSchemaReference(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+	reference := schemaReferences["mso_schema_template_bd"][0]
+
+	tests := []struct {
+		schemaId string
+		values   map[string]interface{}
+		valid    bool
+	}{
+		{"other", map[string]interface{}{"vrf_schema_id": mockSchemaId, "vrf_template_name": "Template1", "vrf_name": "VRF1"}, true},
+		{"other", map[string]interface{}{"vrf_schema_id": mockSchemaId, "vrf_template_name": "Template1", "vrf_name": "VRF2"}, false},
+		{"other", map[string]interface{}{"vrf_schema_id": mockSchemaId, "vrf_template_name": "Template2", "vrf_name": "VRF1"}, false},
+		{"other", map[string]interface{}{"vrf_schema_id": "unknown", "vrf_template_name": "Template1", "vrf_name": "VRF1"}, false},
+		// References within the schema of the resource are not validated.
+		{mockSchemaId, map[string]interface{}{"vrf_schema_id": mockSchemaId, "vrf_template_name": "Template1", "vrf_name": "VRF2"}, true},
+	}
+	for _, test := range tests {
+		err := checkSchemaReference(msoClient, "mso_schema_template_bd", test.schemaId, reference, test.values)
+		if (err == nil) != test.valid {
+			t.Errorf("checkSchemaReference(%v) returned %v, expected valid %t", test.values, err, test.valid)
+		}
+	}
+}
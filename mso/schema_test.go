@@ -0,0 +1,77 @@
+package mso
+
+import (
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+func TestValidateStaticPortPath(t *testing.T) {
+	tests := []struct {
+		pathType, pod, leaf, path, fex string
+		valid                          bool
+	}{
+		{"port", "pod-1", "101", "eth1/1", "", true},
+		{"port", "pod-1", "101", "eth1/1/4", "", true},
+		{"port", "pod-1", "101", "eth1/1", "105", true},
+		{"vpc", "pod-1", "101-102", "vpc_pol_grp", "", true},
+		{"dpc", "pod-1", "101", "pc_pol_grp", "", true},
+		{"port", "1", "101", "eth1/1", "", false},
+		{"port", "pod-1", "101-102", "eth1/1", "", false},
+		{"port", "pod-1", "101", "pol_grp", "", false},
+		{"port", "pod-1", "101", "eth1/1", "200", false},
+		{"vpc", "pod-1", "101", "vpc_pol_grp", "", false},
+		{"vpc", "pod-1", "101-101", "vpc_pol_grp", "", false},
+		{"vpc", "pod-1", "101-102", "vpc_pol_grp", "105", false},
+		{"dpc", "pod-1", "101", "pc_pol_grp", "105", false},
+	}
+	for _, test := range tests {
+		err := validateStaticPortPath(test.pathType, test.pod, test.leaf, test.path, test.fex)
+		if (err == nil) != test.valid {
+			t.Errorf("validateStaticPortPath(%s, %s, %s, %s, %s) returned %v, expected valid %t", test.pathType, test.pod, test.leaf, test.path, test.fex, err, test.valid)
+		}
+	}
+}
+
+func TestPlanStaticPorts(t *testing.T) {
+	msoClient := client.NewClient("https://ndo.example.com", "admin")
+	setProviderSettings(msoClient, &providerSettings{plannedStaticPorts: newPlannedStaticPorts()})
+	defer settingsByClient.Delete(msoClient)
+	staticPorts := []staticPortVlan{{path: "topology/pod-1/paths-101/pathep-[eth1/1]", vlan: 100}}
+
+	if err := planStaticPorts(msoClient, "schema1", "site1", "Template1", "ANP1", "EPG1", staticPorts); err != nil {
+		t.Fatal(err)
+	}
+	if err := planStaticPorts(msoClient, "schema1", "site1", "Template1", "ANP1", "EPG1", staticPorts); err != nil {
+		t.Errorf("Expected the same EPG to plan its static port again, got %v", err)
+	}
+	if err := planStaticPorts(msoClient, "schema2", "site1", "Template1", "ANP2", "EPG2", staticPorts); err == nil {
+		t.Error("Expected an error for a path and VLAN which is planned for another EPG of the site")
+	}
+	if err := planStaticPorts(msoClient, "schema1", "site2", "Template1", "ANP2", "EPG2", staticPorts); err != nil {
+		t.Errorf("Expected the path and VLAN to be allowed on another site, got %v", err)
+	}
+	otherClient := client.NewClient("https://ndo2.example.com", "admin")
+	setProviderSettings(otherClient, &providerSettings{plannedStaticPorts: newPlannedStaticPorts()})
+	defer settingsByClient.Delete(otherClient)
+	if err := planStaticPorts(otherClient, "schema1", "site1", "Template1", "ANP2", "EPG2", staticPorts); err != nil {
+		t.Errorf("Expected the path and VLAN to be allowed for another provider configuration, got %v", err)
+	}
+}
+
+func TestValidateStaticPortVlansMove(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+	testStaticPortSchema()
+	setProviderSettings(msoClient, &providerSettings{plannedStaticPorts: newPlannedStaticPorts()})
+
+	// The static port of EPG1 is moved to EPG2, the plan removes it from EPG1 in the same apply.
+	staticPorts := []staticPortVlan{{path: staticPortPath1, vlan: 100}}
+	err := validateStaticPortVlans(msoClient, mockSchemaId, staticPortSiteId, "Template1", "ANP1", "EPG2", staticPorts)
+	if err != nil {
+		t.Errorf("Expected the static port to be allowed on EPG2 while it is still used by EPG1 on NDO, got %v", err)
+	}
+	err = validateStaticPortVlans(msoClient, mockSchemaId, staticPortSiteId, "Template1", "ANP1", "EPG3", staticPorts)
+	if err == nil {
+		t.Error("Expected an error for a static port which is planned for two EPGs")
+	}
+}
@@ -0,0 +1,200 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+// staticPortBatchDelay is the time a batch waits for static port operations of other resources on the same schema.
+const staticPortBatchDelay = 500 * time.Millisecond
+
+// staticPortOperation is a static port add, replace or remove of a site EPG.
+// The index of the static port in the PATCH path is resolved when the batch is sent.
+type staticPortOperation struct {
+	siteId       string
+	templateName string
+	anpName      string
+	epgName      string
+	staticPort   *models.SchemaSiteAnpEpgStaticPort
+}
+
+// staticPortBatchKey identifies a batch, operations are only batched when they are sent with the same client to the same schema.
+type staticPortBatchKey struct {
+	msoClient *client.Client
+	schemaId  string
+}
+
+type staticPortBatch struct {
+	operations []staticPortOperation
+	done       chan struct{}
+	// errs holds the error of every operation, by the index of the operation.
+	errs []error
+}
+
+var staticPortBatches = struct {
+	sync.Mutex
+	batches map[staticPortBatchKey]*staticPortBatch
+}{batches: make(map[staticPortBatchKey]*staticPortBatch)}
+
+// patchStaticPort queues a static port operation and waits until it is sent, it returns the error of the operation.
+// Operations of all static port resources on the same schema that are queued within staticPortBatchDelay are sent in a single PATCH request.
+func patchStaticPort(msoClient *client.Client, schemaId string, operation staticPortOperation) error {
+	key := staticPortBatchKey{msoClient, schemaId}
+	staticPortBatches.Lock()
+	batch, ok := staticPortBatches.batches[key]
+	if !ok {
+		batch = &staticPortBatch{done: make(chan struct{})}
+		staticPortBatches.batches[key] = batch
+		go func() {
+			err := sleepWithContext(getStopContext(msoClient), staticPortBatchDelay, fmt.Sprintf("waiting for the static port operations of schema %s", schemaId))
+			staticPortBatches.Lock()
+			delete(staticPortBatches.batches, key)
+			staticPortBatches.Unlock()
+			if err != nil {
+				batch.errs = make([]error, len(batch.operations))
+				for i := range batch.errs {
+					batch.errs[i] = err
+				}
+			} else {
+				batch.errs = sendStaticPortBatch(msoClient, schemaId, batch.operations)
+			}
+			close(batch.done)
+		}()
+	}
+	index := len(batch.operations)
+	batch.operations = append(batch.operations, operation)
+	staticPortBatches.Unlock()
+
+	<-batch.done
+	return batch.errs[index]
+}
+
+// sendStaticPortBatch resolves the static port indexes from the current schema and sends all operations in one PATCH request, it returns the error of every operation.
+// Replace ops are sent first, then the add ops which append to the list, and last the remove ops in descending index order so earlier removes do not shift the indexes of later ones.
+// When NDO rejects the PATCH request, the operations are sent one by one so only the operations which fail return an error.
+func sendStaticPortBatch(msoClient *client.Client, schemaId string, operations []staticPortOperation) []error {
+	log.Printf("[DEBUG] Sending %d batched static port operations for schema %s", len(operations), schemaId)
+	errs := make([]error, len(operations))
+	schemaCont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	type indexedOperation struct {
+		index     int
+		position  int
+		operation staticPortOperation
+	}
+	var replaces, adds, removes []indexedOperation
+	for position, operation := range operations {
+		if operation.staticPort.Ops == "add" {
+			adds = append(adds, indexedOperation{-1, position, operation})
+			continue
+		}
+		portPath := operation.staticPort.Value["path"].(string)
+		index, err := getSiteEpgStaticPortIndex(schemaCont, operation, portPath)
+		if err != nil {
+			errs[position] = err
+			continue
+		}
+		if index == -1 {
+			if operation.staticPort.Ops == "remove" {
+				log.Printf("[DEBUG] Static port %s is already removed", portPath)
+			} else {
+				errs[position] = fmt.Errorf("The specified parameters to update static port entry not found")
+			}
+			continue
+		}
+		if operation.staticPort.Ops == "remove" {
+			removes = append(removes, indexedOperation{index, position, operation})
+		} else {
+			replaces = append(replaces, indexedOperation{index, position, operation})
+		}
+	}
+	sort.SliceStable(removes, func(i, j int) bool { return removes[i].index > removes[j].index })
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	sent := make([]int, 0, len(operations))
+	for _, indexed := range append(append(replaces, adds...), removes...) {
+		operation := indexed.operation
+		index := "-"
+		if indexed.index != -1 {
+			index = fmt.Sprintf("%d", indexed.index)
+		}
+		path := fmt.Sprintf("/sites/%s-%s/anps/%s/epgs/%s/staticPorts/%s", operation.siteId, operation.templateName, operation.anpName, operation.epgName, index)
+		var value interface{}
+		if operation.staticPort.Ops != "remove" {
+			value = operation.staticPort.Value
+		}
+		err := addPatchPayloadToContainer(payloadCon, operation.staticPort.Ops, path, value)
+		if err != nil {
+			errs[indexed.position] = err
+			continue
+		}
+		sent = append(sent, indexed.position)
+	}
+	if len(sent) == 0 {
+		return errs
+	}
+
+	err = doPatchRequest(msoClient, fmt.Sprintf("api/v1/schemas/%s", schemaId), payloadCon)
+	if err == nil || len(sent) == 1 {
+		for _, position := range sent {
+			errs[position] = err
+		}
+		return errs
+	}
+	log.Printf("[WARN] Batched static port operations for schema %s failed, sending them one by one: %s", schemaId, err)
+	sort.Ints(sent)
+	for _, position := range sent {
+		errs[position] = sendStaticPortBatch(msoClient, schemaId, operations[position:position+1])[0]
+	}
+	return errs
+}
+
+// getSiteEpgStaticPortIndex returns the index of the static port with portPath in the site EPG, or -1 when it is not found.
+func getSiteEpgStaticPortIndex(schemaCont *container.Container, operation staticPortOperation, portPath string) (int, error) {
+	siteCount, err := schemaCont.ArrayCount("sites")
+	if err != nil {
+		return -1, fmt.Errorf("No Sites found")
+	}
+	for i := 0; i < siteCount; i++ {
+		siteCont, err := schemaCont.ArrayElement(i, "sites")
+		if err != nil {
+			return -1, err
+		}
+		if models.G(siteCont, "siteId") != operation.siteId || models.G(siteCont, "templateName") != operation.templateName {
+			continue
+		}
+		anpCont, err := getSiteAnp(operation.anpName, siteCont)
+		if err != nil {
+			return -1, nil
+		}
+		epgCont, err := getSiteEpg(operation.epgName, anpCont)
+		if err != nil {
+			return -1, nil
+		}
+		portCount, _ := epgCont.ArrayCount("staticPorts")
+		for j := 0; j < portCount; j++ {
+			portCont, err := epgCont.ArrayElement(j, "staticPorts")
+			if err != nil {
+				return -1, err
+			}
+			if models.G(portCont, "path") == portPath {
+				return j, nil
+			}
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,100 @@
+package mso
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+const (
+	staticPortSiteId = "5c7c95b25100008f01c1ee3c"
+	staticPortPath1  = "topology/pod-1/paths-101/pathep-[eth1/1]"
+	staticPortPath2  = "topology/pod-1/paths-101/pathep-[eth1/2]"
+)
+
+// testStaticPortSchema adds EPG1 of ANP1 with a static port on staticPortPath1 to the site of the mock schema.
+func testStaticPortSchema() {
+	schemaObject, _ := mockServer.Object("api/v1/schemas/" + mockSchemaId)
+	site := schemaObject.(map[string]interface{})["sites"].([]interface{})[0].(map[string]interface{})
+	site["anps"] = []interface{}{map[string]interface{}{
+		"anpRef": "/schemas/" + mockSchemaId + "/templates/Template1/anps/ANP1",
+		"epgs": []interface{}{map[string]interface{}{
+			"epgRef":      "/schemas/" + mockSchemaId + "/templates/Template1/anps/ANP1/epgs/EPG1",
+			"staticPorts": []interface{}{map[string]interface{}{"path": staticPortPath1, "portEncapVlan": 100}},
+		}},
+	}}
+	mockServer.SetObject("api/v1/schemas/"+mockSchemaId, schemaObject)
+}
+
+// testPatchStaticPorts queues the operations at the same time and returns the error of every operation.
+func testPatchStaticPorts(operations ...staticPortOperation) []error {
+	errs := make([]error, len(operations))
+	var wg sync.WaitGroup
+	for i, operation := range operations {
+		wg.Add(1)
+		go func(i int, operation staticPortOperation) {
+			defer wg.Done()
+			errs[i] = patchStaticPort(mockClient, mockSchemaId, operation)
+		}(i, operation)
+	}
+	wg.Wait()
+	return errs
+}
+
+func testStaticPortOperation(op, portPath string, vlan int) staticPortOperation {
+	return staticPortOperation{staticPortSiteId, "Template1", "ANP1", "EPG1", models.NewSchemaSiteAnpEpgStaticPort(op, "", "port", portPath, vlan, "", 0, "")}
+}
+
+func TestPatchStaticPortErrorPerOperation(t *testing.T) {
+	server, _ := testMockNDO(t)
+	testStaticPortSchema()
+
+	errs := testPatchStaticPorts(
+		testStaticPortOperation("add", staticPortPath2, 200),
+		testStaticPortOperation("replace", "topology/pod-1/paths-101/pathep-[eth1/3]", 300),
+	)
+	if errs[0] != nil {
+		t.Errorf("Expected the add to succeed, got %v", errs[0])
+	}
+	if errs[1] == nil {
+		t.Error("Expected the replace of a missing static port to fail")
+	}
+
+	patches := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			patches++
+		}
+	}
+	if patches != 1 {
+		t.Errorf("Expected the add to be sent in one PATCH request, got %d", patches)
+	}
+}
+
+func TestPatchStaticPortBatchFallback(t *testing.T) {
+	server, _ := testMockNDO(t)
+	testStaticPortSchema()
+	server.Fail(http.MethodPatch, "api/v1/schemas/"+mockSchemaId, http.StatusBadRequest, map[string]interface{}{"code": 400, "message": "Invalid static port"})
+
+	errs := testPatchStaticPorts(
+		testStaticPortOperation("replace", staticPortPath1, 101),
+		testStaticPortOperation("add", staticPortPath2, 200),
+	)
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("Expected operation %d to succeed when it is sent on its own, got %v", i, err)
+		}
+	}
+
+	patches := 0
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			patches++
+		}
+	}
+	if patches != 3 {
+		t.Errorf("Expected the rejected batch to be sent again one operation at a time, got %d PATCH requests", patches)
+	}
+}
@@ -0,0 +1,35 @@
+package mso
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// getStopContext returns the stop context of the provider configuration of the meta, which is cancelled when Terraform is interrupted.
+// Requests and waits which are derived from it end directly instead of running until their retries or timeouts are exhausted.
+func getStopContext(m interface{}) context.Context {
+	if ctx := getProviderSettings(m).stopContext; ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// contextDoneError returns the error for a wait which ended because the context is done.
+// The action describes what was waited for, e.g. "waiting for deploy task 1 to complete".
+func contextDoneError(ctx context.Context, action string) error {
+	if ctx.Err() == context.Canceled {
+		return fmt.Errorf("Interrupted while %s", action)
+	}
+	return fmt.Errorf("Timeout exceeded while %s", action)
+}
+
+// sleepWithContext waits for the duration and returns an error when the context is done before.
+func sleepWithContext(ctx context.Context, duration time.Duration, action string) error {
+	select {
+	case <-ctx.Done():
+		return contextDoneError(ctx, action)
+	case <-time.After(duration):
+		return nil
+	}
+}
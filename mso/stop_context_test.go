@@ -0,0 +1,51 @@
+package mso
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+func TestSleepWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := sleepWithContext(ctx, time.Hour, "waiting for the test")
+	if err == nil || !strings.HasPrefix(err.Error(), "Interrupted while waiting for the test") {
+		t.Errorf("expected an interrupted error, got %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err = sleepWithContext(ctx, time.Hour, "waiting for the test")
+	if err == nil || !strings.HasPrefix(err.Error(), "Timeout exceeded while waiting for the test") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+
+	if err = sleepWithContext(context.Background(), time.Millisecond, "waiting for the test"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestStopContextPerConfiguration(t *testing.T) {
+	stoppedClient := client.NewClient("https://mso1.example.com", "admin", client.Password("password"))
+	runningClient := client.NewClient("https://mso2.example.com", "admin", client.Password("password"))
+	stopContext, cancel := context.WithCancel(context.Background())
+	cancel()
+	setProviderSettings(stoppedClient, &providerSettings{stopContext: stopContext})
+	setProviderSettings(runningClient, &providerSettings{stopContext: context.Background()})
+	defer settingsByClient.Delete(stoppedClient)
+	defer settingsByClient.Delete(runningClient)
+
+	if getStopContext(stoppedClient).Err() == nil {
+		t.Error("Expected the stop context of the stopped configuration to be cancelled")
+	}
+	if err := getStopContext(runningClient).Err(); err != nil {
+		t.Errorf("Expected the stop context of the other configuration to be running, got %v", err)
+	}
+	if getStopContext(nil) == nil {
+		t.Error("Expected a background context for clients which are not configured")
+	}
+}
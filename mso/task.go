@@ -0,0 +1,19 @@
+package mso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+)
+
+// waitForTask polls the task with the client until it is no longer running and returns its last status.
+// An error is returned with the status and message of every site on which the task failed, or when the context is done before the task finished.
+func waitForTask(ctx context.Context, msoClient *client.Client, taskId string) (*container.Container, error) {
+	taskCont, err := msoClient.WaitForTask(ctx, taskId, 0)
+	if err != nil && ctx.Err() != nil {
+		return taskCont, contextDoneError(ctx, fmt.Sprintf("waiting for task %s to complete", taskId))
+	}
+	return taskCont, err
+}
@@ -0,0 +1,61 @@
+package mso
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForTaskSiteErrors(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/task/task1", map[string]interface{}{
+		"id": "task1",
+		"operDetails": map[string]interface{}{
+			"taskStatus": "Error",
+			"siteStatus": map[string]interface{}{
+				"site2": map[string]interface{}{"siteName": "Site2", "status": map[string]interface{}{"status": "Success"}},
+				"site1": map[string]interface{}{"siteName": "Site1", "status": map[string]interface{}{"status": "Failed", "msg": "Invalid BD"}},
+			},
+		},
+	})
+
+	_, err := waitForTask(context.Background(), msoClient, "task1")
+	if err == nil || !strings.Contains(err.Error(), "failed on 1 site(s)") || !strings.Contains(err.Error(), "site Site1 (site1): Failed Invalid BD") {
+		t.Fatalf("Expected an error for site1, got %v", err)
+	}
+	if strings.Contains(err.Error(), "site2") {
+		t.Errorf("Expected no error for the successful site, got %v", err)
+	}
+}
+
+func TestWaitForTaskTimeout(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/task/task1", map[string]interface{}{
+		"id":          "task1",
+		"operDetails": map[string]interface{}{"taskStatus": "Running"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := waitForTask(ctx, msoClient, "task1")
+	if err == nil || !strings.Contains(err.Error(), "Timeout exceeded while waiting for task task1") {
+		t.Fatalf("Expected a timeout error, got %v", err)
+	}
+}
+
+func TestWaitForTaskComplete(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/task/task1", map[string]interface{}{
+		"id":          "task1",
+		"operDetails": map[string]interface{}{"taskStatus": "Complete"},
+	})
+
+	taskCont, err := waitForTask(context.Background(), msoClient, "task1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskCont.S("id").Data() != "task1" {
+		t.Errorf("Expected the status of task1, got %v", taskCont)
+	}
+}
@@ -0,0 +1,366 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// Template types are the keys of the template content in the NDO templates API.
+const (
+	tenantPolicyTemplate   = "tenantPolicyTemplate"
+	fabricPolicyTemplate   = "fabricPolicyTemplate"
+	fabricResourceTemplate = "fabricResourceTemplate"
+	serviceDeviceTemplate  = "deviceTemplate"
+	l3outTemplate          = "l3outTemplate"
+	monitoringTemplate     = "monitoringTemplate"
+)
+
+// templateApiTypes maps the template types to the templateType of the NDO templates API.
+var templateApiTypes = map[string]string{
+	tenantPolicyTemplate:   "tenantPolicy",
+	fabricPolicyTemplate:   "fabricPolicy",
+	fabricResourceTemplate: "fabricResource",
+	serviceDeviceTemplate:  "serviceDevice",
+	l3outTemplate:          "l3out",
+	monitoringTemplate:     "monitoring",
+}
+
+// templateMinVersions are the NDO versions which introduced the template types that are not available since NDO 4.0.
+var templateMinVersions = map[string]string{
+	l3outTemplate:      "4.2.0.0",
+	monitoringTemplate: "4.2.0.0",
+}
+
+// siteTemplateTypes are the template types which belong to a single site.
+// Their tenant and site are set in the template content itself instead of in the template object and the sites list.
+var siteTemplateTypes = map[string]bool{
+	l3outTemplate: true,
+}
+
+// tenantTemplateTypes are the template types which belong to a tenant.
+var tenantTemplateTypes = map[string]bool{
+	tenantPolicyTemplate:  true,
+	serviceDeviceTemplate: true,
+	l3outTemplate:         true,
+}
+
+// resourceMSOTemplate returns the resource of a template of the templateType in the NDO templates API, which is available since NDO 4.0.
+// The template is identified by its ID, which is used as template_id by the resources of the policies in the template.
+func resourceMSOTemplate(templateType string) *schema.Resource {
+	templateSchema := map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 64),
+		},
+	}
+	if siteTemplateTypes[templateType] {
+		templateSchema["site_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		}
+	} else {
+		templateSchema["sites"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		}
+	}
+	if tenantTemplateTypes[templateType] {
+		templateSchema["tenant_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		}
+	}
+	// Monitoring templates monitor either the EPGs of a tenant or the access interfaces of the fabric.
+	if templateType == monitoringTemplate {
+		templateSchema["monitoring_type"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"tenant",
+				"access",
+			}, false),
+		}
+		templateSchema["tenant_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 1000),
+		}
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceMSOTemplateCreate(d, m, templateType)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceMSOTemplateRead(d, m, templateType)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceMSOTemplateUpdate(d, m, templateType)
+		},
+		Delete: resourceMSOTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+				err := resourceMSOTemplateRead(d, m, templateType)
+				if err != nil {
+					return nil, err
+				}
+				if d.Id() == "" {
+					return nil, fmt.Errorf("Template %s not found", d.Id())
+				}
+				log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		SchemaVersion: version,
+
+		Schema: templateSchema,
+	}
+}
+
+// getTemplateSitesPayload returns the sites of the template sorted by ID, to keep the payload stable.
+func getTemplateSitesPayload(d *schema.ResourceData) []interface{} {
+	siteIds := make([]string, 0)
+	for _, siteId := range d.Get("sites").(*schema.Set).List() {
+		siteIds = append(siteIds, siteId.(string))
+	}
+	sort.Strings(siteIds)
+	sites := make([]interface{}, 0, len(siteIds))
+	for _, siteId := range siteIds {
+		sites = append(sites, map[string]interface{}{"siteId": siteId})
+	}
+	return sites
+}
+
+func resourceMSOTemplateCreate(d *schema.ResourceData, m interface{}, templateType string) error {
+	log.Printf("[DEBUG] Template: Beginning Creation")
+	msoClient := m.(*client.Client)
+
+	minVersion, ok := templateMinVersions[templateType]
+	if !ok {
+		minVersion = "4.0.0.0"
+	}
+	versionInt, err := msoClient.CompareVersion(minVersion)
+	if err != nil {
+		return err
+	}
+	if versionInt == 1 {
+		return fmt.Errorf("%s templates are only supported in NDO %s and higher", templateApiTypes[templateType], strings.TrimSuffix(minVersion, ".0.0"))
+	}
+
+	var content map[string]interface{}
+	if siteTemplateTypes[templateType] {
+		content = map[string]interface{}{
+			"tenantId": d.Get("tenant_id").(string),
+			"siteId":   d.Get("site_id").(string),
+		}
+	} else {
+		template := map[string]interface{}{}
+		if tenantTemplateTypes[templateType] {
+			template["tenantId"] = d.Get("tenant_id").(string)
+		}
+		if templateType == monitoringTemplate {
+			template["mtType"] = d.Get("monitoring_type").(string)
+			if template["mtType"] == "tenant" {
+				if d.Get("tenant_id").(string) == "" {
+					return fmt.Errorf("tenant_id is required for tenant monitoring templates")
+				}
+				template["tenant"] = d.Get("tenant_id").(string)
+			}
+		}
+		content = map[string]interface{}{
+			"template": template,
+			"sites":    getTemplateSitesPayload(d),
+		}
+	}
+	payload, err := container.Consume(map[string]interface{}{
+		"displayName":  d.Get("name").(string),
+		"templateType": templateApiTypes[templateType],
+		templateType:   content,
+	})
+	if err != nil {
+		return err
+	}
+
+	cont, _, err := doRequestWithContext(getStopContext(msoClient), msoClient, "POST", "api/v1/templates", payload)
+	if err != nil {
+		return err
+	}
+	templateId := models.StripQuotes(cont.S("templateId").String())
+	if templateId == "" || templateId == "{}" {
+		return fmt.Errorf("The template ID of template %s is not returned by NDO", d.Get("name").(string))
+	}
+
+	d.SetId(templateId)
+	log.Printf("[DEBUG] %s: Creation finished successfully", d.Id())
+	return resourceMSOTemplateRead(d, m, templateType)
+}
+
+func resourceMSOTemplateRead(d *schema.ResourceData, m interface{}, templateType string) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+	msoClient := m.(*client.Client)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", d.Id()))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	if apiType := models.StripQuotes(cont.S("templateType").String()); apiType != templateApiTypes[templateType] {
+		return fmt.Errorf("Template %s is a %s template, expected a %s template", d.Id(), apiType, templateApiTypes[templateType])
+	}
+
+	d.Set("name", models.StripQuotes(cont.S("displayName").String()))
+	if siteTemplateTypes[templateType] {
+		d.Set("tenant_id", convertInterfaceToString(cont.S(templateType, "tenantId").Data()))
+		d.Set("site_id", convertInterfaceToString(cont.S(templateType, "siteId").Data()))
+		log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+		return nil
+	}
+	if tenantTemplateTypes[templateType] {
+		d.Set("tenant_id", convertInterfaceToString(cont.S(templateType, "template", "tenantId").Data()))
+	}
+	if templateType == monitoringTemplate {
+		d.Set("monitoring_type", convertInterfaceToString(cont.S(templateType, "template", "mtType").Data()))
+		d.Set("tenant_id", convertInterfaceToString(cont.S(templateType, "template", "tenant").Data()))
+	}
+	siteIds := make([]interface{}, 0)
+	sites, _ := cont.S(templateType, "sites").Data().([]interface{})
+	for _, site := range sites {
+		if siteMap, ok := site.(map[string]interface{}); ok {
+			siteIds = append(siteIds, convertInterfaceToString(siteMap["siteId"]))
+		}
+	}
+	d.Set("sites", siteIds)
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func resourceMSOTemplateUpdate(d *schema.ResourceData, m interface{}, templateType string) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+	msoClient := m.(*client.Client)
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	if d.HasChange("name") {
+		err := addPatchPayloadToContainer(payloadCon, "replace", "/displayName", d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+	}
+	if !siteTemplateTypes[templateType] && d.HasChange("sites") {
+		err := addPatchPayloadToContainer(payloadCon, "add", fmt.Sprintf("/%s/sites", templateType), getTemplateSitesPayload(d))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := doPatchRequest(msoClient, fmt.Sprintf("api/v1/templates/%s", d.Id()), payloadCon)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return resourceMSOTemplateRead(d, m, templateType)
+}
+
+func resourceMSOTemplateDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+	msoClient := m.(*client.Client)
+
+	err := msoClient.DeletebyId(fmt.Sprintf("api/v1/templates/%s", d.Id()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// templatePolicyMutexes holds a mutex per template, which serializes the changes of the policies of the template.
+// The policies are patched by their index in the list, so the GET of the template and the PATCH must not interleave with other changes.
+var templatePolicyMutexes = struct {
+	sync.Mutex
+	templates map[string]*sync.Mutex
+}{templates: make(map[string]*sync.Mutex)}
+
+// lockTemplatePolicies locks the policies of the template and returns the function which unlocks them.
+func lockTemplatePolicies(templateId string) func() {
+	templatePolicyMutexes.Lock()
+	mutex, ok := templatePolicyMutexes.templates[templateId]
+	if !ok {
+		mutex = &sync.Mutex{}
+		templatePolicyMutexes.templates[templateId] = mutex
+	}
+	templatePolicyMutexes.Unlock()
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// getTemplatePolicy returns the policy with name from the objectKey list of a template, and its index in the list.
+// The index is -1 when the policy is not found.
+func getTemplatePolicy(templateCont *container.Container, templateType, objectKey, name string) (*container.Container, int, error) {
+	policyCount, err := templateCont.ArrayCount(templateType, "template", objectKey)
+	if err != nil {
+		return nil, -1, nil
+	}
+	for i := 0; i < policyCount; i++ {
+		policyCont, err := templateCont.ArrayElement(i, templateType, "template", objectKey)
+		if err != nil {
+			return nil, -1, err
+		}
+		if models.G(policyCont, "name") == name {
+			return policyCont, i, nil
+		}
+	}
+	return nil, -1, nil
+}
+
+// patchTemplatePolicy adds, replaces or removes a policy in the objectKey list of a template.
+// A policy is added with the list itself when the template does not have the list yet.
+// The caller must hold the lock of lockTemplatePolicies from the GET of the index until the PATCH is sent.
+func patchTemplatePolicy(msoClient *client.Client, templateId, templateType, objectKey, op string, index int, value interface{}) error {
+	path := fmt.Sprintf("/%s/template/%s/%d", templateType, objectKey, index)
+	if op == "add" {
+		templateCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+		if err != nil {
+			return err
+		}
+		if _, err := templateCont.ArrayCount(templateType, "template", objectKey); err != nil {
+			path = fmt.Sprintf("/%s/template/%s", templateType, objectKey)
+			value = []interface{}{value}
+		} else {
+			path = fmt.Sprintf("/%s/template/%s/-", templateType, objectKey)
+		}
+	}
+
+	payloadCon := container.New()
+	payloadCon.Array()
+	err := addPatchPayloadToContainer(payloadCon, op, path, value)
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/templates/%s", templateId), payloadCon)
+}
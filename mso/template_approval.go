@@ -0,0 +1,80 @@
+package mso
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+)
+
+// Approval states of a template version in the change control workflow of NDO.
+const (
+	templateApprovalApproved = "approved"
+	templateApprovalDenied   = "denied"
+)
+
+// templateApprovalPollInterval is the time between the status requests while waiting for the approval of a template.
+var templateApprovalPollInterval = 10 * time.Second
+
+// checkTemplateApprovalSupport returns an error when the NDO version does not support the change control workflow.
+func checkTemplateApprovalSupport(msoClient *client.Client) error {
+	versionInt, err := msoClient.CompareVersion("4.1.0.0")
+	if err != nil {
+		return err
+	}
+	if versionInt == 1 {
+		return fmt.Errorf("The approval workflow of templates is only supported on NDO version 4.1 or higher.")
+	}
+	return nil
+}
+
+// sendTemplateApprovalAction submits the current version of the template for approval, or approves or denies it.
+func sendTemplateApprovalAction(msoClient *client.Client, schemaId, templateName, action, comment string) error {
+	payload := container.New()
+	payload.Set(action, "action")
+	if comment != "" {
+		payload.Set(comment, "comment")
+	}
+	_, _, err := doRequestWithContext(getStopContext(msoClient), msoClient, "POST", fmt.Sprintf("api/v1/schemas/%s/templates/%s/approval", schemaId, templateName), payload)
+	return err
+}
+
+// getTemplateApprovalState returns the approval state of the current version of the template, which is empty when the template was never submitted.
+func getTemplateApprovalState(msoClient *client.Client, schemaId, templateName string) (string, error) {
+	cont, err := getSchemaCont(msoClient, schemaId)
+	if err != nil {
+		return "", err
+	}
+	templates, _ := cont.S("templates").Data().([]interface{})
+	for _, template := range templates {
+		if templateMap, ok := template.(map[string]interface{}); ok && templateMap["name"] == templateName {
+			return strings.ToLower(convertInterfaceToString(templateMap["approvalState"])), nil
+		}
+	}
+	return "", fmt.Errorf("Template %s not found in schema %s", templateName, schemaId)
+}
+
+// waitForTemplateApproval polls the approval state of the template until it is approved.
+// It returns an error when the template is denied or the context is done before the approval.
+func waitForTemplateApproval(ctx context.Context, msoClient *client.Client, schemaId, templateName string) error {
+	for {
+		state, err := getTemplateApprovalState(msoClient, schemaId, templateName)
+		if err != nil {
+			return err
+		}
+		log.Printf("[DEBUG] Approval state of template %s in schema %s: %s", templateName, schemaId, state)
+		if state == templateApprovalApproved {
+			return nil
+		} else if state == templateApprovalDenied {
+			return fmt.Errorf("The deployment of template %s in schema %s is blocked, the template is denied", templateName, schemaId)
+		}
+		err = sleepWithContext(ctx, templateApprovalPollInterval, fmt.Sprintf("waiting for the approval of template %s in schema %s, last state: %s", templateName, schemaId, state))
+		if err != nil {
+			return err
+		}
+	}
+}
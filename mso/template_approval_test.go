@@ -0,0 +1,48 @@
+package mso
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func setMockTemplateApprovalState(state string) {
+	object, _ := mockServer.Object("api/v1/schemas/" + mockSchemaId)
+	schemaMap := object.(map[string]interface{})
+	schemaMap["templates"].([]interface{})[0].(map[string]interface{})["approvalState"] = state
+	mockServer.SetObject("api/v1/schemas/"+mockSchemaId, schemaMap)
+}
+
+func TestMockNDOTemplateApproval(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	defer func(interval time.Duration) { templateApprovalPollInterval = interval }(templateApprovalPollInterval)
+	templateApprovalPollInterval = time.Millisecond
+
+	err := sendTemplateApprovalAction(msoClient, mockSchemaId, "Template1", "approve", "Looks good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := server.Requests()[0]
+	body, _ := request.Body.(map[string]interface{})
+	if request.Method != http.MethodPost || request.Path != "api/v1/schemas/"+mockSchemaId+"/templates/Template1/approval" || body["action"] != "approve" || body["comment"] != "Looks good" {
+		t.Errorf("Unexpected approval request %s %s %v", request.Method, request.Path, request.Body)
+	}
+
+	setMockTemplateApprovalState("Approved")
+	if err := waitForTemplateApproval(context.Background(), msoClient, mockSchemaId, "Template1"); err != nil {
+		t.Errorf("Expected the approved template not to block, got %v", err)
+	}
+
+	setMockTemplateApprovalState("Denied")
+	if err := waitForTemplateApproval(context.Background(), msoClient, mockSchemaId, "Template1"); err == nil {
+		t.Error("Expected the denied template to block the deployment")
+	}
+
+	setMockTemplateApprovalState("PendingApproval")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := waitForTemplateApproval(ctx, msoClient, mockSchemaId, "Template1"); err == nil {
+		t.Error("Expected a timeout for the template pending approval")
+	}
+}
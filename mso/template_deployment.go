@@ -0,0 +1,114 @@
+package mso
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// readDeployedObject verifies within the read timeout that the deployed schema or template at path still exists,
+// the deployment is removed from the state when it does not.
+func readDeployedObject(d *schema.ResourceData, msoClient *client.Client, path string) error {
+	ctx, cancel := context.WithTimeout(getStopContext(msoClient), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+	cont, _, err := doRequestWithContext(ctx, msoClient, "GET", path, nil)
+	return errorForObjectNotFound(err, d.Id(), cont, d)
+}
+
+// undeployTemplateFromSite undeploys the template of the schema from the site.
+// On NDO version 3.7 and higher it waits until the undeploy task completed, so the site is only disassociated once its objects are removed.
+// When the version can not be retrieved the template is not undeployed, so the destroy is not blocked by the version lookup.
+func undeployTemplateFromSite(ctx context.Context, msoClient *client.Client, schemaId, templateName, siteId string) error {
+	versionInt, err := msoClient.CompareVersion("3.7.0.0")
+	if err != nil {
+		log.Printf("[WARNING] Failed to compare version. Template could not be undeployed prior to schema site deletion. Err: %s.", err)
+		return nil
+	}
+
+	if versionInt <= 0 {
+		payload, err := container.ParseJSON([]byte(fmt.Sprintf(`{"schemaId": "%s", "templateName": "%s", "undeploy": ["%s"]}`, schemaId, templateName, siteId)))
+		if err != nil {
+			log.Printf("[DEBUG] Parse of JSON failed with err: %s.", err)
+			return err
+		}
+		taskCont, resp, err := doRequestWithContext(ctx, msoClient, "POST", "api/v1/task", payload)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != 202 {
+			return fmt.Errorf("Unable to undeploy template %s from site %s, status code %d", templateName, siteId, resp.StatusCode)
+		}
+		_, err = waitForTask(ctx, msoClient, models.StripQuotes(taskCont.S("id").String()))
+		if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("Unable to undeploy template %s from site %s: %s", templateName, siteId, err)
+		}
+		return err
+	}
+
+	_, err = msoClient.GetViaURL(fmt.Sprintf("/api/v1/execute/schema/%s/template/%s?undeploy=%s", schemaId, templateName, siteId))
+	return err
+}
+
+// getTemplateDeployedSites returns the ids of the sites to which the template of the schema is deployed.
+// Versions without the deployment status endpoint return no sites, so the destroy is not blocked on them.
+func getTemplateDeployedSites(msoClient *client.Client, schemaId, templateName string) ([]string, error) {
+	statusCont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/deploy/status/schema/%s/template/%s", schemaId, templateName))
+	if err != nil {
+		if statusCont != nil && statusCont.S("code").String() == "404" {
+			log.Printf("[WARN] Deployment status of template %s in schema %s is not available: %s", templateName, schemaId, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	siteIds := make([]string, 0)
+	count, _ := statusCont.ArrayCount("status")
+	for i := 0; i < count; i++ {
+		siteCont, err := statusCont.ArrayElement(i, "status")
+		if err != nil {
+			return nil, err
+		}
+		status := strings.ToLower(convertInterfaceToString(siteCont.S("status").Data()))
+		if status == "" || status == "notdeployed" || status == "undeployed" {
+			continue
+		}
+		siteIds = append(siteIds, models.StripQuotes(siteCont.S("siteId").String()))
+	}
+	sort.Strings(siteIds)
+	return siteIds, nil
+}
+
+// undeployOrProtectTemplates undeploys the templates of the schema from all sites when undeploy is set.
+// Otherwise it returns an error when a template is still deployed, because NDO leaves the deployed objects on the sites when the template is removed.
+func undeployOrProtectTemplates(ctx context.Context, msoClient *client.Client, schemaId string, templateNames []string, undeploy bool) error {
+	deployed := make([]string, 0)
+	for _, templateName := range templateNames {
+		siteIds, err := getTemplateDeployedSites(msoClient, schemaId, templateName)
+		if err != nil {
+			return err
+		}
+		for _, siteId := range siteIds {
+			if !undeploy {
+				deployed = append(deployed, fmt.Sprintf("template %s to site %s", templateName, siteId))
+				continue
+			}
+			log.Printf("[DEBUG] Undeploying template %s of schema %s from site %s", templateName, schemaId, siteId)
+			err = undeployTemplateFromSite(ctx, msoClient, schemaId, templateName, siteId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(deployed) > 0 {
+		return fmt.Errorf("Schema %s is still deployed (%s). Undeploy the templates or set undeploy_on_destroy to true to undeploy them before the destroy.", schemaId, strings.Join(deployed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package mso
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testSchemaTemplateResourceData(t *testing.T, undeploy bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplate().Schema, map[string]interface{}{
+		"schema_id":           mockSchemaId,
+		"tenant_id":           "5c4d9fca270000a101f8094a",
+		"name":                "Template1",
+		"display_name":        "Template 1",
+		"undeploy_on_destroy": undeploy,
+	})
+	d.SetId(mockSchemaId + "/templates/Template1")
+	return d
+}
+
+func TestSchemaTemplateDeleteDeployed(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/deploy/status/schema/"+mockSchemaId+"/template/Template1", map[string]interface{}{
+		"status": []interface{}{
+			map[string]interface{}{"siteId": "5c7c95b25100008f01c1ee3c", "status": "deployed"},
+			map[string]interface{}{"siteId": "5c7c95b25100008f01c1ee3d", "status": "notDeployed"},
+		},
+	})
+
+	err := resourceMSOSchemaTemplateDelete(testSchemaTemplateResourceData(t, false), msoClient)
+	if err == nil || !strings.Contains(err.Error(), "template Template1 to site 5c7c95b25100008f01c1ee3c") {
+		t.Fatalf("Expected an error for the deployed template, got %v", err)
+	}
+	if strings.Contains(err.Error(), "5c7c95b25100008f01c1ee3d") {
+		t.Errorf("Expected only the deployed site in the error, got %v", err)
+	}
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			t.Errorf("Expected the template not to be removed, got a PATCH request to %s", request.Path)
+		}
+	}
+}
+
+func TestSchemaTemplateDeleteUndeploy(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.SetObject("api/v1/deploy/status/schema/"+mockSchemaId+"/template/Template1", map[string]interface{}{
+		"status": []interface{}{
+			map[string]interface{}{"siteId": "5c7c95b25100008f01c1ee3c", "status": "deployed"},
+		},
+	})
+
+	d := testSchemaTemplateResourceData(t, true)
+	err := resourceMSOSchemaTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var undeployed, removed bool
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "api/v1/task" {
+			body := request.Body.(map[string]interface{})
+			undeployed = body["templateName"] == "Template1" && len(body["undeploy"].([]interface{})) == 1
+		}
+		if request.Method == http.MethodPatch {
+			if !undeployed {
+				t.Error("Expected the template to be undeployed before it is removed")
+			}
+			removed = true
+		}
+	}
+	if !undeployed || !removed {
+		t.Errorf("Expected the template to be undeployed and removed, undeployed: %v, removed: %v", undeployed, removed)
+	}
+}
+
+func TestSchemaTemplateDeleteWithoutDeploymentStatus(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+
+	d := testSchemaTemplateResourceData(t, false)
+	err := resourceMSOSchemaTemplateDelete(d, msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the template to be removed from state, got id %s", d.Id())
+	}
+}
+
+func testSchemaSiteResourceData(t *testing.T, undeploy bool) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaSite().Schema, map[string]interface{}{
+		"schema_id":           mockSchemaId,
+		"template_name":       "Template1",
+		"site_id":             "5c7c95b25100008f01c1ee3c",
+		"undeploy_on_destroy": undeploy,
+	})
+	d.SetId("5c7c95b25100008f01c1ee3c")
+	return d
+}
+
+func TestSchemaSiteDeleteUndeploy(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+
+	err := resourceMSOSchemaSiteDelete(testSchemaSiteResourceData(t, true), msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var undeployed, waited, removed bool
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "api/v1/task" {
+			body := request.Body.(map[string]interface{})
+			undeployed = body["templateName"] == "Template1" && len(body["undeploy"].([]interface{})) == 1
+		}
+		if request.Method == http.MethodGet && strings.HasPrefix(request.Path, "api/v1/task/") {
+			waited = undeployed
+		}
+		if request.Method == http.MethodPatch {
+			if !waited {
+				t.Error("Expected the undeploy task to complete before the site is disassociated")
+			}
+			removed = true
+		}
+	}
+	if !removed {
+		t.Error("Expected the site to be disassociated")
+	}
+}
+
+func TestSchemaSiteDeleteUndeployFailed(t *testing.T) {
+	server, msoClient := testMockNDO(t)
+	server.Fail(http.MethodPost, "api/v1/task", http.StatusBadRequest, map[string]interface{}{"code": 400, "message": "Invalid template"})
+
+	err := resourceMSOSchemaSiteDelete(testSchemaSiteResourceData(t, true), msoClient)
+	if err == nil {
+		t.Fatal("Expected an error for the failed undeploy")
+	}
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPatch {
+			t.Errorf("Expected the site not to be disassociated, got a PATCH request to %s", request.Path)
+		}
+	}
+}
+
+func TestSchemaSiteDeleteUndeployVersionUnknown(t *testing.T) {
+	server, _ := testMockNDO(t)
+	// The version of the client can not be parsed, so the comparison with 3.7 fails like a failed version lookup.
+	msoClient := client.NewClient(server.URL, "admin", client.Password("password"), client.Insecure(true), client.Version("invalid"))
+
+	err := resourceMSOSchemaSiteDelete(testSchemaSiteResourceData(t, true), msoClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	removed := false
+	for _, request := range server.Requests() {
+		if request.Method == http.MethodPost && request.Path == "api/v1/task" {
+			t.Error("Expected the template not to be undeployed when the version is unknown")
+		}
+		if request.Method == http.MethodPatch {
+			removed = true
+		}
+	}
+	if !removed {
+		t.Error("Expected the site to be disassociated when the version is unknown")
+	}
+}
+
+func TestSchemaTemplateDeployReadRemovedSchema(t *testing.T) {
+	_, msoClient := testMockNDO(t)
+	d := schema.TestResourceDataRaw(t, resourceMSOSchemaTemplateDeploy().Schema, map[string]interface{}{
+		"schema_id":     mockSchemaId,
+		"template_name": "Template1",
+	})
+	d.SetId("Template1")
+
+	if err := resourceMSOSchemaTemplateDeployRead(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "Template1" {
+		t.Errorf("Expected the deployment of an existing schema to be kept, got id %q", d.Id())
+	}
+
+	d.Set("schema_id", "5c4d5bb72700000401f80949")
+	if err := resourceMSOSchemaTemplateDeployRead(d, msoClient); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("Expected the deployment of a removed schema to be removed from the state, got id %q", d.Id())
+	}
+}
@@ -0,0 +1,296 @@
+package mso
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// templatePolicyObject describes the policies of a template policy resource, which are the named objects of a list of the template.
+type templatePolicyObject struct {
+	// label names the policy in errors and logs.
+	label string
+	// templateType and objectKey are the template content and its list which hold the policies.
+	templateType, objectKey string
+	// idSegment is the segment of the ID of the resource before the name of the policy.
+	idSegment string
+	// single is set for the policies of which a template holds one object instead of a list, like the PTP policy.
+	single  bool
+	payload func(d *schema.ResourceData) map[string]interface{}
+	set     func(d *schema.ResourceData, policyCont *container.Container)
+	// computed optionally sets the attributes which are not stored in the policy, like the DN of a service device cluster.
+	computed func(d *schema.ResourceData, msoClient *client.Client, templateCont *container.Container) error
+}
+
+func (p templatePolicyObject) id(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/%s/%s", d.Get("template_id").(string), p.idSegment, d.Get("name").(string))
+}
+
+// find returns the policy with the name of the resource in the template, and its index in the list.
+// The index is -1 when the policy is not found.
+func (p templatePolicyObject) find(d *schema.ResourceData, cont *container.Container) (*container.Container, int, error) {
+	if p.single {
+		policyCont := cont.S(p.templateType, "template", p.objectKey)
+		if policyCont.Data() == nil || models.G(policyCont, "name") != d.Get("name").(string) {
+			return nil, -1, nil
+		}
+		return policyCont, 0, nil
+	}
+	return getTemplatePolicy(cont, p.templateType, p.objectKey, d.Get("name").(string))
+}
+
+// patch adds, replaces or removes the policy at index in the template.
+func (p templatePolicyObject) patch(msoClient *client.Client, templateId, op string, index int, value interface{}) error {
+	if !p.single {
+		return patchTemplatePolicy(msoClient, templateId, p.templateType, p.objectKey, op, index, value)
+	}
+	payloadCon := container.New()
+	payloadCon.Array()
+	err := addPatchPayloadToContainer(payloadCon, op, fmt.Sprintf("/%s/template/%s", p.templateType, p.objectKey), value)
+	if err != nil {
+		return err
+	}
+	return doPatchRequest(msoClient, fmt.Sprintf("api/v1/templates/%s", templateId), payloadCon)
+}
+
+// policyPayload returns the payload of the policy with its name and description.
+func (p templatePolicyObject) policyPayload(d *schema.ResourceData) map[string]interface{} {
+	policy := p.payload(d)
+	policy["name"] = d.Get("name").(string)
+	policy["description"] = d.Get("description").(string)
+	return policy
+}
+
+func (p templatePolicyObject) importState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] %s: Beginning Import", d.Id())
+
+	get_attribute := strings.Split(d.Id(), "/")
+	if len(get_attribute) != 3 || get_attribute[1] != p.idSegment {
+		return nil, fmt.Errorf("Invalid import ID %s, expected {template_id}/%s/{name}", d.Id(), p.idSegment)
+	}
+	d.Set("template_id", get_attribute[0])
+	d.Set("name", get_attribute[2])
+
+	err := p.read(d, m)
+	if err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("%s %s not found in template %s", p.label, get_attribute[2], get_attribute[0])
+	}
+
+	log.Printf("[DEBUG] %s: Import finished successfully", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func (p templatePolicyObject) create(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Create", p.label)
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+	defer lockTemplatePolicies(templateId)()
+
+	if p.single {
+		cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+		if err != nil {
+			return err
+		}
+		if existing := cont.S(p.templateType, "template", p.objectKey).Data(); existing != nil {
+			return fmt.Errorf("Template %s already has a %s", templateId, p.label)
+		}
+	}
+
+	err := p.patch(msoClient, templateId, "add", -1, p.policyPayload(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(p.id(d))
+	log.Printf("[DEBUG] %s: Create finished successfully", d.Id())
+	return p.read(d, m)
+}
+
+func (p templatePolicyObject) read(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Read", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	policyCont, _, err := p.find(d, cont)
+	if err != nil {
+		return err
+	}
+	if policyCont == nil {
+		log.Printf("[WARN] %s not found, removing from state: %s", p.label, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(p.id(d))
+	d.Set("name", models.StripQuotes(policyCont.S("name").String()))
+	d.Set("description", convertInterfaceToString(policyCont.S("description").Data()))
+	d.Set("uuid", convertInterfaceToString(policyCont.S("uuid").Data()))
+	p.set(d, policyCont)
+	if p.computed != nil {
+		err = p.computed(d, msoClient, cont)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Read finished successfully", d.Id())
+	return nil
+}
+
+func (p templatePolicyObject) update(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Update", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+	defer lockTemplatePolicies(templateId)()
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return err
+	}
+	policyCont, index, err := p.find(d, cont)
+	if err != nil {
+		return err
+	}
+	if policyCont == nil {
+		return fmt.Errorf("%s %s not found in template %s", p.label, d.Get("name").(string), templateId)
+	}
+
+	policy := p.policyPayload(d)
+	policy["uuid"] = models.StripQuotes(policyCont.S("uuid").String())
+	err = p.patch(msoClient, templateId, "replace", index, policy)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] %s: Update finished successfully", d.Id())
+	return p.read(d, m)
+}
+
+func (p templatePolicyObject) delete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] %s: Beginning Destroy", d.Id())
+
+	msoClient := m.(*client.Client)
+	templateId := d.Get("template_id").(string)
+	defer lockTemplatePolicies(templateId)()
+
+	cont, err := msoClient.GetViaURL(fmt.Sprintf("api/v1/templates/%s", templateId))
+	if err != nil {
+		return errorForObjectNotFound(err, d.Id(), cont, d)
+	}
+	_, index, err := p.find(d, cont)
+	if err != nil {
+		return err
+	}
+	if index != -1 {
+		err = p.patch(msoClient, templateId, "remove", index, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] %s: Destroy finished successfully", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// resource returns the resource of the template policy, with the template_id, name, description and uuid attributes added to the schema.
+func (p templatePolicyObject) resource(policySchema map[string]*schema.Schema) *schema.Resource {
+	policySchema["template_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 1000),
+	}
+	policySchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringLenBetween(1, 64),
+	}
+	policySchema["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	policySchema["uuid"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Create: p.create,
+		Read:   p.read,
+		Update: p.update,
+		Delete: p.delete,
+
+		Importer: &schema.ResourceImporter{
+			State: p.importState,
+		},
+
+		SchemaVersion: version,
+
+		Schema: policySchema,
+	}
+}
+
+// templatePolicyAdminStateSchema returns the schema of the admin_state attribute of a template policy.
+func templatePolicyAdminStateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "enabled",
+		ValidateFunc: validation.StringInSlice([]string{
+			"enabled",
+			"disabled",
+		}, false),
+	}
+}
+
+// templatePolicyManagementEpgSchema returns the schema of the management_epg attribute of the servers of a template policy.
+func templatePolicyManagementEpgSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "oob",
+		ValidateFunc: validation.StringInSlice([]string{
+			"oob",
+			"inb",
+		}, false),
+	}
+}
+
+// getTemplatePolicyList returns the maps of the list at key of the policy.
+func getTemplatePolicyList(policyCont *container.Container, key string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+	list, _ := policyCont.S(key).Data().([]interface{})
+	for _, object := range list {
+		if objectMap, ok := object.(map[string]interface{}); ok {
+			objects = append(objects, objectMap)
+		}
+	}
+	return objects
+}
+
+// setTemplatePolicyString sets the attribute to the string at the path of the policy, when the policy has it.
+// Attributes of settings which are not returned keep their configured or default value.
+func setTemplatePolicyString(d *schema.ResourceData, policyCont *container.Container, attribute string, path ...string) {
+	if policyCont.Exists(path...) {
+		d.Set(attribute, convertInterfaceToString(policyCont.S(path...).Data()))
+	}
+}
@@ -0,0 +1,48 @@
+package mso
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+)
+
+// loadTLSCertificates returns the pool of the CA certificates of the file and PEM content and the client certificate of the key pair files.
+// The pool is nil when no CA is provided, so the system roots are used, and the certificates are empty when no client certificate is provided.
+func loadTLSCertificates(caCertFile, caCertPem, clientCertFile, clientKeyFile string) (*x509.CertPool, []tls.Certificate, error) {
+	var rootCAs *x509.CertPool
+	caCerts := []byte(caCertPem)
+	if caCertFile != "" {
+		content, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Unable to read the CA certificate file: %s", err)
+		}
+		caCerts = append(append(caCerts, '\n'), content...)
+	}
+	if caCertFile != "" || caCertPem != "" {
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(caCerts) {
+			return nil, nil, fmt.Errorf("No PEM encoded certificate found in the CA certificates")
+		}
+	}
+
+	certificates := make([]tls.Certificate, 0)
+	if (clientCertFile == "") != (clientKeyFile == "") {
+		return nil, nil, fmt.Errorf("client_cert_file and client_key_file must be provided together")
+	}
+	if clientCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Unable to load the client certificate: %s", err)
+		}
+		certificates = append(certificates, certificate)
+	}
+	return rootCAs, certificates, nil
+}
+
+// configureClientTLSCertificates sets the CA certificates and client certificates on the client.
+func configureClientTLSCertificates(msoClient *client.Client, rootCAs *x509.CertPool, certificates []tls.Certificate) error {
+	return msoClient.SetTLSCertificates(rootCAs, certificates)
+}
@@ -0,0 +1,29 @@
+package mso
+
+import "testing"
+
+func TestLoadTLSCertificates(t *testing.T) {
+	rootCAs, certificates, err := loadTLSCertificates("", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rootCAs != nil || len(certificates) != 0 {
+		t.Errorf("Expected the system roots and no client certificate, got %v and %d certificates", rootCAs, len(certificates))
+	}
+
+	tests := []struct {
+		caCertFile, caCertPem, clientCertFile, clientKeyFile string
+	}{
+		{"", "not a certificate", "", ""},
+		{"/nonexistent/ca.pem", "", "", ""},
+		{"", "", "client.pem", ""},
+		{"", "", "", "client.key"},
+		{"", "", "/nonexistent/client.pem", "/nonexistent/client.key"},
+	}
+	for _, test := range tests {
+		_, _, err := loadTLSCertificates(test.caCertFile, test.caCertPem, test.clientCertFile, test.clientKeyFile)
+		if err == nil {
+			t.Errorf("Expected an error for %+v", test)
+		}
+	}
+}
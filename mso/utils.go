@@ -1,9 +1,14 @@
 package mso
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/ciscoecosystem/mso-go-client/client"
@@ -23,9 +28,15 @@ func toStringList(configured interface{}) []string {
 	return vs
 }
 
+// objectNotFoundRegex matches the whole error messages of the lookups of the resources, which indicate that the object, or one of its parents, no longer exists.
+// Messages which only contain "not found", like the errors of NDO for a reference in a payload, do not match.
+var objectNotFoundRegex = regexp.MustCompile(`(?i)^(no .+ found|unable to get .+ list|unable to find .+|.+ not found( from api| (in|on) (the )?(schema|template|site)\b[^,]*)?)$`)
+
+// errorForObjectNotFound removes the resource from the state when the error indicates that the object was deleted outside of Terraform.
+// The container is the API response that resulted in the error and can be nil when the object was looked up by a helper function.
 func errorForObjectNotFound(err error, dn string, con *container.Container, d *schema.ResourceData) error {
 	if err != nil {
-		if con.S("code").String() == "404" || strings.HasSuffix(err.Error(), "not found") || strings.HasSuffix(models.StripQuotes(con.S("error").String()), "no documents in result") {
+		if isObjectNotFound(err, con) {
 			log.Printf("[WARN] %s, removing from state: %s", err, dn)
 			d.SetId("")
 			return nil
@@ -36,6 +47,12 @@ func errorForObjectNotFound(err error, dn string, con *container.Container, d *s
 	return nil
 }
 
+// isObjectNotFound returns whether the error of a request and its response report that the object does not exist.
+func isObjectNotFound(err error, con *container.Container) bool {
+	notFoundResponse := con != nil && (con.S("code").String() == "404" || strings.HasSuffix(models.StripQuotes(con.S("error").String()), "no documents in result"))
+	return notFoundResponse || objectNotFoundRegex.MatchString(strings.TrimSuffix(strings.TrimSpace(err.Error()), "."))
+}
+
 // extractServiceGraphNodesFromContainer extracts the nodes from the given container.
 //
 // Parameters:
@@ -179,6 +196,32 @@ func getPathFromId(id string) string {
 	return fmt.Sprintf("/%s", strings.Join(strings.Split(id, "/")[1:], "/"))
 }
 
+// abandonOnDestroy removes the resource from the state only when abandon_on_destroy is set and returns whether it did.
+// The object then stays in the template and on the sites, NDO has no way to remove an object from a template without removing it from the sites on the next deploy.
+func abandonOnDestroy(d *schema.ResourceData) bool {
+	if !d.Get("abandon_on_destroy").(bool) {
+		return false
+	}
+	log.Printf("[DEBUG] %s: Abandoning the object, removing from state only because abandon_on_destroy is set", d.Id())
+	d.SetId("")
+	return true
+}
+
+// addQueryFilters returns the path with the non empty filters as query parameters, so NDO only returns the matching objects of a collection.
+// Callers still filter the returned objects, because older versions ignore the query parameters and return the whole collection.
+func addQueryFilters(path string, filters map[string]string) string {
+	query := url.Values{}
+	for key, value := range filters {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if len(query) == 0 {
+		return path
+	}
+	return fmt.Sprintf("%s?%s", path, query.Encode())
+}
+
 func addPatchPayloadToContainer(payloadContainer *container.Container, op, path string, value interface{}) error {
 
 	payloadMap := map[string]interface{}{"op": op, "path": path, "value": value}
@@ -209,6 +252,7 @@ func doPatchRequest(msoClient *client.Client, path string, payloadCon *container
 	}
 
 	cont, _, err := msoClient.Do(req)
+	invalidateSchemaCache(msoClient, path)
 	if err != nil {
 		return err
 	}
@@ -218,5 +262,71 @@ func doPatchRequest(msoClient *client.Client, path string, payloadCon *container
 		return err
 	}
 
+	if getProviderSettings(msoClient).verifyWrites {
+		operations, _ := payloadCon.Data().([]interface{})
+		return verifyPatchOperations(msoClient, path, operations)
+	}
+
 	return nil
 }
+
+// doRequestWithContext sends a request and aborts it when the context is cancelled or its deadline is exceeded.
+// It is used by long-running operations which are bounded by the resource timeouts.
+func doRequestWithContext(ctx context.Context, msoClient *client.Client, method, path string, payloadCon *container.Container) (*container.Container, *http.Response, error) {
+
+	req, err := msoClient.MakeRestRequest(method, path, payloadCon, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cont, resp, err := msoClient.Do(req.WithContext(ctx))
+	if method != "GET" {
+		invalidateSchemaCache(msoClient, path)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, resp, contextDoneError(ctx, fmt.Sprintf("waiting for %s %s to complete", method, path))
+		}
+		return nil, resp, err
+	}
+
+	if cont != nil {
+		err = client.CheckForErrors(cont, method)
+		if err != nil {
+			return cont, resp, err
+		}
+	}
+
+	return cont, resp, nil
+}
+
+// upgradeListToSetState removes the duplicate values of attributes which are converted from a list to a set,
+// so that the state written by an older schema version can be decoded with the new schema.
+func upgradeListToSetState(rawState map[string]interface{}, attributes ...string) map[string]interface{} {
+	for _, attribute := range attributes {
+		values, ok := rawState[attribute].([]interface{})
+		if !ok {
+			continue
+		}
+		uniqueValues := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			duplicate := false
+			for _, uniqueValue := range uniqueValues {
+				if reflect.DeepEqual(value, uniqueValue) {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				uniqueValues = append(uniqueValues, value)
+			}
+		}
+		rawState[attribute] = uniqueValues
+	}
+	return rawState
+}
+
+// suppressMacAddressDiff suppresses the diff between MAC addresses which only differ in case, NDO returns them in upper case.
+func suppressMacAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
@@ -0,0 +1,60 @@
+package mso
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestAddQueryFilters(t *testing.T) {
+	tests := []struct {
+		filters  map[string]string
+		expected string
+	}{
+		{nil, "api/v1/labels"},
+		{map[string]string{"type": ""}, "api/v1/labels"},
+		{map[string]string{"displayName": "label 1", "type": "site"}, "api/v1/labels?displayName=label+1&type=site"},
+	}
+	for _, test := range tests {
+		if path := addQueryFilters("api/v1/labels", test.filters); path != test.expected {
+			t.Errorf("addQueryFilters(%v) = %s, expected %s", test.filters, path, test.expected)
+		}
+	}
+}
+
+func TestErrorForObjectNotFound(t *testing.T) {
+	tests := []struct {
+		err      string
+		response map[string]interface{}
+		notFound bool
+	}{
+		{"No Template found", nil, true},
+		{"Unable to get Anp list", nil, true},
+		{"Unable to find the BD BD1 in Template Template1 of Schema Id 1 ", nil, true},
+		{"Schema 1 not found", nil, true},
+		{"BD not found from API", nil, true},
+		{"Template Template1 is not found in Schema.", nil, true},
+		{"SR-MPLS L3Out L3Out1 not found in the Site Vrf VRF1", nil, true},
+		{"Hub network Hub1 not found on site Site1", nil, true},
+		{"object not found", map[string]interface{}{"code": 404}, true},
+		{"Bad Request: VRF reference not found in the payload, check the configuration", nil, false},
+		{"The device_dn uni/tn-t1 of service_node.0 is not found in site Site1 for tenant t1, expected one of [uni/tn-t2].", nil, false},
+		{"The created object is not found in api/v1/schemas/1 after 3 attempts", nil, false},
+		{"Unable to get the version", nil, false},
+		{"Internal Server Error", map[string]interface{}{"code": 500}, false},
+	}
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+		d.SetId("object1")
+		var con *container.Container
+		if test.response != nil {
+			con, _ = container.Consume(test.response)
+		}
+		err := errorForObjectNotFound(errors.New(test.err), d.Id(), con, d)
+		if notFound := err == nil && d.Id() == ""; notFound != test.notFound {
+			t.Errorf("errorForObjectNotFound(%q) removed the object: %t, expected %t", test.err, notFound, test.notFound)
+		}
+	}
+}
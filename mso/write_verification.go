@@ -0,0 +1,288 @@
+package mso
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/client"
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+// writeVerification holds the number of reads and the delay between them of the read-after-write verification of PATCH requests.
+// The verification is enabled per provider configuration with verify_writes.
+var writeVerification = struct {
+	attempts int
+	delay    time.Duration
+}{attempts: 5, delay: time.Second}
+
+// patchbyID sends the PATCH request and verifies that the changes are visible when write verification is enabled.
+func patchbyID(msoClient *client.Client, endpoint string, objList ...models.Model) (*container.Container, error) {
+	cont, err := msoClient.PatchbyID(endpoint, objList...)
+	invalidateSchemaCache(msoClient, endpoint)
+	if err != nil || !getProviderSettings(msoClient).verifyWrites {
+		return cont, err
+	}
+
+	operations := make([]interface{}, 0, len(objList))
+	for _, obj := range objList {
+		operation, err := obj.ToMap()
+		if err != nil {
+			return cont, err
+		}
+		operations = append(operations, operation)
+	}
+	return cont, verifyPatchOperations(msoClient, endpoint, operations)
+}
+
+// verifyPatchOperations retrieves the object of the endpoint until all operations are visible or the attempts are exhausted.
+// Only schemas and templates are verified, because their PATCH paths can be resolved against the retrieved object.
+func verifyPatchOperations(msoClient *client.Client, endpoint string, operations []interface{}) error {
+	if !strings.HasPrefix(endpoint, "api/v1/schemas/") && !strings.HasPrefix(endpoint, "api/v1/templates/") {
+		return nil
+	}
+
+	var pending string
+	for attempt := 1; attempt <= writeVerification.attempts; attempt++ {
+		cont, err := msoClient.GetViaURL(endpoint)
+		if err != nil {
+			pending = err.Error()
+		} else {
+			pending = ""
+			for _, operation := range operations {
+				operationMap, ok := operation.(map[string]interface{})
+				if ok && !isPatchOperationVisible(cont.Data(), operationMap) {
+					pending = fmt.Sprintf("%v %v", operationMap["op"], operationMap["path"])
+					break
+				}
+			}
+			if pending == "" {
+				return nil
+			}
+		}
+		log.Printf("[DEBUG] Change to %s is not visible yet (attempt %d of %d): %s", endpoint, attempt, writeVerification.attempts, pending)
+		if attempt < writeVerification.attempts {
+			err = sleepWithContext(getStopContext(msoClient), writeVerification.delay, fmt.Sprintf("verifying the change to %s", endpoint))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return fmt.Errorf("The change to %s is not visible after %d attempts: %s", endpoint, writeVerification.attempts, pending)
+}
+
+// isPatchOperationVisible returns whether the result of a PATCH operation is reflected in the object data.
+// Added and replaced paths must exist with the value of the operation and removed paths must no longer exist.
+// Removes by list index are not verified, because the next element of the list moves to the removed index.
+func isPatchOperationVisible(data interface{}, operation map[string]interface{}) bool {
+	path, _ := operation["path"].(string)
+	segments := splitPatchPath(path)
+	last := segments[len(segments)-1]
+
+	if operation["op"] == "remove" {
+		if _, err := strconv.Atoi(last); err == nil {
+			return true
+		}
+		return !resolvePatchPath(data, segments)
+	}
+
+	expected, hasValue := operation["value"]
+	if hasValue {
+		expected = normalizePatchValue(expected)
+	}
+	if last == "-" {
+		list, ok := getPatchPathValue(data, segments[:len(segments)-1])
+		if !ok || !hasValue {
+			return ok
+		}
+		elements, _ := list.([]interface{})
+		for _, element := range elements {
+			if containsPatchValue(element, expected) {
+				return true
+			}
+		}
+		return false
+	} else if name, ok := expected.(string); ok && last == "name" && len(segments) > 1 {
+		// A rename changes the segment by which the object itself is addressed.
+		segments[len(segments)-2] = name
+	}
+	value, ok := getPatchPathValue(data, segments)
+	return ok && (!hasValue || containsPatchValue(value, expected))
+}
+
+// normalizePatchValue returns the value of an operation as it is decoded from JSON, so it can be compared with the retrieved object.
+func normalizePatchValue(value interface{}) interface{} {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(content, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+// containsPatchValue returns whether the retrieved value contains the value of an operation.
+// Objects only need to contain the properties of the operation, because NDO adds properties like uuids and omits empty ones.
+// Lists are compared regardless of their order.
+func containsPatchValue(value, expected interface{}) bool {
+	switch expectedValue := expected.(type) {
+	case map[string]interface{}:
+		valueMap, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, expectedProperty := range expectedValue {
+			property, ok := valueMap[key]
+			if !ok && isEmptyPatchValue(expectedProperty) {
+				continue
+			}
+			if !containsPatchValue(property, expectedProperty) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		values, ok := value.([]interface{})
+		if !ok {
+			return value == nil && len(expectedValue) == 0
+		}
+		if len(values) != len(expectedValue) {
+			return false
+		}
+		for _, expectedElement := range expectedValue {
+			found := false
+			for _, element := range values {
+				if containsPatchValue(element, expectedElement) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(value, expected)
+	}
+}
+
+// isEmptyPatchValue returns whether the value of an operation is empty, which NDO can omit from the retrieved object.
+func isEmptyPatchValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// splitPatchPath returns the unescaped segments of a JSON patch path.
+func splitPatchPath(path string) []string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.Replace(strings.Replace(segment, "~1", "/", -1), "~0", "~", -1)
+	}
+	return segments
+}
+
+// resolvePatchPath returns whether the path exists in data.
+func resolvePatchPath(data interface{}, segments []string) bool {
+	_, ok := getPatchPathValue(data, segments)
+	return ok
+}
+
+// getPatchPathValue returns the value of the path in data and whether it exists.
+// List elements are addressed by index, by name or by the name in their object ref.
+func getPatchPathValue(data interface{}, segments []string) (interface{}, bool) {
+	current := data
+	for _, segment := range segments {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			next, ok := value[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			if index, err := strconv.Atoi(segment); err == nil {
+				if index < 0 || index >= len(value) {
+					return nil, false
+				}
+				current = value[index]
+				continue
+			}
+			found := false
+			for _, element := range value {
+				if elementMap, ok := element.(map[string]interface{}); ok && isPatchPathElement(elementMap, segment) {
+					current = element
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func isPatchPathElement(element map[string]interface{}, segment string) bool {
+	if element["name"] == segment {
+		return true
+	}
+	if siteId, ok := element["siteId"].(string); ok && fmt.Sprintf("%s-%v", siteId, element["templateName"]) == segment {
+		return true
+	}
+	for key, value := range element {
+		if ref, ok := value.(string); ok && strings.HasSuffix(key, "Ref") && strings.HasSuffix(ref, "/"+segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// waitForCreatedObject retrieves the object of the endpoint directly after it is created until it is found, because NDO can respond with not found for a few seconds after a create.
+// When found is provided the object is only found when found returns true for it, otherwise every successful response is accepted.
+// Errors other than not found are returned right away.
+func waitForCreatedObject(msoClient *client.Client, endpoint string, found func(*container.Container) bool) error {
+	for attempt := 1; ; attempt++ {
+		cont, err := msoClient.GetViaURL(endpoint)
+		if err == nil && (found == nil || found(cont)) {
+			return nil
+		}
+		if err != nil && (cont == nil || cont.S("code").String() != "404") {
+			return err
+		}
+		if attempt == writeVerification.attempts {
+			if err != nil {
+				return err
+			}
+			return fmt.Errorf("The created object is not found in %s after %d attempts", endpoint, attempt)
+		}
+		log.Printf("[DEBUG] Created object is not found in %s yet (attempt %d of %d)", endpoint, attempt, writeVerification.attempts)
+		err = sleepWithContext(getStopContext(msoClient), writeVerification.delay, fmt.Sprintf("waiting for the created object in %s", endpoint))
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package mso
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsPatchOperationVisible(t *testing.T) {
+	var schema interface{}
+	err := json.Unmarshal([]byte(`{
+		"templates": [{"name": "T1", "anps": [{"name": "A1", "epgs": [{"name": "E1", "uuid": "1", "preferredGroup": false, "subnets": [{"ip": "10.0.0.1/24", "scope": "private"}]}]}]}],
+		"sites": [{"siteId": "S1", "templateName": "T1", "anps": [{"anpRef": "/schemas/x/templates/T1/anps/A1", "epgs": []}]}]
+	}`), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		operation map[string]interface{}
+		visible   bool
+	}{
+		{map[string]interface{}{"op": "add", "path": "/templates/T1/anps/A1/epgs/-"}, true},
+		{map[string]interface{}{"op": "add", "path": "/templates/T1/anps/A2"}, false},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T1/anps/A1/epgs/E1/subnets/0"}, true},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T1/anps/A1/epgs/E1/subnets/1"}, false},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T0/name", "value": "T1"}, true},
+		{map[string]interface{}{"op": "add", "path": "/sites/S1-T1/anps/A1/epgs/-"}, true},
+		{map[string]interface{}{"op": "add", "path": "/templates/T1/anps/A1/epgs/-", "value": map[string]interface{}{"name": "E1", "description": "", "subnets": []map[string]interface{}{{"ip": "10.0.0.1/24"}}}}, true},
+		{map[string]interface{}{"op": "add", "path": "/templates/T1/anps/A1/epgs/-", "value": map[string]interface{}{"name": "E2"}}, false},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T1/anps/A1/epgs/E1/subnets/0/scope", "value": "private"}, true},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T1/anps/A1/epgs/E1/subnets/0/scope", "value": "public"}, false},
+		{map[string]interface{}{"op": "replace", "path": "/templates/T1/anps/A1/epgs/E1/preferredGroup", "value": true}, false},
+		{map[string]interface{}{"op": "add", "path": "/templates/T1/anps/A1/epgs/E1/subnets", "value": []interface{}{map[string]interface{}{"ip": "10.0.0.2/24"}}}, false},
+		{map[string]interface{}{"op": "remove", "path": "/templates/T1/anps/A1/epgs/E1"}, false},
+		{map[string]interface{}{"op": "remove", "path": "/templates/T1/anps/A1/epgs/E2"}, true},
+		{map[string]interface{}{"op": "remove", "path": "/templates/T1/anps/A1/epgs/E1/subnets/0"}, true},
+	}
+	for _, test := range tests {
+		if visible := isPatchOperationVisible(schema, test.operation); visible != test.visible {
+			t.Errorf("expected visible %t for %v, got %t", test.visible, test.operation, visible)
+		}
+	}
+}
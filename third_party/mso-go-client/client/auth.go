@@ -0,0 +1,138 @@
+package client
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+)
+
+// tokenRefreshMargin is the number of seconds before the expiry of the token at which it is renewed.
+// Renewing ahead of the expiry avoids that a request of a long running apply is sent with a token that expires on the way.
+const tokenRefreshMargin = 60
+
+type Auth struct {
+	Token  string
+	Expiry time.Time
+}
+
+func (au *Auth) IsValid() bool {
+	if au.Token != "" && au.Expiry.Unix() > au.estimateExpireTime() {
+		return true
+	}
+	return false
+}
+
+func (t *Auth) CalculateExpiry(willExpire int64) {
+	t.Expiry = time.Unix((time.Now().Unix() + willExpire), 0)
+}
+
+func (t *Auth) estimateExpireTime() int64 {
+	return time.Now().Unix() + tokenRefreshMargin
+}
+
+// canReauthenticate reports whether the request was sent with a token the client can renew.
+// Tokens which are provided to the client without a password can not be renewed.
+func (client *Client) canReauthenticate(req *http.Request) bool {
+	return client.password != "" && client.privateKey == nil && req.Header.Get("Authorization") != "" && (req.Body == nil || req.GetBody != nil)
+}
+
+// reauthenticate renews the token the request was sent with and sets the new token on the request.
+// When another request renewed the token in the meantime, its token is used.
+func (client *Client) reauthenticate(req *http.Request) error {
+	client.authMutex.Lock()
+	defer client.authMutex.Unlock()
+	if client.AuthToken == nil || req.Header.Get("Authorization") == fmt.Sprintf("Bearer %s", client.AuthToken.Token) {
+		err := client.Authenticate()
+		if err != nil {
+			return err
+		}
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", client.AuthToken.Token))
+	return nil
+}
+
+// SetCertificateAuthentication configures the client to sign every request with the private key of a user certificate instead of logging in with the password.
+// The privateKey is the PEM encoded RSA key and certName the name of the certificate of the user, which identifies it as uni/userext/user-{username}/usercert-{certName}.
+func (client *Client) SetCertificateAuthentication(privateKey, certName string) error {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return errors.New("Private key is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		parsedKey, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if pkcs8Err != nil {
+			return fmt.Errorf("Private key is not a PKCS1 or PKCS8 key: %s", err)
+		}
+		var ok bool
+		if key, ok = parsedKey.(*rsa.PrivateKey); !ok {
+			return errors.New("Private key is not an RSA key")
+		}
+	}
+	client.privateKey = key
+	client.certificateDn = fmt.Sprintf("uni/userext/user-%s/usercert-%s", client.username, certName)
+	return nil
+}
+
+// injectSignature signs the method, path and body of the request with the private key and sets the signature cookies.
+func (client *Client) injectSignature(req *http.Request) (*http.Request, error) {
+	content := []byte(req.Method + req.URL.RequestURI())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes, err := ioutil.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, bodyBytes...)
+	}
+	hash := sha256.Sum256(content)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, client.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	req.AddCookie(&http.Cookie{Name: "APIC-Request-Signature", Value: base64.StdEncoding.EncodeToString(signature)})
+	req.AddCookie(&http.Cookie{Name: "APIC-Certificate-Algorithm", Value: "v1.0"})
+	req.AddCookie(&http.Cookie{Name: "APIC-Certificate-Fingerprint", Value: "fingerprint"})
+	req.AddCookie(&http.Cookie{Name: "APIC-Certificate-DN", Value: client.certificateDn})
+	return req, nil
+}
+
+func (client *Client) InjectAuthenticationHeader(req *http.Request, path string) (*http.Request, error) {
+	log.Printf("[DEBUG] Begin Injection")
+	if client.privateKey != nil {
+		return client.injectSignature(req)
+	}
+	// Only one request at a time refreshes the token, the other requests wait for it and reuse the new token.
+	client.authMutex.Lock()
+	if client.AuthToken == nil || !client.AuthToken.IsValid() {
+
+		err := client.Authenticate()
+
+		if err != nil {
+			client.authMutex.Unlock()
+			return nil, err
+		}
+	}
+	token := client.AuthToken.Token
+	client.authMutex.Unlock()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return req, nil
+}
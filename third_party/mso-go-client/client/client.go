@@ -0,0 +1,663 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+	"github.com/hashicorp/go-version"
+)
+
+const msoAuthPayload = `{
+	"username": "%s",
+	"password": "%s"
+}`
+
+const ndAuthPayload = `{
+	"userName": "%s",
+	"userPasswd": "%s"
+}`
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried when NDO reports a concurrent modification or is unavailable.
+	DefaultMaxRetries = 5
+	// DefaultBackoffMinDelay is the delay before the first retry.
+	DefaultBackoffMinDelay = 2 * time.Second
+	// DefaultBackoffMaxDelay is the maximum delay between retries.
+	DefaultBackoffMaxDelay = 60 * time.Second
+	// DefaultBackoffDelayFactor is the factor the delay is multiplied with on every retry.
+	DefaultBackoffDelayFactor = 2.0
+)
+
+// Client is the main entry point
+type Client struct {
+	BaseURL            *url.URL
+	httpClient         *http.Client
+	AuthToken          *Auth
+	Mutex              sync.Mutex // Deprecated: no longer used, modifying requests are serialized per endpoint.
+	username           string
+	password           string
+	insecure           bool
+	proxyUrl           string
+	domain             string
+	platform           string
+	version            string
+	skipLoggingPayload bool
+	certificateDn      string
+	privateKey         *rsa.PrivateKey
+	maxRetries         int
+	backoffMinDelay    time.Duration
+	backoffMaxDelay    time.Duration
+	backoffDelayFactor float64
+	authMutex          sync.Mutex
+	versionMutex       sync.Mutex
+	endpointLocks      sync.Map
+	stopContext        context.Context
+	requestSlots       chan struct{}
+}
+
+var (
+	clientImpl   *Client
+	clientsMutex sync.Mutex
+)
+
+type Option func(*Client)
+
+func Insecure(insecure bool) Option {
+	return func(client *Client) {
+		client.insecure = insecure
+	}
+}
+
+func Password(password string) Option {
+	return func(client *Client) {
+		client.password = password
+	}
+}
+
+func ProxyUrl(pUrl string) Option {
+	return func(client *Client) {
+		client.proxyUrl = pUrl
+	}
+}
+
+func Domain(domain string) Option {
+	return func(client *Client) {
+		client.domain = domain
+	}
+}
+
+func Platform(platform string) Option {
+	return func(client *Client) {
+		client.platform = platform
+	}
+}
+
+func Version(version string) Option {
+	return func(client *Client) {
+		client.version = version
+	}
+}
+
+func SkipLoggingPayload(skipLoggingPayload bool) Option {
+	return func(client *Client) {
+		client.skipLoggingPayload = skipLoggingPayload
+	}
+}
+
+// StopContext sets the context of all requests of the client, the requests and their retries end when it is cancelled.
+func StopContext(ctx context.Context) Option {
+	return func(client *Client) {
+		if ctx != nil {
+			client.stopContext = ctx
+		}
+	}
+}
+
+func initClient(clientUrl, username string, options ...Option) *Client {
+	var transport *http.Transport
+	bUrl, err := url.Parse(clientUrl)
+	if err != nil {
+		// cannot move forward if url is undefined
+		log.Fatal(err)
+	}
+	client := &Client{
+		BaseURL:            bUrl,
+		username:           username,
+		httpClient:         http.DefaultClient,
+		maxRetries:         DefaultMaxRetries,
+		backoffMinDelay:    DefaultBackoffMinDelay,
+		backoffMaxDelay:    DefaultBackoffMaxDelay,
+		backoffDelayFactor: DefaultBackoffDelayFactor,
+		stopContext:        context.Background(),
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	transport = client.useInsecureHTTPClient(client.insecure)
+	if client.proxyUrl != "" {
+		transport = client.configProxy(transport)
+	}
+
+	client.httpClient = &http.Client{
+		Transport: transport,
+	}
+
+	return client
+}
+
+// GetClient returns the client which is created by the first call, the arguments of later calls are ignored.
+// Use NewClient for a client per configuration.
+func GetClient(clientUrl, username string, options ...Option) *Client {
+	clientsMutex.Lock()
+	defer clientsMutex.Unlock()
+	if clientImpl == nil {
+		clientImpl = initClient(clientUrl, username, options...)
+	}
+	return clientImpl
+}
+
+// NewClient returns a new client with its own token, version and transport.
+// Clients are not shared, so settings which are applied after the creation, like the TLS certificates and the retry policy, only apply to this client.
+func NewClient(clientUrl, username string, options ...Option) *Client {
+	return initClient(clientUrl, username, options...)
+}
+
+func (c *Client) configProxy(transport *http.Transport) *http.Transport {
+	pUrl, err := url.Parse(c.proxyUrl)
+	if err != nil {
+		log.Fatal(err)
+	}
+	transport.Proxy = http.ProxyURL(pUrl)
+	return transport
+}
+
+func (c *Client) useInsecureHTTPClient(insecure bool) *http.Transport {
+	transport := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			CipherSuites: []uint16{
+				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+			},
+			PreferServerCipherSuites: true,
+			InsecureSkipVerify:       insecure,
+			MinVersion:               tls.VersionTLS11,
+			MaxVersion:               tls.VersionTLS13,
+		},
+	}
+
+	return transport
+}
+
+// SetTLSCertificates configures the transport of the client to verify the server against rootCAs and to present the certificates for mutual TLS.
+// A nil rootCAs keeps the verification against the system roots. The insecure option still disables the verification.
+func (c *Client) SetTLSCertificates(rootCAs *x509.CertPool, certificates []tls.Certificate) error {
+	transport, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok || transport.TLSClientConfig == nil {
+		return errors.New("The transport of the client does not support TLS certificates")
+	}
+	transport.TLSClientConfig.RootCAs = rootCAs
+	transport.TLSClientConfig.Certificates = certificates
+	return nil
+}
+
+func (c *Client) MakeRestRequest(method string, path string, body *container.Container, authenticated bool) (*http.Request, error) {
+	var bodyBytes []byte
+	if method != "GET" && method != "DELETE" {
+		bodyBytes = body.Bytes()
+	}
+	return c.makeRequest(method, c.msoPath(path), bodyBytes, "application/json", authenticated)
+}
+
+// MakePlatformRequest returns a request to the API of the Nexus Dashboard platform on which NDO runs, like its cluster configuration.
+// The path is sent as is, without the mso prefix of the NDO API on the nd platform.
+func (c *Client) MakePlatformRequest(method string, path string, body *container.Container, authenticated bool) (*http.Request, error) {
+	var bodyBytes []byte
+	if method != "GET" && method != "DELETE" {
+		bodyBytes = body.Bytes()
+	}
+	return c.makeRequest(method, path, bodyBytes, "application/json", authenticated)
+}
+
+// MakeRawRequest returns a request with a body which is not JSON, like a multipart file upload, of the contentType.
+// The body is set before the request is authenticated, so the signature of certificate based authentication covers it.
+func (c *Client) MakeRawRequest(method string, path string, body []byte, contentType string, authenticated bool) (*http.Request, error) {
+	return c.makeRequest(method, c.msoPath(path), body, contentType, authenticated)
+}
+
+// msoPath returns the path of the NDO API, which is prefixed with mso on the nd platform.
+func (c *Client) msoPath(path string) string {
+	if c.platform == "nd" && path != "/login" {
+		if strings.HasPrefix(path, "/") {
+			path = path[1:]
+		}
+		path = fmt.Sprintf("mso/%v", path)
+	}
+	return path
+}
+
+func (c *Client) makeRequest(method string, path string, body []byte, contentType string, authenticated bool) (*http.Request, error) {
+	url, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	if method == "PATCH" {
+		validateString := url.Query()
+		validateString.Set("validate", "false")
+		url.RawQuery = validateString.Encode()
+	}
+	fURL := c.BaseURL.ResolveReference(url)
+	var req *http.Request
+	if method == "GET" || method == "DELETE" {
+		req, err = http.NewRequestWithContext(c.stopContext, method, fURL.String(), nil)
+	} else {
+		req, err = http.NewRequestWithContext(c.stopContext, method, fURL.String(), bytes.NewBuffer(body))
+	}
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	log.Printf("[DEBUG] HTTP request %s %s", method, path)
+
+	if authenticated {
+
+		req, err = c.InjectAuthenticationHeader(req, path)
+		if err != nil {
+			return req, err
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	log.Printf("[DEBUG] HTTP request after injection %s %s", method, path)
+
+	return req, nil
+}
+
+// Authenticate is used to
+func (c *Client) Authenticate() error {
+	method := "POST"
+	path := "/api/v1/auth/login"
+	var authPayload string
+
+	if c.platform == "nd" {
+		authPayload = ndAuthPayload
+		if c.domain == "" {
+			c.domain = "DefaultAuth"
+		}
+		path = "/login"
+	} else {
+		authPayload = msoAuthPayload
+	}
+	body, err := container.ParseJSON([]byte(fmt.Sprintf(authPayload, c.username, c.password)))
+	if err != nil {
+		return err
+	}
+
+	if c.domain != "" {
+		if c.platform == "nd" {
+			body.Set(c.domain, "domain")
+		} else {
+			domainId, err := c.GetDomainId(c.domain)
+			if err != nil {
+				return err
+			}
+			body.Set(domainId, "domainId")
+		}
+	}
+
+	req, err := c.MakeRestRequest(method, path, body, false)
+	if err != nil {
+		return err
+	}
+
+	obj, _, err := c.do(req, true)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil {
+		return errors.New("Empty response")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	token := models.StripQuotes(obj.S("token").String())
+
+	if token == "" || token == "{}" {
+		return errors.New("Invalid Username or Password")
+	}
+
+	if c.AuthToken == nil {
+		c.AuthToken = &Auth{}
+	}
+	c.AuthToken.Token = stripQuotes(token)
+	c.AuthToken.CalculateExpiry(1200) //refreshTime=1200 Sec
+
+	return nil
+}
+
+func (c *Client) GetDomainId(domain string) (string, error) {
+	req, err := c.MakeRestRequest("GET", "/api/v1/auth/login-domains", nil, false)
+	if err != nil {
+		return "", err
+	}
+
+	obj, _, err := c.Do(req)
+
+	if err != nil {
+		return "", err
+	}
+	err = CheckForErrors(obj, "GET")
+	if err != nil {
+		return "", err
+	}
+	count, err := obj.ArrayCount("domains")
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < count; i++ {
+		domainCont, err := obj.ArrayElement(i, "domains")
+		if err != nil {
+			return "", err
+		}
+		domainName := models.StripQuotes(domainCont.S("name").String())
+
+		if domainName == domain {
+			return models.StripQuotes(domainCont.S("id").String()), nil
+		}
+	}
+	return "", fmt.Errorf("Unable to find domain id for domain %s", domain)
+}
+
+// GetVersion returns the platform version. The version is retrieved once and cached on the client,
+// which is shared by all resources of a provider configuration.
+func (c *Client) GetVersion() (string, error) {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	if c.version != "" && c.version != "unknown" {
+		return c.version, nil
+	}
+
+	req, err := c.MakeRestRequest("GET", "/api/v1/platform/version", nil, true)
+	if err != nil {
+		return "unknown", err
+	}
+
+	obj, _, err := c.Do(req)
+	if err != nil {
+		return "unknown", err
+	}
+
+	err = CheckForErrors(obj, "GET")
+	if err != nil {
+		return "unknown", err
+	}
+
+	version := stripQuotes(obj.Search("version").String())
+	if version == "" {
+		return "unknown", fmt.Errorf("Unable to identify version")
+	}
+	c.version = version
+	return version, nil
+}
+
+// Compares the version to the retrieved version.
+// This returns -1, 0, or 1 if this version is smaller, equal, or larger than the retrieved version, respectively.
+func (c *Client) CompareVersion(v string) (int, error) {
+	currentVersion, err := c.GetVersion()
+	if err != nil {
+		return 0, fmt.Errorf("Could not retrieve version: %s", err)
+	}
+
+	v1, err := version.NewVersion(currentVersion)
+	if err != nil {
+		return 0, fmt.Errorf("Could not parse retrieved version")
+	}
+	v2, err := version.NewVersion(v)
+	if err != nil {
+		return 0, fmt.Errorf("Could not parse version")
+	}
+
+	return v2.Compare(v1), nil
+}
+
+func StrtoInt(s string, startIndex int, bitSize int) (int64, error) {
+	return strconv.ParseInt(s, startIndex, bitSize)
+}
+
+func (c *Client) Do(req *http.Request) (*container.Container, *http.Response, error) {
+	return c.do(req, c.skipLoggingPayload)
+}
+
+// lockEndpoint serializes the modifying requests to an endpoint and returns the function to release the lock.
+// GET requests are never locked so reads and writes to different objects can run in parallel.
+func (c *Client) lockEndpoint(req *http.Request) func() {
+	if req.Method == "GET" {
+		return func() {}
+	}
+	lock, _ := c.endpointLocks.LoadOrStore(req.URL.Path, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+// DoRaw sends a request of which the response is not JSON, like a file download, and returns the response with its body.
+// The request is retried and authenticated again like the requests of Do, the status of the response is left to the caller.
+func (c *Client) DoRaw(req *http.Request) (*http.Response, []byte, error) {
+	log.Printf("[TRACE] HTTP Request Method and URL: %s %s", req.Method, req.URL.String())
+	return c.send(req, false)
+}
+
+// send sends the request with the endpoint lock, retries and re-authentication of the client and returns the response with its body.
+func (c *Client) send(req *http.Request, logResponseBody bool) (resp *http.Response, bodyBytes []byte, err error) {
+	unlock := c.lockEndpoint(req)
+	defer unlock()
+
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		release, err := c.acquireRequestSlot(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
+		log.Printf("[DEBUG] HTTP Request: %s %s", req.Method, req.URL.String())
+		log.Printf("[DEBUG] HTTP Response: %d %s %v", resp.StatusCode, resp.Status, resp)
+
+		bodyBytes, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		release()
+		if err != nil {
+			return resp, nil, err
+		}
+		if logResponseBody {
+			log.Printf("[DEBUG] HTTP response unique string %s %s %s", req.Method, req.URL.String(), string(bodyBytes))
+		}
+
+		// A token which is revoked or expired before its calculated expiry is renewed once and the request is sent again.
+		if resp.StatusCode == http.StatusUnauthorized && !reauthenticated && c.canReauthenticate(req) {
+			reauthenticated = true
+			attempt--
+			log.Printf("[WARN] %s %s was rejected as unauthorized, authenticating again", req.Method, req.URL.String())
+			err = c.reauthenticate(req)
+			if err != nil {
+				return resp, nil, err
+			}
+			if req.GetBody != nil {
+				req.Body, err = req.GetBody()
+				if err != nil {
+					return resp, nil, err
+				}
+			}
+			continue
+		}
+
+		reason := retryReason(req.Method, resp.StatusCode, bodyBytes)
+		if reason == "" {
+			break
+		}
+		if attempt >= c.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil, fmt.Errorf("%s %s failed because %s, gave up after %d retries: %s", req.Method, req.URL.Path, reason, attempt, strings.TrimSpace(string(bodyBytes)))
+		}
+
+		delay := c.retryDelay(attempt, resp)
+		log.Printf("[WARN] %s %s failed because %s, retrying in %s (attempt %d of %d)", req.Method, req.URL.String(), reason, delay, attempt+1, c.maxRetries)
+		select {
+		case <-req.Context().Done():
+			return resp, nil, fmt.Errorf("%s %s was cancelled while waiting to retry: %s", req.Method, req.URL.Path, req.Context().Err())
+		case <-time.After(delay):
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return resp, nil, err
+			}
+		}
+	}
+
+	return resp, bodyBytes, nil
+}
+
+func (c *Client) do(req *http.Request, skipLoggingPayload bool) (*container.Container, *http.Response, error) {
+	log.Printf("[DEBUG] Begining DO method %s", req.URL.String())
+	log.Printf("[TRACE] HTTP Request Method and URL: %s %s", req.Method, req.URL.String())
+	if !skipLoggingPayload {
+		log.Printf("[TRACE] HTTP Request Body: %v", req.Body)
+	}
+
+	resp, bodyBytes, err := c.send(req, true)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if req.Method != "DELETE" && resp.StatusCode != 204 {
+		obj, err := container.ParseJSON(bodyBytes)
+
+		if err != nil {
+			log.Printf("Error occured while json parsing %+v", err)
+			return nil, resp, err
+		}
+		log.Printf("[DEBUG] Exit from do method")
+		return obj, resp, err
+	} else if resp.StatusCode == 204 {
+		return nil, nil, nil
+	} else {
+		return nil, resp, nil
+	}
+}
+
+// SetMaxParallelRequests limits the number of requests of the client which are sent at the same time, the requests are not limited when max is 0.
+// A request only holds its slot while it is sent and its response is read, not while it waits to be retried.
+func (c *Client) SetMaxParallelRequests(max int) error {
+	if max < 0 {
+		return fmt.Errorf("Invalid maximum of parallel requests: %d", max)
+	}
+	if max == 0 {
+		c.requestSlots = nil
+	} else {
+		c.requestSlots = make(chan struct{}, max)
+	}
+	return nil
+}
+
+// acquireRequestSlot waits for a free request slot and returns the function which releases it.
+// It returns an error when the context of the request is done before a slot is free.
+func (c *Client) acquireRequestSlot(req *http.Request) (func(), error) {
+	slots := c.requestSlots
+	if slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-req.Context().Done():
+		return nil, fmt.Errorf("%s %s was cancelled while waiting for a free request slot: %s", req.Method, req.URL.Path, req.Context().Err())
+	}
+}
+
+// SetRetryPolicy configures how often and with which delays the requests are retried when NDO reports a concurrent modification or is unavailable.
+// The delay starts at minDelay and is multiplied by delayFactor on every retry up to maxDelay, a Retry-After header of the response takes precedence.
+func (c *Client) SetRetryPolicy(maxRetries int, minDelay, maxDelay time.Duration, delayFactor float64) error {
+	if maxRetries < 0 || minDelay < 0 || maxDelay < minDelay || delayFactor < 1 {
+		return fmt.Errorf("Invalid retry policy: max retries %d, min delay %s, max delay %s, delay factor %g", maxRetries, minDelay, maxDelay, delayFactor)
+	}
+	c.maxRetries = maxRetries
+	c.backoffMinDelay = minDelay
+	c.backoffMaxDelay = maxDelay
+	c.backoffDelayFactor = delayFactor
+	return nil
+}
+
+// retryReason returns why the request is retried, or an empty string when the response is not retried.
+func retryReason(method string, statusCode int, body []byte) string {
+	if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+		return fmt.Sprintf("NDO is unavailable (%d %s)", statusCode, http.StatusText(statusCode))
+	}
+	if isConcurrentModification(method, statusCode, body) {
+		return "the object is being modified by another request"
+	}
+	return ""
+}
+
+// retryDelay returns the delay before the retry of the attempt.
+// The Retry-After header of the response, in seconds or as HTTP date, is preferred over the backoff delay.
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			if delay := time.Until(date); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+	delay := float64(c.backoffMinDelay) * math.Pow(c.backoffDelayFactor, float64(attempt))
+	if delay > float64(c.backoffMaxDelay) {
+		return c.backoffMaxDelay
+	}
+	return time.Duration(delay)
+}
+
+// isConcurrentModification reports whether the response indicates that the object is locked by another change.
+// Other conflicts, like a POST of an object which already exists, are only retried for idempotent methods, because a retry can not change their outcome otherwise.
+func isConcurrentModification(method string, statusCode int, body []byte) bool {
+	if statusCode < 400 {
+		return false
+	}
+	message := strings.ToLower(string(body))
+	if strings.Contains(message, "is being modified") || strings.Contains(message, "concurrent modification") {
+		return true
+	}
+	return statusCode == http.StatusConflict && (method == "GET" || method == "PUT" || method == "DELETE")
+}
+
+func stripQuotes(word string) string {
+	if strings.HasPrefix(word, "\"") && strings.HasSuffix(word, "\"") {
+		return strings.TrimSuffix(strings.TrimPrefix(word, "\""), "\"")
+	}
+	return word
+}
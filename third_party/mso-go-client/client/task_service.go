@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ciscoecosystem/mso-go-client/container"
+	"github.com/ciscoecosystem/mso-go-client/models"
+)
+
+const (
+	// TaskPollMinInterval is the delay before the second status request of a task.
+	TaskPollMinInterval = 1 * time.Second
+	// TaskPollMaxInterval is the maximum delay between the status requests of a task.
+	TaskPollMaxInterval = 30 * time.Second
+	// TaskPollIntervalFactor is the factor the delay is multiplied with after every status request.
+	TaskPollIntervalFactor = 2
+)
+
+// TaskSiteError is the status of a site on which a task did not succeed.
+type TaskSiteError struct {
+	SiteId   string
+	SiteName string
+	Status   string
+	Message  string
+}
+
+// TaskError is returned by WaitForTask when a task did not complete successfully.
+type TaskError struct {
+	TaskId string
+	// Status is the status of the task, e.g. Complete or Error.
+	Status string
+	// Sites are the sites which report a failure, sorted by their id.
+	Sites []TaskSiteError
+}
+
+func (e *TaskError) Error() string {
+	if len(e.Sites) == 0 {
+		return fmt.Sprintf("Task %s finished with status %s", e.TaskId, e.Status)
+	}
+	sites := make([]string, 0, len(e.Sites))
+	for _, site := range e.Sites {
+		sites = append(sites, fmt.Sprintf("site %s (%s): %s %s", site.SiteName, site.SiteId, site.Status, site.Message))
+	}
+	return fmt.Sprintf("Task %s failed on %d site(s):\n%s", e.TaskId, len(e.Sites), strings.Join(sites, "\n"))
+}
+
+// isTaskRunning reports whether the task status is one of a task which did not finish yet.
+func isTaskRunning(status string) bool {
+	return status == "Running" || status == "Pending" || status == "Queued"
+}
+
+// GetTaskError returns the TaskError of a finished task, or nil when the task completed on all sites.
+func GetTaskError(taskId string, taskCont *container.Container) error {
+	taskError := TaskError{
+		TaskId: taskId,
+		Status: models.StripQuotes(taskCont.S("operDetails", "taskStatus").String()),
+	}
+	if siteStatus, ok := taskCont.S("operDetails", "siteStatus").Data().(map[string]interface{}); ok {
+		siteIds := make([]string, 0, len(siteStatus))
+		for siteId := range siteStatus {
+			siteIds = append(siteIds, siteId)
+		}
+		sort.Strings(siteIds)
+		for _, siteId := range siteIds {
+			siteCont := taskCont.S("operDetails", "siteStatus", siteId)
+			status := models.StripQuotes(siteCont.S("status", "status").String())
+			if status == "Success" {
+				continue
+			}
+			siteName, _ := siteCont.S("siteName").Data().(string)
+			message, _ := siteCont.S("status", "msg").Data().(string)
+			taskError.Sites = append(taskError.Sites, TaskSiteError{
+				SiteId:   siteId,
+				SiteName: siteName,
+				Status:   status,
+				Message:  message,
+			})
+		}
+	}
+	if len(taskError.Sites) == 0 && taskError.Status == "Complete" {
+		return nil
+	}
+	return &taskError
+}
+
+// WaitForTask polls the task until it is no longer running and returns the last status of the task.
+// The delay between the status requests starts at TaskPollMinInterval and is multiplied by TaskPollIntervalFactor up to TaskPollMaxInterval.
+// A timeout of zero only bounds the wait by the context. A *TaskError is returned when the task did not complete successfully.
+func (c *Client) WaitForTask(ctx context.Context, taskId string, timeout time.Duration) (*container.Container, error) {
+	if taskId == "" || taskId == "{}" {
+		return nil, errors.New("Unable to wait for the task, the task id is empty")
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	delay := TaskPollMinInterval
+	for {
+		req, err := c.MakeRestRequest("GET", fmt.Sprintf("api/v1/task/%s", taskId), nil, true)
+		if err != nil {
+			return nil, err
+		}
+		taskCont, _, err := c.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if taskCont == nil {
+			return nil, errors.New("Empty response body")
+		}
+		err = CheckForErrors(taskCont, "GET")
+		if err != nil {
+			return taskCont, err
+		}
+
+		status := models.StripQuotes(taskCont.S("operDetails", "taskStatus").String())
+		log.Printf("[DEBUG] Task %s status: %s", taskId, status)
+		if !isTaskRunning(status) {
+			return taskCont, GetTaskError(taskId, taskCont)
+		}
+
+		select {
+		case <-ctx.Done():
+			return taskCont, fmt.Errorf("Stopped waiting for task %s with status %s: %s", taskId, status, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay *= TaskPollIntervalFactor
+		if delay > TaskPollMaxInterval {
+			delay = TaskPollMaxInterval
+		}
+	}
+}
@@ -0,0 +1,5 @@
+module github.com/ciscoecosystem/mso-go-client
+
+go 1.13
+
+require github.com/hashicorp/go-version v1.6.0
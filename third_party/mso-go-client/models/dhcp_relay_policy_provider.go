@@ -0,0 +1,8 @@
+package models
+
+type DHCPRelayPolicyProvider struct {
+	PolicyName     string
+	Addr           string
+	EpgRef         string
+	ExternalEpgRef string
+}
@@ -0,0 +1,6 @@
+package models
+
+type SchemValidate struct {
+	SchmaId string
+	Result  string
+}